@@ -0,0 +1,253 @@
+// Package boiltest provides a mock executor so code using the generated
+// models can be unit tested without a database.
+package boiltest
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"reflect"
+	"sync"
+
+	"github.com/friendsofgo/errors"
+)
+
+// Call is a statement that was run against an Executor
+type Call struct {
+	Query string
+	Args  []interface{}
+}
+
+// Result is the canned answer to a statement. Exec uses LastInsertID and
+// RowsAffected, queries return Rows under Columns. The values of Rows must
+// be driver values: int64, float64, bool, []byte, string, time.Time or nil.
+// The scan type reported for a column is the type of its first non-nil value.
+// A non-nil Err fails the statement instead.
+type Result struct {
+	Columns      []string
+	Rows         [][]interface{}
+	LastInsertID int64
+	RowsAffected int64
+	Err          error
+}
+
+// Executor is a boil.ContextExecutor and boil.ContextBeginner that records
+// the statements run against it and answers them with canned results in the
+// order they were added by Expect. Once the results run out statements
+// affect no rows and queries return no rows.
+type Executor struct {
+	*sql.DB
+
+	mu      sync.Mutex
+	calls   []Call
+	results []Result
+}
+
+// NewExecutor creates an Executor, Close it when done
+func NewExecutor() *Executor {
+	e := &Executor{}
+	e.DB = sql.OpenDB(connector{executor: e})
+	return e
+}
+
+// Expect adds results to answer the next statements with
+func (e *Executor) Expect(results ...Result) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.results = append(e.results, results...)
+}
+
+// Calls returns the statements run so far
+func (e *Executor) Calls() []Call {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	calls := make([]Call, len(e.calls))
+	copy(calls, e.calls)
+	return calls
+}
+
+// Reset forgets the recorded statements and the results that were not used
+func (e *Executor) Reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.calls = nil
+	e.results = nil
+}
+
+// record adds a call and returns the result to answer it with
+func (e *Executor) record(query string, args []driver.NamedValue) Result {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	call := Call{Query: query, Args: make([]interface{}, len(args))}
+	for i, a := range args {
+		call.Args[i] = a.Value
+	}
+	e.calls = append(e.calls, call)
+
+	if len(e.results) == 0 {
+		return Result{}
+	}
+
+	res := e.results[0]
+	e.results = e.results[1:]
+	return res
+}
+
+// connector hands out connections to an Executor, which lets each Executor
+// have its own state without registering a driver
+type connector struct {
+	executor *Executor
+}
+
+func (c connector) Connect(context.Context) (driver.Conn, error) {
+	return conn{executor: c.executor}, nil
+}
+
+func (c connector) Driver() driver.Driver {
+	return mockDriver{}
+}
+
+// mockDriver is only used through the connector, it can't be opened by name
+type mockDriver struct{}
+
+func (mockDriver) Open(string) (driver.Conn, error) {
+	return nil, errors.New("boiltest: the driver can only be used by NewExecutor")
+}
+
+type conn struct {
+	executor *Executor
+}
+
+func (c conn) Prepare(query string) (driver.Stmt, error) {
+	return stmt{conn: c, query: query}, nil
+}
+
+func (c conn) Close() error {
+	return nil
+}
+
+func (c conn) Begin() (driver.Tx, error) {
+	return tx{}, nil
+}
+
+func (c conn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	res := c.executor.record(query, args)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	return result{lastInsertID: res.LastInsertID, rowsAffected: res.RowsAffected}, nil
+}
+
+func (c conn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	res := c.executor.record(query, args)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	return newRows(res.Columns, res.Rows), nil
+}
+
+type stmt struct {
+	conn  conn
+	query string
+}
+
+func (s stmt) Close() error {
+	return nil
+}
+
+func (s stmt) NumInput() int {
+	return -1
+}
+
+func (s stmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.conn.ExecContext(context.Background(), s.query, namedValues(args))
+}
+
+func (s stmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.conn.QueryContext(context.Background(), s.query, namedValues(args))
+}
+
+func namedValues(args []driver.Value) []driver.NamedValue {
+	named := make([]driver.NamedValue, len(args))
+	for i, a := range args {
+		named[i] = driver.NamedValue{Ordinal: i + 1, Value: a}
+	}
+	return named
+}
+
+type tx struct{}
+
+func (tx) Commit() error {
+	return nil
+}
+
+func (tx) Rollback() error {
+	return nil
+}
+
+type result struct {
+	lastInsertID int64
+	rowsAffected int64
+}
+
+func (r result) LastInsertId() (int64, error) {
+	return r.lastInsertID, nil
+}
+
+func (r result) RowsAffected() (int64, error) {
+	return r.rowsAffected, nil
+}
+
+type rows struct {
+	columns   []string
+	scanTypes []reflect.Type
+	rows      [][]interface{}
+}
+
+func newRows(columns []string, values [][]interface{}) *rows {
+	r := &rows{columns: columns, rows: values}
+
+	r.scanTypes = make([]reflect.Type, len(columns))
+	for i := range columns {
+		r.scanTypes[i] = reflect.TypeOf((*interface{})(nil)).Elem()
+		for _, row := range values {
+			if row[i] != nil {
+				r.scanTypes[i] = reflect.TypeOf(row[i])
+				break
+			}
+		}
+	}
+
+	return r
+}
+
+func (r *rows) Columns() []string {
+	return r.columns
+}
+
+func (r *rows) ColumnTypeScanType(index int) reflect.Type {
+	return r.scanTypes[index]
+}
+
+func (r *rows) Close() error {
+	return nil
+}
+
+func (r *rows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+
+	for i, v := range r.rows[0] {
+		dest[i] = v
+	}
+	r.rows = r.rows[1:]
+	return nil
+}
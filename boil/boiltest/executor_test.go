@@ -0,0 +1,117 @@
+package boiltest
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"testing"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+var (
+	_ boil.ContextExecutor = &Executor{}
+	_ boil.ContextBeginner = &Executor{}
+)
+
+func TestExecutorRecordsCalls(t *testing.T) {
+	t.Parallel()
+
+	exec := NewExecutor()
+	defer exec.Close()
+
+	ctx := context.Background()
+	if _, err := exec.ExecContext(ctx, "delete from pilots where id = $1", 5); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := exec.Query("select * from jets where name = $1 and size > $2", "plane", 2.5); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Call{
+		{Query: "delete from pilots where id = $1", Args: []interface{}{int64(5)}},
+		{Query: "select * from jets where name = $1 and size > $2", Args: []interface{}{"plane", 2.5}},
+	}
+	if got := exec.Calls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("want: %#v\ngot: %#v", want, got)
+	}
+
+	exec.Reset()
+	if got := exec.Calls(); len(got) != 0 {
+		t.Errorf("calls were not reset: %#v", got)
+	}
+}
+
+func TestExecutorResults(t *testing.T) {
+	t.Parallel()
+
+	exec := NewExecutor()
+	defer exec.Close()
+
+	failed := errors.New("failed")
+	exec.Expect(
+		Result{LastInsertID: 3, RowsAffected: 1},
+		Result{Columns: []string{"id", "name"}, Rows: [][]interface{}{{int64(1), "Ann"}, {int64(2), nil}}},
+		Result{Err: failed},
+	)
+
+	res, err := exec.Exec("insert into pilots (name) values ($1)", "Ann")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id, _ := res.LastInsertId(); id != 3 {
+		t.Errorf("wrong last insert id: %d", id)
+	}
+	if n, _ := res.RowsAffected(); n != 1 {
+		t.Errorf("wrong rows affected: %d", n)
+	}
+
+	type pilot struct {
+		ID   int            `boil:"id"`
+		Name sql.NullString `boil:"name"`
+	}
+	var pilots []pilot
+	if err = queries.Raw("select id, name from pilots").Bind(context.Background(), exec, &pilots); err != nil {
+		t.Fatal(err)
+	}
+	want := []pilot{{ID: 1, Name: sql.NullString{String: "Ann", Valid: true}}, {ID: 2}}
+	if !reflect.DeepEqual(pilots, want) {
+		t.Errorf("want: %#v\ngot: %#v", want, pilots)
+	}
+
+	if _, err = exec.Exec("delete from pilots"); errors.Cause(err) != failed {
+		t.Errorf("want the canned error, got: %v", err)
+	}
+
+	// Out of results
+	if err = exec.QueryRow("select id from pilots").Scan(new(int)); err != sql.ErrNoRows {
+		t.Errorf("want no rows, got: %v", err)
+	}
+	if len(exec.Calls()) != 4 {
+		t.Errorf("want 4 calls, got: %#v", exec.Calls())
+	}
+}
+
+func TestExecutorTransaction(t *testing.T) {
+	t.Parallel()
+
+	exec := NewExecutor()
+	defer exec.Close()
+
+	tx, err := exec.BeginTx(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = tx.Exec("update pilots set name = $1", "Bob"); err != nil {
+		t.Fatal(err)
+	}
+	if err = tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	if calls := exec.Calls(); len(calls) != 1 || calls[0].Query != "update pilots set name = $1" {
+		t.Errorf("wrong calls: %#v", calls)
+	}
+}
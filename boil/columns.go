@@ -196,3 +196,27 @@ func (c Columns) UpdateColumnSet(allColumns, pkeyCols []string) []string {
 		panic("not a real column list kind")
 	}
 }
+
+// UpsertUpdateColumnSet generates the set of columns to update when an
+// upsert hits a conflict. It's the same as UpdateColumnSet except that the
+// immutable columns, like the created at timestamp, are left out of inferred
+// lists too. A greylist can still add them back.
+//
+//  None:      empty
+//  Infer:     all - pkey-columns - immutable
+//  whitelist: whitelist
+//  blacklist: all - pkeys - immutable - blacklist
+//  greylist:  all - pkeys - immutable + greylist
+func (c Columns) UpsertUpdateColumnSet(allColumns, pkeyCols, immutableCols []string) []string {
+	switch c.Kind {
+	case columnsInfer, columnsBlacklist:
+		return c.UpdateColumnSet(strmangle.SetComplement(allColumns, immutableCols), pkeyCols)
+	case columnsGreylist:
+		mutable := strmangle.SetComplement(allColumns, immutableCols)
+		// okay to modify return of SetComplement since it's a new slice
+		update := append(strmangle.SetComplement(mutable, pkeyCols), c.Cols...)
+		return strmangle.SortByKeys(allColumns, update)
+	default:
+		return c.UpdateColumnSet(allColumns, pkeyCols)
+	}
+}
@@ -135,3 +135,34 @@ func TestUpdateColumnSet(t *testing.T) {
 		}
 	}
 }
+
+func TestUpsertUpdateColumnSet(t *testing.T) {
+	t.Parallel()
+
+	all := []string{"id", "name", "created_at", "updated_at"}
+	pkeys := []string{"id"}
+	immutable := []string{"created_at"}
+
+	tests := []struct {
+		Columns Columns
+		Out     []string
+	}{
+		{Columns: None(), Out: nil},
+		{Columns: Infer(), Out: []string{"name", "updated_at"}},
+		{Columns: Whitelist("name", "created_at"), Out: []string{"name", "created_at"}},
+		{Columns: Blacklist("name"), Out: []string{"updated_at"}},
+		{Columns: Greylist("created_at"), Out: []string{"name", "created_at", "updated_at"}},
+	}
+
+	for i, test := range tests {
+		set := test.Columns.UpsertUpdateColumnSet(all, pkeys, immutable)
+
+		if !reflect.DeepEqual(set, test.Out) {
+			t.Errorf("%d) set was wrong\nwant: %v\ngot:  %v", i, test.Out, set)
+		}
+	}
+
+	if set := Infer().UpsertUpdateColumnSet(all, pkeys, nil); !reflect.DeepEqual(set, []string{"name", "created_at", "updated_at"}) {
+		t.Errorf("want only the pkeys left out without immutable columns, got: %v", set)
+	}
+}
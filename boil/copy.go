@@ -0,0 +1,262 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/strmangle"
+)
+
+// CopyFromBatchParams is the most arguments CopyFrom puts in a single INSERT
+// when the driver can't COPY, it's under mssql's limit of 2100 parameters.
+var CopyFromBatchParams = 2000
+
+// CopyFromBatchBytes is roughly the most bytes of sql and arguments CopyFrom
+// puts in a single INSERT when the driver can't COPY. It keeps statements
+// under mysql's max_allowed_packet, which is 4MB by default before 8.0.
+// A single row bigger than this still gets a statement of its own.
+var CopyFromBatchBytes = 1 << 20
+
+// CopyFromer is an executor that bulk loads rows itself, CopyFrom hands the
+// rows to it when the executor implements it. Wrap a pgx connection's
+// CopyFrom to use it, or anything else the database offers.
+type CopyFromer interface {
+	CopyFrom(ctx context.Context, table string, columns []string, rows [][]interface{}) (int64, error)
+}
+
+// copyFromDriver is what CopyFrom needs to know about a driver
+type copyFromDriver struct {
+	copyIn            bool
+	lq, rq            rune
+	indexPlaceholders bool
+}
+
+// copyFromDrivers are the drivers CopyFrom knows, keyed by the import path
+// and name of their driver.Driver type so boil doesn't have to import them
+var copyFromDrivers = map[string]copyFromDriver{
+	"github.com/lib/pq.Driver":                   {copyIn: true, lq: '"', rq: '"', indexPlaceholders: true},
+	"github.com/jackc/pgx/v4/stdlib.Driver":      {lq: '"', rq: '"', indexPlaceholders: true},
+	"github.com/jackc/pgx/v5/stdlib.Driver":      {lq: '"', rq: '"', indexPlaceholders: true},
+	"github.com/go-sql-driver/mysql.MySQLDriver": {lq: '`', rq: '`'},
+	"github.com/denisenkom/go-mssqldb.Driver":    {lq: '[', rq: ']', indexPlaceholders: true},
+	"github.com/mattn/go-sqlite3.SQLiteDriver":   {lq: '"', rq: '"'},
+}
+
+// copyFromDriverName is the key of d in copyFromDrivers. Unlike the name %T
+// prints it can't be mistaken for a driver from another package of the same
+// name.
+func copyFromDriverName(d driver.Driver) string {
+	typ := reflect.TypeOf(d)
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ.PkgPath() + "." + typ.Name()
+}
+
+// CopyFrom bulk loads rows into table and returns the number of rows
+// inserted. Each row has a value for each of the columns.
+//
+// If exec is a CopyFromer the rows are handed to it. Otherwise exec must be
+// a *sql.DB so its driver can be told apart, the rows are then loaded in a
+// transaction with postgres' COPY FROM STDIN for lib/pq, and with multi-row
+// INSERTs of up to CopyFromBatchParams arguments and CopyFromBatchBytes bytes
+// for the other drivers.
+func CopyFrom(ctx context.Context, exec ContextExecutor, table string, columns []string, rows [][]interface{}) (int64, error) {
+	if copier, ok := exec.(CopyFromer); ok {
+		return copier.CopyFrom(ctx, table, columns, rows)
+	}
+
+	db, ok := exec.(interface {
+		ContextBeginner
+		Driver() driver.Driver
+	})
+	if !ok {
+		return 0, errors.Errorf("cannot copy with an executor of type %T, use the *sql.DB or a CopyFromer", exec)
+	}
+
+	drv, ok := copyFromDrivers[copyFromDriverName(db.Driver())]
+	if !ok {
+		return 0, errors.Errorf("cannot copy with the driver %T, use a CopyFromer", db.Driver())
+	}
+
+	for i, row := range rows {
+		if len(row) != len(columns) {
+			return 0, errors.Errorf("row %d has %d values for %d columns", i, len(row), len(columns))
+		}
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to begin the copy transaction")
+	}
+
+	var n int64
+	if drv.copyIn {
+		n, err = copyIn(ctx, tx, drv, table, columns, rows)
+	} else {
+		n, err = copyInsert(ctx, tx, drv, table, columns, rows)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, errors.Wrap(err, "failed to commit the copy transaction")
+	}
+
+	return n, nil
+}
+
+// copyIn loads the rows with COPY FROM STDIN, lib/pq sends each row as the
+// statement is executed with it and finishes the copy when it's executed
+// without arguments
+func copyIn(ctx context.Context, tx *sql.Tx, drv copyFromDriver, table string, columns []string, rows [][]interface{}) (int64, error) {
+	query := fmt.Sprintf("COPY %s (%s) FROM STDIN",
+		strmangle.IdentQuote(drv.lq, drv.rq, table),
+		strings.Join(strmangle.IdentQuoteSlice(drv.lq, drv.rq, columns), ", "),
+	)
+
+	if IsDebug(ctx) {
+		writer := DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+	}
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to prepare copy")
+	}
+	defer stmt.Close()
+
+	for _, row := range rows {
+		if _, err = stmt.ExecContext(ctx, row...); err != nil {
+			return 0, errors.Wrap(err, "failed to copy row")
+		}
+	}
+
+	res, err := stmt.ExecContext(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to finish copy")
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return int64(len(rows)), nil
+	}
+	return n, nil
+}
+
+// copyInsert loads the rows with multi-row INSERTs, starting a new statement
+// before one would go over CopyFromBatchParams arguments or about
+// CopyFromBatchBytes bytes
+func copyInsert(ctx context.Context, tx *sql.Tx, drv copyFromDriver, table string, columns []string, rows [][]interface{}) (int64, error) {
+	prefix := fmt.Sprintf("INSERT INTO %s (%s) VALUES ",
+		strmangle.IdentQuote(drv.lq, drv.rq, table),
+		strings.Join(strmangle.IdentQuoteSlice(drv.lq, drv.rq, columns), ", "),
+	)
+
+	buf := strmangle.GetBuffer()
+	defer strmangle.PutBuffer(buf)
+
+	var total int64
+	var args []interface{}
+	var size int
+
+	flush := func() error {
+		query := buf.String()
+		if IsDebug(ctx) {
+			writer := DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, args...)
+		}
+
+		res, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert rows")
+		}
+
+		n, err := res.RowsAffected()
+		if err != nil {
+			n = int64(len(args) / len(columns))
+		}
+		total += n
+
+		buf.Reset()
+		args = args[:0]
+		size = 0
+		return nil
+	}
+
+	for _, row := range rows {
+		rowSize := 2 + 3*len(row)
+		for _, v := range row {
+			rowSize += copyArgSize(v)
+		}
+
+		if len(args) != 0 && (len(args)+len(row) > CopyFromBatchParams || size+rowSize > CopyFromBatchBytes) {
+			if err := flush(); err != nil {
+				return 0, err
+			}
+		}
+
+		if len(args) == 0 {
+			buf.WriteString(prefix)
+			size = len(prefix)
+		} else {
+			buf.WriteByte(',')
+		}
+
+		buf.WriteByte('(')
+		for j := range row {
+			if j != 0 {
+				buf.WriteByte(',')
+			}
+			if drv.indexPlaceholders {
+				fmt.Fprintf(buf, "$%d", len(args)+j+1)
+			} else {
+				buf.WriteByte('?')
+			}
+		}
+		buf.WriteByte(')')
+
+		args = append(args, row...)
+		size += rowSize
+	}
+
+	if len(args) != 0 {
+		if err := flush(); err != nil {
+			return 0, err
+		}
+	}
+
+	return total, nil
+}
+
+// copyArgSize estimates how many bytes an argument takes up in a statement
+func copyArgSize(v interface{}) int {
+	if valuer, ok := v.(driver.Valuer); ok {
+		var err error
+		if v, err = valuer.Value(); err != nil {
+			return 0
+		}
+	}
+
+	switch val := v.(type) {
+	case string:
+		return len(val)
+	case []byte:
+		return len(val)
+	case nil:
+		return 4
+	default:
+		return 8
+	}
+}
@@ -0,0 +1,268 @@
+package boil
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/friendsofgo/errors"
+	_ "github.com/lib/pq"
+)
+
+// useCopyFromDriver makes CopyFrom treat the driver of db like drv until
+// the test is done
+func useCopyFromDriver(t testing.TB, db *sql.DB, drv copyFromDriver) {
+	name := copyFromDriverName(db.Driver())
+	old, ok := copyFromDrivers[name]
+	copyFromDrivers[name] = drv
+	t.Cleanup(func() {
+		if ok {
+			copyFromDrivers[name] = old
+		} else {
+			delete(copyFromDrivers, name)
+		}
+	})
+}
+
+func TestCopyFromCopyIn(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	useCopyFromDriver(t, db, copyFromDriver{copyIn: true, lq: '"', rq: '"', indexPlaceholders: true})
+
+	mock.ExpectBegin()
+	prep := mock.ExpectPrepare(`COPY "public"."pilots" \("id", "name"\) FROM STDIN`)
+	prep.ExpectExec().WithArgs(1, "Ann").WillReturnResult(sqlmock.NewResult(0, 0))
+	prep.ExpectExec().WithArgs(2, "Bob").WillReturnResult(sqlmock.NewResult(0, 0))
+	prep.ExpectExec().WithArgs().WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	n, err := CopyFrom(context.Background(), db, "public.pilots", []string{"id", "name"}, [][]interface{}{{1, "Ann"}, {2, "Bob"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Errorf("want 2 rows copied, got: %d", n)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCopyFromInsert(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	useCopyFromDriver(t, db, copyFromDriver{lq: '`', rq: '`'})
+
+	oldBatch := CopyFromBatchParams
+	CopyFromBatchParams = 5
+	defer func() { CopyFromBatchParams = oldBatch }()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `pilots` \\(`id`, `name`\\) VALUES \\(\\?,\\?\\),\\(\\?,\\?\\)$").
+		WithArgs(1, "Ann", 2, "Bob").WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("INSERT INTO `pilots` \\(`id`, `name`\\) VALUES \\(\\?,\\?\\)$").
+		WithArgs(3, "Cat").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	rows := [][]interface{}{{1, "Ann"}, {2, "Bob"}, {3, "Cat"}}
+	n, err := CopyFrom(context.Background(), db, "pilots", []string{"id", "name"}, rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 {
+		t.Errorf("want 3 rows inserted, got: %d", n)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCopyFromInsertBytes(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	useCopyFromDriver(t, db, copyFromDriver{lq: '`', rq: '`'})
+
+	oldBytes := CopyFromBatchBytes
+	CopyFromBatchBytes = 64 << 10
+	defer func() { CopyFromBatchBytes = oldBytes }()
+
+	// Each row is about 1KB so 64 of them fit in a statement
+	name := strings.Repeat("a", 1000)
+	rows := make([][]interface{}, 250)
+	for i := range rows {
+		rows[i] = []interface{}{i, name}
+	}
+
+	mock.ExpectBegin()
+	for _, n := range []int{64, 64, 64, 58} {
+		mock.ExpectExec(fmt.Sprintf("INSERT INTO `pilots` \\(`id`, `name`\\) VALUES (\\(\\?,\\?\\),){%d}\\(\\?,\\?\\)$", n-1)).
+			WillReturnResult(sqlmock.NewResult(0, int64(n)))
+	}
+	mock.ExpectCommit()
+
+	n, err := CopyFrom(context.Background(), db, "pilots", []string{"id", "name"}, rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 250 {
+		t.Errorf("want 250 rows inserted, got: %d", n)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+
+	// A row bigger than the limit is inserted on its own
+	big := strings.Repeat("b", 100<<10)
+	mock.ExpectBegin()
+	mock.ExpectExec("VALUES \\(\\?,\\?\\)$").WithArgs(1, "Ann").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("VALUES \\(\\?,\\?\\)$").WithArgs(2, big).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("VALUES \\(\\?,\\?\\)$").WithArgs(3, "Cat").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	if _, err = CopyFrom(context.Background(), db, "pilots", []string{"id", "name"}, [][]interface{}{{1, "Ann"}, {2, big}, {3, "Cat"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCopyFromRollback(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	useCopyFromDriver(t, db, copyFromDriver{lq: '"', rq: '"', indexPlaceholders: true})
+
+	failed := errors.New("failed")
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO "pilots" \("id"\) VALUES \(\$1\),\(\$2\)$`).WithArgs(1, 2).WillReturnError(failed)
+	mock.ExpectRollback()
+
+	if _, err := CopyFrom(context.Background(), db, "pilots", []string{"id"}, [][]interface{}{{1}, {2}}); errors.Cause(err) != failed {
+		t.Errorf("want the insert error, got: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+type copyFromerExecutor struct {
+	ContextExecutor
+	rows [][]interface{}
+}
+
+func (c *copyFromerExecutor) CopyFrom(ctx context.Context, table string, columns []string, rows [][]interface{}) (int64, error) {
+	c.rows = append(c.rows, rows...)
+	return int64(len(rows)), nil
+}
+
+func TestCopyFromErrors(t *testing.T) {
+	t.Parallel()
+
+	copier := &copyFromerExecutor{}
+	if n, err := CopyFrom(context.Background(), copier, "pilots", []string{"id"}, [][]interface{}{{1}}); err != nil || n != 1 || len(copier.rows) != 1 {
+		t.Errorf("want the rows handed to the CopyFromer, got %d, %v", n, err)
+	}
+
+	if _, err := CopyFrom(context.Background(), &sql.Tx{}, "pilots", []string{"id"}, nil); err == nil {
+		t.Error("want an error for an executor whose driver can't be told")
+	}
+
+	db, _, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	if _, err := CopyFrom(context.Background(), db, "pilots", []string{"id"}, nil); err == nil {
+		t.Error("want an error for an unknown driver")
+	}
+}
+
+func TestCopyFromDriverName(t *testing.T) {
+	t.Parallel()
+
+	db, err := sql.Open("postgres", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	name := copyFromDriverName(db.Driver())
+	if name != "github.com/lib/pq.Driver" {
+		t.Errorf("want the import path and name of the driver, got: %s", name)
+	}
+	if !copyFromDrivers[name].copyIn {
+		t.Error("want lib/pq to use COPY")
+	}
+}
+
+// BenchmarkCopyFrom compares COPY to multi-row INSERTs against the postgres
+// database in SQLBOILER_BENCH_PSQL, a lib/pq connection string
+func BenchmarkCopyFrom(b *testing.B) {
+	dsn := os.Getenv("SQLBOILER_BENCH_PSQL")
+	if len(dsn) == 0 {
+		b.Skip("SQLBOILER_BENCH_PSQL is not set")
+	}
+
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer db.Close()
+
+	// A temp table only exists on the connection that created it, keeping a
+	// single connection in the pool makes CopyFrom's transactions use it too
+	db.SetMaxOpenConns(1)
+	db.SetMaxIdleConns(1)
+	db.SetConnMaxLifetime(0)
+
+	ctx := context.Background()
+	if _, err = db.ExecContext(ctx, `create temp table bench_copy (id int, name text)`); err != nil {
+		b.Fatal(err)
+	}
+
+	rows := make([][]interface{}, 10000)
+	for i := range rows {
+		rows[i] = []interface{}{i, "pilot " + strconv.Itoa(i)}
+	}
+
+	for _, copyIn := range []bool{true, false} {
+		name := "insert"
+		if copyIn {
+			name = "copy"
+		}
+
+		b.Run(name, func(b *testing.B) {
+			drv := copyFromDrivers["github.com/lib/pq.Driver"]
+			drv.copyIn = copyIn
+			useCopyFromDriver(b, db, drv)
+
+			for i := 0; i < b.N; i++ {
+				if _, err := CopyFrom(ctx, db, "bench_copy", []string{"id", "name"}, rows); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -14,3 +14,23 @@ func TestGetSetDB(t *testing.T) {
 		t.Errorf("Expected GetDB to return a database handle, got nil")
 	}
 }
+
+type executorOnly struct {
+	Executor
+}
+
+func TestSetDBContext(t *testing.T) {
+	db := &sql.DB{}
+	SetDB(db)
+	defer SetDB(nil)
+
+	if GetContextDB() != db {
+		t.Errorf("Expected GetContextDB to return the db set, got %#v", GetContextDB())
+	}
+
+	// A db without context support doesn't leave the previous one behind
+	SetDB(executorOnly{Executor: db})
+	if GetContextDB() != nil {
+		t.Errorf("Expected GetContextDB to return nil, got %#v", GetContextDB())
+	}
+}
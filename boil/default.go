@@ -0,0 +1,21 @@
+package boil
+
+// Default is a sentinel value that can be used in place of a value in
+// updates and inserts built by the queries package to set a column to its
+// default, for example: models.M{"status": boil.Default}.
+// It's written to the query as the DEFAULT keyword and does not use a
+// placeholder or an arg.
+var Default = defaultKeyword{}
+
+type defaultKeyword struct{}
+
+// String returns the keyword that the value is written as
+func (defaultKeyword) String() string {
+	return "DEFAULT"
+}
+
+// IsDefault checks if a value is the Default sentinel
+func IsDefault(v interface{}) bool {
+	_, ok := v.(defaultKeyword)
+	return ok
+}
@@ -0,0 +1,20 @@
+package boil
+
+import "testing"
+
+func TestIsDefault(t *testing.T) {
+	t.Parallel()
+
+	if !IsDefault(Default) {
+		t.Error("expected Default to be the default sentinel")
+	}
+
+	var intf interface{} = Default
+	if !IsDefault(intf) {
+		t.Error("expected Default as an interface to be the default sentinel")
+	}
+
+	if IsDefault("DEFAULT") || IsDefault(nil) {
+		t.Error("only the sentinel should be default")
+	}
+}
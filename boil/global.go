@@ -13,12 +13,12 @@ var (
 	timestampLocation = time.UTC
 )
 
-// SetDB initializes the database handle for all template db interactions
+// SetDB initializes the database handle for all template db interactions,
+// it's used by the G variants of the generated methods. The context aware
+// variants need a db that is also a ContextExecutor, like *sql.DB.
 func SetDB(db Executor) {
 	currentDB = db
-	if c, ok := currentDB.(ContextExecutor); ok {
-		currentContextDB = c
-	}
+	currentContextDB, _ = currentDB.(ContextExecutor)
 }
 
 // GetDB retrieves the global state database handle
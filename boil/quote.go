@@ -0,0 +1,48 @@
+package boil
+
+import (
+	"strings"
+
+	"github.com/friendsofgo/errors"
+)
+
+// identQuotes are the quote characters of each dialect, keyed by the name
+// of its sqlboiler driver
+var identQuotes = map[string][2]byte{
+	"psql":    {'"', '"'},
+	"mysql":   {'`', '`'},
+	"mssql":   {'[', ']'},
+	"sqlite3": {'"', '"'},
+}
+
+// QuoteIdentifier quotes name as a single identifier for the dialect, one
+// of psql, mysql, mssql or sqlite3. Closing quote characters in the name
+// are doubled so it can't break out of the quotes, and dots are left alone
+// so "schema.table" is one identifier, quote each part to qualify a name.
+//
+// It returns an error if the dialect is unknown or the name contains a null
+// byte, which no database allows in an identifier.
+func QuoteIdentifier(dialect, name string) (string, error) {
+	quotes, ok := identQuotes[dialect]
+	if !ok {
+		return "", errors.Errorf("boil: cannot quote identifiers for unknown dialect %q", dialect)
+	}
+	if strings.IndexByte(name, 0) != -1 {
+		return "", errors.Errorf("boil: identifier %q contains a null byte", name)
+	}
+
+	lq, rq := quotes[0], quotes[1]
+
+	var buf strings.Builder
+	buf.Grow(len(name) + 2)
+	buf.WriteByte(lq)
+	for i := 0; i < len(name); i++ {
+		if name[i] == rq {
+			buf.WriteByte(rq)
+		}
+		buf.WriteByte(name[i])
+	}
+	buf.WriteByte(rq)
+
+	return buf.String(), nil
+}
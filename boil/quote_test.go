@@ -0,0 +1,57 @@
+package boil
+
+import "testing"
+
+func TestQuoteIdentifier(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Dialect string
+		Name    string
+		Want    string
+	}{
+		{"psql", "pilots", `"pilots"`},
+		{"psql", `pi"lots`, `"pi""lots"`},
+		{"psql", `"`, `""""`},
+		{"psql", "public.pilots", `"public.pilots"`},
+		{"psql", "pi`lots]", "\"pi`lots]\""},
+		{"sqlite3", `pi"lots`, `"pi""lots"`},
+		{"mysql", "pilots", "`pilots`"},
+		{"mysql", "pi`lots", "`pi``lots`"},
+		{"mysql", `pi"lots`, "`pi\"lots`"},
+		{"mssql", "pilots", "[pilots]"},
+		{"mssql", "pi]lots", "[pi]]lots]"},
+		{"mssql", "pi[lots", "[pi[lots]"},
+		{"mssql", "", "[]"},
+	}
+
+	for i, test := range tests {
+		got, err := QuoteIdentifier(test.Dialect, test.Name)
+		if err != nil {
+			t.Errorf("%d) %s %q: %v", i, test.Dialect, test.Name, err)
+			continue
+		}
+		if got != test.Want {
+			t.Errorf("%d) %s %q: want %s, got %s", i, test.Dialect, test.Name, test.Want, got)
+		}
+	}
+}
+
+func TestQuoteIdentifierErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Dialect string
+		Name    string
+	}{
+		{"psql", "pi\x00lots"},
+		{"mssql", "\x00"},
+		{"oracle", "pilots"},
+	}
+
+	for i, test := range tests {
+		if got, err := QuoteIdentifier(test.Dialect, test.Name); err == nil {
+			t.Errorf("%d) %s %q: want an error, got %s", i, test.Dialect, test.Name, got)
+		}
+	}
+}
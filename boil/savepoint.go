@@ -0,0 +1,60 @@
+package boil
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/friendsofgo/errors"
+)
+
+var rgxSavepointName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// WithSavepoint runs fn inside a savepoint of the transaction tx. If fn
+// returns an error the transaction is rolled back to the savepoint, undoing
+// only what fn did, and the error is returned as it is. Otherwise the
+// savepoint is released. Either way tx can still be used afterwards, which
+// makes it useful for best-effort work inside a bigger transaction:
+//
+//   err := boil.WithSavepoint(tx, "audit", func() error {
+//     return entry.Insert(tx, boil.Infer())
+//   })
+//
+// The name must be a plain identifier. The SAVEPOINT syntax is the one of
+// postgres, mysql and sqlite, mssql's SAVE TRANSACTION isn't supported.
+func WithSavepoint(tx Executor, name string, fn func() error) error {
+	return withSavepoint(name, fn, func(query string) error {
+		_, err := tx.Exec(query)
+		return err
+	})
+}
+
+// WithSavepointContext is WithSavepoint with a context
+func WithSavepointContext(ctx context.Context, tx ContextExecutor, name string, fn func(ctx context.Context) error) error {
+	return withSavepoint(name, func() error { return fn(ctx) }, func(query string) error {
+		_, err := tx.ExecContext(ctx, query)
+		return err
+	})
+}
+
+func withSavepoint(name string, fn func() error, exec func(query string) error) error {
+	if !rgxSavepointName.MatchString(name) {
+		return errors.Errorf("invalid savepoint name %q", name)
+	}
+
+	if err := exec("SAVEPOINT " + name); err != nil {
+		return errors.Wrapf(err, "failed to create savepoint %s", name)
+	}
+
+	if err := fn(); err != nil {
+		if rbErr := exec("ROLLBACK TO SAVEPOINT " + name); rbErr != nil {
+			return errors.Wrapf(err, "failed to roll back to savepoint %s: %v", name, rbErr)
+		}
+		return err
+	}
+
+	if err := exec("RELEASE SAVEPOINT " + name); err != nil {
+		return errors.Wrapf(err, "failed to release savepoint %s", name)
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package boil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/friendsofgo/errors"
+)
+
+func TestWithSavepoint(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SAVEPOINT audit").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("insert into audits").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("RELEASE SAVEPOINT audit").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = WithSavepoint(tx, "audit", func() error {
+		_, err := tx.Exec("insert into audits")
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWithSavepointRollback(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	failed := errors.New("failed")
+
+	mock.ExpectBegin()
+	mock.ExpectExec("insert into pilots").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("SAVEPOINT audit").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("insert into audits").WillReturnError(failed)
+	mock.ExpectExec("ROLLBACK TO SAVEPOINT audit").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("insert into jets").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	ctx := context.Background()
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = tx.ExecContext(ctx, "insert into pilots"); err != nil {
+		t.Fatal(err)
+	}
+
+	err = WithSavepointContext(ctx, tx, "audit", func(ctx context.Context) error {
+		_, err := tx.ExecContext(ctx, "insert into audits")
+		return err
+	})
+	if err != failed {
+		t.Errorf("want the error from fn, got: %v", err)
+	}
+
+	// The outer transaction carries on after the savepoint was rolled back
+	if _, err = tx.ExecContext(ctx, "insert into jets"); err != nil {
+		t.Fatal(err)
+	}
+	if err = tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWithSavepointErrors(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	called := false
+	fn := func() error {
+		called = true
+		return errors.New("failed")
+	}
+
+	if err := WithSavepoint(db, "audit; drop table pilots", fn); err == nil {
+		t.Error("want an error for an invalid name")
+	}
+
+	mock.ExpectExec("SAVEPOINT audit").WillReturnError(errors.New("no transaction"))
+	if err := WithSavepoint(db, "audit", fn); err == nil {
+		t.Error("want an error when the savepoint can't be created")
+	}
+	if called {
+		t.Error("want fn not called without a savepoint")
+	}
+
+	rbFailed := errors.New("rollback failed")
+	mock.ExpectExec("SAVEPOINT audit").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("ROLLBACK TO SAVEPOINT audit").WillReturnError(rbFailed)
+	if err := WithSavepoint(db, "audit", fn); err == nil || err.Error() != "failed to roll back to savepoint audit: rollback failed: failed" {
+		t.Errorf("want both errors when the rollback fails, got: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,70 @@
+package boil
+
+import (
+	"sync"
+)
+
+// StrictMode is a flag controlling whether queries are validated against
+// the columns registered with RegisterTableColumns before they are executed.
+// It is off by default and is mostly useful in tests to catch typos in
+// column names before they reach the database.
+var StrictMode = false
+
+var (
+	tableColumnsMut sync.RWMutex
+	tableColumns    = make(map[string]map[string]struct{})
+)
+
+// RegisterTableColumns records the known columns for a table so that
+// queries can be validated against them when StrictMode is enabled.
+// Registering the same table again replaces its columns.
+func RegisterTableColumns(table string, columns []string) {
+	cols := make(map[string]struct{}, len(columns))
+	for _, c := range columns {
+		cols[c] = struct{}{}
+	}
+
+	tableColumnsMut.Lock()
+	tableColumns[table] = cols
+	tableColumnsMut.Unlock()
+}
+
+// UnregisterTableColumns removes a table from the column registry.
+func UnregisterTableColumns(table string) {
+	tableColumnsMut.Lock()
+	delete(tableColumns, table)
+	tableColumnsMut.Unlock()
+}
+
+// TableHasColumn reports whether column is one of the registered columns
+// of table. The registered return value is false if the table has never
+// been registered, in which case nothing can be said about the column.
+func TableHasColumn(table, column string) (registered bool, ok bool) {
+	tableColumnsMut.RLock()
+	defer tableColumnsMut.RUnlock()
+
+	cols, registered := tableColumns[table]
+	if !registered {
+		return false, false
+	}
+
+	_, ok = cols[column]
+	return true, ok
+}
+
+// IsTableRegistered reports whether columns were registered for table.
+func IsTableRegistered(table string) bool {
+	tableColumnsMut.RLock()
+	defer tableColumnsMut.RUnlock()
+
+	_, ok := tableColumns[table]
+	return ok
+}
+
+// HasRegisteredTables reports whether any tables have been registered.
+func HasRegisteredTables() bool {
+	tableColumnsMut.RLock()
+	defer tableColumnsMut.RUnlock()
+
+	return len(tableColumns) != 0
+}
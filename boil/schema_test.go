@@ -0,0 +1,33 @@
+package boil
+
+import "testing"
+
+func TestTableColumnsRegistry(t *testing.T) {
+	t.Parallel()
+
+	if registered, _ := TableHasColumn("schema_test_users", "id"); registered {
+		t.Error("table should not be registered yet")
+	}
+
+	RegisterTableColumns("schema_test_users", []string{"id", "name"})
+	defer UnregisterTableColumns("schema_test_users")
+
+	if !IsTableRegistered("schema_test_users") || !HasRegisteredTables() {
+		t.Error("table should be registered")
+	}
+	if IsTableRegistered("schema_test_videos") {
+		t.Error("table should not be registered")
+	}
+
+	if registered, ok := TableHasColumn("schema_test_users", "id"); !registered || !ok {
+		t.Errorf("want id to exist, got registered=%t ok=%t", registered, ok)
+	}
+	if registered, ok := TableHasColumn("schema_test_users", "nmae"); !registered || ok {
+		t.Errorf("want nmae to be missing, got registered=%t ok=%t", registered, ok)
+	}
+
+	RegisterTableColumns("schema_test_users", []string{"id"})
+	if _, ok := TableHasColumn("schema_test_users", "name"); ok {
+		t.Error("re-registering should replace the columns")
+	}
+}
@@ -3,8 +3,8 @@ package boilingcore
 import (
 	"fmt"
 
+	"github.com/friendsofgo/errors"
 	"github.com/volatiletech/sqlboiler/v4/drivers"
-	"github.com/volatiletech/strmangle"
 )
 
 // Aliases defines aliases for the generation run
@@ -34,8 +34,16 @@ type RelationshipAlias struct {
 // and fills in aliases where the user has provided none.
 //
 // This leaves us with a complete list of Go names for all tables,
-// columns, and relationships.
+// columns, and relationships. Names are inflected with the default rules,
+// see FillAliasesInflected to add to them.
 func FillAliases(a *Aliases, tables []drivers.Table) {
+	FillAliasesInflected(a, tables, Inflections{})
+}
+
+// FillAliasesInflected is FillAliases with the irregular and uncountable
+// words of inf added to the rules used to inflect table names, and its
+// initialisms to the ones used to case them.
+func FillAliasesInflected(a *Aliases, tables []drivers.Table, inf Inflections) {
 	if a.Tables == nil {
 		a.Tables = make(map[string]TableAlias)
 	}
@@ -54,16 +62,16 @@ func FillAliases(a *Aliases, tables []drivers.Table) {
 		table := a.Tables[t.Name]
 
 		if len(table.UpPlural) == 0 {
-			table.UpPlural = strmangle.TitleCase(strmangle.Plural(t.Name))
+			table.UpPlural = inf.TitleCase(inf.Plural(t.Name))
 		}
 		if len(table.UpSingular) == 0 {
-			table.UpSingular = strmangle.TitleCase(strmangle.Singular(t.Name))
+			table.UpSingular = inf.TitleCase(inf.Singular(t.Name))
 		}
 		if len(table.DownPlural) == 0 {
-			table.DownPlural = strmangle.CamelCase(strmangle.Plural(t.Name))
+			table.DownPlural = inf.CamelCase(inf.Plural(t.Name))
 		}
 		if len(table.DownSingular) == 0 {
-			table.DownSingular = strmangle.CamelCase(strmangle.Singular(t.Name))
+			table.DownSingular = inf.CamelCase(inf.Singular(t.Name))
 		}
 
 		if table.Columns == nil {
@@ -75,24 +83,25 @@ func FillAliases(a *Aliases, tables []drivers.Table) {
 
 		for _, c := range t.Columns {
 			if _, ok := table.Columns[c.Name]; !ok {
-				table.Columns[c.Name] = strmangle.TitleCase(c.Name)
+				table.Columns[c.Name] = inf.TitleCase(c.Name)
 			}
 		}
 
 		a.Tables[t.Name] = table
 
-		for _, k := range t.FKeys {
-			r := table.Relationships[k.Name]
-			if len(r.Local) != 0 && len(r.Foreign) != 0 {
-				continue
-			}
+		names := make([]RelationshipAlias, len(t.FKeys))
+		for i, k := range t.FKeys {
+			names[i].Local, names[i].Foreign = txtNameToOne(k, inf)
+		}
+		disambiguateToOne(t.FKeys, names, inf)
 
-			local, foreign := txtNameToOne(k)
+		for i, k := range t.FKeys {
+			r := table.Relationships[k.Name]
 			if len(r.Local) == 0 {
-				r.Local = local
+				r.Local = names[i].Local
 			}
 			if len(r.Foreign) == 0 {
-				r.Foreign = foreign
+				r.Foreign = names[i].Foreign
 			}
 
 			table.Relationships[k.Name] = r
@@ -127,7 +136,7 @@ func FillAliases(a *Aliases, tables []drivers.Table) {
 		// videos_tags.relationships.fk_video_id.foreign = "Videos"
 		// Consistent, yes. Confusing? Also yes.
 
-		lhsName, rhsName := txtNameToMany(lhs, rhs)
+		lhsName, rhsName := txtNameToMany(lhs, rhs, inf)
 
 		if len(lhsAlias.Local) != 0 {
 			rhsName = lhsAlias.Local
@@ -159,6 +168,142 @@ func FillAliases(a *Aliases, tables []drivers.Table) {
 	}
 }
 
+// disambiguateToOne renames the relationships of a table's foreign keys that
+// were given the same name, which happens when more than one of them refers
+// to the same table through columns that only differ by a suffix, like
+// messages.user and messages.user_id. The colliding names are made from the
+// column and the table instead, first without the column's suffix, then
+// with it. Only the names that collide are renamed: for messages.user and
+// messages.user_id the foreign names MessageUser and User already differ
+// and stay, the local names users.Messages become UserMessages and
+// UserIDMessages.
+//
+// A unique foreign key to its own table through a column named after the
+// table, like jets.jet_id, gives both of its sides the same name on the
+// same struct. Its local name is renamed the same way: Jet and JetJet.
+func disambiguateToOne(fkeys []drivers.ForeignKey, names []RelationshipAlias, inf Inflections) {
+	for _, withSuffix := range []bool{false, true} {
+		foreign := make(map[string]int)
+		local := make(map[string]int)
+		for i, k := range fkeys {
+			foreign[names[i].Foreign]++
+			local[k.ForeignTable+"."+names[i].Local]++
+			if k.ForeignTable == k.Table && names[i].Local == names[i].Foreign {
+				local[k.ForeignTable+"."+names[i].Local]++
+			}
+		}
+
+		for i, k := range fkeys {
+			column := k.Column
+			if !withSuffix {
+				column = trimSuffixes(column)
+			}
+
+			if foreign[names[i].Foreign] > 1 {
+				names[i].Foreign = inf.TitleCase(column) + inf.TitleCase(inf.Singular(k.ForeignTable))
+			}
+			if local[k.ForeignTable+"."+names[i].Local] > 1 {
+				plurality := inf.Plural
+				if k.Unique {
+					plurality = inf.Singular
+				}
+				names[i].Local = inf.TitleCase(column) + inf.TitleCase(plurality(k.Table))
+			}
+		}
+	}
+}
+
+// CheckAliases ensures no two tables are given the same Go names and no two
+// columns or relationships of a table the same field name, which would
+// otherwise only show up as compile errors in the generated code. A table's
+// singular and plural names must also differ since the first names the
+// struct and the second the query function.
+func CheckAliases(a Aliases, tables []drivers.Table) error {
+	upNames := make(map[string]string)
+	downNames := make(map[string]string)
+
+	for _, t := range tables {
+		if t.IsJoinTable {
+			continue
+		}
+
+		table := a.Table(t.Name)
+		if table.UpSingular == table.UpPlural {
+			return errors.Errorf("table %s has the same singular and plural name %s, set one with an alias", t.Name, table.UpSingular)
+		}
+
+		for _, name := range []string{table.UpSingular, table.UpPlural} {
+			if other, ok := upNames[name]; ok {
+				return errors.Errorf("tables %s and %s are both named %s, set one with an alias", other, t.Name, name)
+			}
+			upNames[name] = t.Name
+		}
+
+		if other, ok := downNames[table.DownSingular]; ok {
+			return errors.Errorf("tables %s and %s are both named %s, set one with an alias", other, t.Name, table.DownSingular)
+		}
+		downNames[table.DownSingular] = t.Name
+
+		columns := make(map[string]string)
+		for _, c := range t.Columns {
+			name := table.Column(c.Name)
+			if other, ok := columns[name]; ok {
+				return errors.Errorf("columns %s and %s of table %s are both named %s, set one with an alias", other, c.Name, t.Name, name)
+			}
+			columns[name] = c.Name
+		}
+
+		if _, err := checkRelationshipAliases(a, t, columns); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkRelationshipAliases ensures the relationships of a table, which are
+// named after the foreign key they're for, each get a method name of their
+// own that isn't also the name of a column's field. It returns the foreign
+// keys of the relationships keyed by their names.
+func checkRelationshipAliases(a Aliases, t drivers.Table, columns map[string]string) (map[string]string, error) {
+	table := a.Table(t.Name)
+	rels := make(map[string]string)
+
+	check := func(name, fkey string) error {
+		if column, ok := columns[name]; ok {
+			return errors.Errorf("relationship %s of table %s is named %s like the column %s, set another name with an alias", fkey, t.Name, name, column)
+		}
+		if other, ok := rels[name]; ok {
+			return errors.Errorf("relationships %s and %s of table %s are both named %s, set one with an alias", other, fkey, t.Name, name)
+		}
+		rels[name] = fkey
+		return nil
+	}
+
+	for _, fkey := range t.FKeys {
+		if err := check(table.Relationship(fkey.Name).Foreign, fkey.Name); err != nil {
+			return nil, err
+		}
+	}
+	for _, rel := range t.ToOneRelationships {
+		if err := check(a.Table(rel.ForeignTable).Relationship(rel.Name).Local, rel.Name); err != nil {
+			return nil, err
+		}
+	}
+	for _, rel := range t.ToManyRelationships {
+		name := a.ManyRelationship(rel.ForeignTable, rel.Name, rel.JoinTable, rel.JoinLocalFKeyName).Local
+		fkey := rel.Name
+		if rel.ToJoinTable {
+			fkey = rel.JoinLocalFKeyName
+		}
+		if err := check(name, fkey); err != nil {
+			return nil, err
+		}
+	}
+
+	return rels, nil
+}
+
 // Table gets a table alias, panics if not found.
 func (a Aliases) Table(table string) TableAlias {
 	t, ok := a.Tables[table]
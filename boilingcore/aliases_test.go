@@ -62,6 +62,31 @@ func TestAliasesTables(t *testing.T) {
 			t.Error("it should not alter things that were specified by user")
 		}
 	})
+
+	t.Run("Inflections", func(t *testing.T) {
+		tables := []drivers.Table{
+			{Name: "search_criteria"},
+			{Name: "sheep", Columns: []drivers.Column{{Name: "sku_id"}}},
+		}
+		inf := Inflections{
+			Irregular:   map[string]string{"criterion": "criteria"},
+			Uncountable: []string{"sheep"},
+			Initialisms: []string{"SKU"},
+		}
+
+		a := Aliases{}
+		FillAliasesInflected(&a, tables, inf)
+
+		if got := a.Tables["search_criteria"]; got.UpSingular != "SearchCriterion" || got.DownPlural != "searchCriteria" {
+			t.Errorf("it should use the irregular words: %#v", got)
+		}
+		if got := a.Tables["sheep"]; got.UpSingular != "Sheep" || got.UpPlural != "Sheep" {
+			t.Errorf("it should use the uncountable words: %#v", got)
+		}
+		if got := a.Tables["sheep"].Columns["sku_id"]; got != "SKUID" {
+			t.Errorf("it should use the initialisms: %s", got)
+		}
+	})
 }
 
 func TestAliasesRelationships(t *testing.T) {
@@ -255,6 +280,192 @@ func TestAliasesRelationshipsJoinTable(t *testing.T) {
 	})
 }
 
+func TestCheckAliases(t *testing.T) {
+	t.Parallel()
+
+	tables := []drivers.Table{
+		{Name: "videos", Columns: []drivers.Column{{Name: "id"}, {Name: "name"}}},
+		{Name: "clips", Columns: []drivers.Column{{Name: "id"}}},
+		{Name: "video_clips", IsJoinTable: true, FKeys: []drivers.ForeignKey{
+			{Name: "fk_video_id", Table: "video_clips", Column: "video_id", ForeignTable: "videos", ForeignColumn: "id"},
+			{Name: "fk_clip_id", Table: "video_clips", Column: "clip_id", ForeignTable: "clips", ForeignColumn: "id"},
+		}},
+	}
+
+	tests := []struct {
+		Name    string
+		Aliases map[string]TableAlias
+		Err     bool
+	}{
+		{Name: "NoAliases"},
+		{Name: "Distinct", Aliases: map[string]TableAlias{
+			"clips": {UpPlural: "Shorts", UpSingular: "Short", DownSingular: "short"},
+		}},
+		{Name: "SameStruct", Err: true, Aliases: map[string]TableAlias{
+			"clips": {UpSingular: "Video"},
+		}},
+		{Name: "StructIsQuery", Err: true, Aliases: map[string]TableAlias{
+			"clips": {UpSingular: "Videos"},
+		}},
+		{Name: "SameVariable", Err: true, Aliases: map[string]TableAlias{
+			"clips": {DownSingular: "video"},
+		}},
+		{Name: "SingularIsPlural", Err: true, Aliases: map[string]TableAlias{
+			"clips": {UpPlural: "Clip"},
+		}},
+		{Name: "SameColumn", Err: true, Aliases: map[string]TableAlias{
+			"videos": {Columns: map[string]string{"name": "ID"}},
+		}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			a := Aliases{Tables: make(map[string]TableAlias)}
+			for name, alias := range test.Aliases {
+				a.Tables[name] = alias
+			}
+			FillAliases(&a, tables)
+
+			err := CheckAliases(a, tables)
+			if test.Err && err == nil {
+				t.Error("expected a collision error")
+			} else if !test.Err && err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestAliasesRelationshipsDisambiguate(t *testing.T) {
+	t.Parallel()
+
+	fkey := func(name, column string) drivers.ForeignKey {
+		return drivers.ForeignKey{Name: name, Table: "messages", Column: column, ForeignTable: "users", ForeignColumn: "id"}
+	}
+
+	tables := []drivers.Table{
+		{Name: "users", Columns: []drivers.Column{{Name: "id"}}},
+		{Name: "messages", Columns: []drivers.Column{{Name: "id"}}, FKeys: []drivers.ForeignKey{
+			fkey("fk_sender_id", "sender_id"),
+			fkey("fk_recipient_id", "recipient_id"),
+			fkey("fk_user", "user"),
+			fkey("fk_user_id", "user_id"),
+			fkey("fk_editor_id", "editor_id"),
+			fkey("fk_editor_uuid", "editor_uuid"),
+		}},
+	}
+
+	a := Aliases{Tables: map[string]TableAlias{
+		"messages": {Relationships: map[string]RelationshipAlias{
+			"fk_editor_uuid": {Foreign: "Reviser"},
+		}},
+	}}
+	FillAliases(&a, tables)
+
+	want := map[string]RelationshipAlias{
+		// Different columns are already told apart
+		"fk_sender_id":    {Local: "SenderMessages", Foreign: "Sender"},
+		"fk_recipient_id": {Local: "RecipientMessages", Foreign: "Recipient"},
+		// Both would be users.Messages
+		"fk_user":    {Local: "UserMessages", Foreign: "MessageUser"},
+		"fk_user_id": {Local: "UserIDMessages", Foreign: "User"},
+		// Both would be message.Editor and user.EditorMessages, aliases
+		// still win over the disambiguated names
+		"fk_editor_id":   {Local: "EditorIDMessages", Foreign: "EditorIDUser"},
+		"fk_editor_uuid": {Local: "EditorUUIDMessages", Foreign: "Reviser"},
+	}
+
+	got := a.Tables["messages"].Relationships
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want:\n%#v\ngot:\n%#v", want, got)
+	}
+
+	if err := CheckAliases(a, tables); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAliasesRelationshipsSelfReference(t *testing.T) {
+	t.Parallel()
+
+	tables := []drivers.Table{
+		{Name: "jets", Columns: []drivers.Column{{Name: "id"}}, FKeys: []drivers.ForeignKey{
+			{Name: "fk_jet_id", Table: "jets", Column: "jet_id", Unique: true, ForeignTable: "jets", ForeignColumn: "id"},
+		}},
+		{Name: "employees", Columns: []drivers.Column{{Name: "id"}}, FKeys: []drivers.ForeignKey{
+			{Name: "fk_manager_id", Table: "employees", Column: "manager_id", ForeignTable: "employees", ForeignColumn: "id"},
+		}},
+	}
+
+	a := Aliases{}
+	FillAliases(&a, tables)
+
+	// Both sides of jets.jet_id would be Jet on the same struct
+	if got := a.Tables["jets"].Relationships["fk_jet_id"]; got.Local != "JetJet" || got.Foreign != "Jet" {
+		t.Errorf("jets relationship wrong: %#v", got)
+	}
+	// The sides of employees.manager_id already differ
+	if got := a.Tables["employees"].Relationships["fk_manager_id"]; got.Local != "ManagerEmployees" || got.Foreign != "Manager" {
+		t.Errorf("employees relationship wrong: %#v", got)
+	}
+
+	if err := CheckAliases(a, tables); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCheckAliasesRelationships(t *testing.T) {
+	t.Parallel()
+
+	tables := []drivers.Table{
+		{Name: "users", Columns: []drivers.Column{{Name: "id"}}},
+		{Name: "videos", Columns: []drivers.Column{{Name: "id"}, {Name: "name"}, {Name: "author_id"}, {Name: "editor_id"}}, FKeys: []drivers.ForeignKey{
+			{Name: "fk_author_id", Table: "videos", Column: "author_id", ForeignTable: "users", ForeignColumn: "id"},
+			{Name: "fk_editor_id", Table: "videos", Column: "editor_id", ForeignTable: "users", ForeignColumn: "id"},
+		}},
+	}
+	tables[0].ToManyRelationships = drivers.ToManyRelationships("users", tables)
+
+	tests := []struct {
+		Name          string
+		Relationships map[string]RelationshipAlias
+		Err           bool
+	}{
+		{Name: "NoAliases"},
+		{Name: "Distinct", Relationships: map[string]RelationshipAlias{
+			"fk_author_id": {Local: "AuthoredVideos", Foreign: "Creator"},
+		}},
+		{Name: "SameForeign", Err: true, Relationships: map[string]RelationshipAlias{
+			"fk_editor_id": {Foreign: "Author"},
+		}},
+		{Name: "SameLocal", Err: true, Relationships: map[string]RelationshipAlias{
+			"fk_author_id": {Local: "Videos"},
+			"fk_editor_id": {Local: "Videos"},
+		}},
+		{Name: "SameAsColumn", Err: true, Relationships: map[string]RelationshipAlias{
+			"fk_author_id": {Foreign: "Name"},
+		}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			a := Aliases{Tables: map[string]TableAlias{
+				"videos": {Relationships: test.Relationships},
+			}}
+			FillAliases(&a, tables)
+
+			err := CheckAliases(a, tables)
+			if test.Err && err == nil {
+				t.Error("expected a collision error")
+			} else if !test.Err && err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
 func TestAliasHelpers(t *testing.T) {
 	t.Parallel()
 
@@ -3,6 +3,7 @@
 package boilingcore
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -10,6 +11,7 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"text/template"
 
 	"github.com/friendsofgo/errors"
 	"github.com/volatiletech/sqlboiler/v4/drivers"
@@ -33,6 +35,8 @@ var (
 	rgxValidTag = regexp.MustCompile(`[a-zA-Z_\.]+`)
 	// Column names must be in format column_name or table_name.column_name
 	rgxValidTableColumn = regexp.MustCompile(`^[\w]+\.[\w]+$|^[\w]+$`)
+	// Result types and relationships in the config must be exported Go names
+	rgxExportedName = regexp.MustCompile(`^[A-Z][A-Za-z0-9_]*$`)
 )
 
 // State holds the global data needed by most pieces to run
@@ -46,6 +50,26 @@ type State struct {
 
 	Templates     *templateList
 	TestTemplates *templateList
+
+	// written holds the files output by Run relative to the OutFolder
+	written map[string]struct{}
+	// outputFile names the files output by Run
+	outputFile *template.Template
+	// header is written at the top of the go files output by Run, it's nil
+	// without a Header in the config
+	header *template.Template
+	// buildConstraint holds the build constraint lines of the BuildTags
+	buildConstraint string
+	// dryRun holds what Run would have done to each file in a dry run
+	dryRun []dryRunFile
+}
+
+// outputFileData is given to the OutputFile and Header templates, Table and
+// Alias are empty for the single layout and the singleton files
+type outputFileData struct {
+	Table   string
+	Alias   TableAlias
+	PkgName string
 }
 
 // New creates a new state based off of the config
@@ -82,6 +106,38 @@ func New(config *Config) (*State, error) {
 		}
 	}()
 
+	if !isValidTagCasing(config.StructTagCasing) {
+		return nil, errors.Errorf("unknown struct tag casing %q, must be one of snake, camel, title or alias", config.StructTagCasing)
+	}
+	for tag, casing := range config.TagCases {
+		if !isValidTagCasing(casing) {
+			return nil, errors.Errorf("unknown casing %q for struct tag %s, must be one of snake, camel, title or alias", casing, tag)
+		}
+	}
+
+	if err := s.initOutputFile(); err != nil {
+		return nil, err
+	}
+	if err := s.initHeader(); err != nil {
+		return nil, err
+	}
+	if len(config.BuildTags) != 0 {
+		var err error
+		if s.buildConstraint, err = buildConstraint(config.BuildTags); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(config.AutoColumns.Created) == 0 {
+		config.AutoColumns.Created = "created_at"
+	}
+	if len(config.AutoColumns.Updated) == 0 {
+		config.AutoColumns.Updated = "updated_at"
+	}
+	if len(config.AutoColumns.Deleted) == 0 {
+		config.AutoColumns.Deleted = "deleted_at"
+	}
+
 	if len(config.Version) > 0 {
 		noEditDisclaimer = []byte(
 			fmt.Sprintf(noEditDisclaimerFmt, " "+config.Version+" "),
@@ -104,10 +160,36 @@ func New(config *Config) (*State, error) {
 		s.Config.Imports.Test.Standard = append(s.Config.Imports.Test.Standard, `"context"`)
 	}
 
+	if s.Config.AddValidation {
+		s.Config.Imports.All.Standard = append(s.Config.Imports.All.Standard, `"unicode/utf8"`)
+	}
+
+	if s.Config.AddEnumTypes {
+		s.processEnumTypes()
+		if s.Config.Imports.Singleton == nil {
+			s.Config.Imports.Singleton = make(importers.Map)
+		}
+		boilTypes := s.Config.Imports.Singleton["boil_types"]
+		boilTypes.Standard = append(boilTypes.Standard, `"database/sql/driver"`)
+		s.Config.Imports.Singleton["boil_types"] = boilTypes
+	}
+
+	if s.Config.NullablePointers {
+		s.processNullablePointers()
+	}
+
 	if err := s.processTypeReplacements(); err != nil {
 		return nil, err
 	}
 
+	if s.Config.AddInterfaces {
+		s.addInterfaceImports()
+	}
+
+	if err := checkOptimisticLock(s.Tables, s.Config.OptimisticLock); err != nil {
+		return nil, err
+	}
+
 	templates, err = s.initTemplates()
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to initialize templates")
@@ -128,6 +210,16 @@ func New(config *Config) (*State, error) {
 		return nil, errors.Wrap(err, "unable to initialize aliases")
 	}
 
+	if err := s.processJSONTypes(); err != nil {
+		return nil, err
+	}
+	if err := s.processResultTypes(); err != nil {
+		return nil, err
+	}
+	if err := s.checkEnumTypes(); err != nil {
+		return nil, err
+	}
+
 	return s, nil
 }
 
@@ -142,6 +234,9 @@ func (s *State) Run() error {
 		AddGlobal:         s.Config.AddGlobal,
 		AddPanic:          s.Config.AddPanic,
 		AddSoftDeletes:    s.Config.AddSoftDeletes,
+		AddEnumTypes:      s.Config.AddEnumTypes,
+		AddValidation:     s.Config.AddValidation,
+		AddInterfaces:     s.Config.AddInterfaces,
 		NoContext:         s.Config.NoContext,
 		NoHooks:           s.Config.NoHooks,
 		NoAutoTimestamps:  s.Config.NoAutoTimestamps,
@@ -149,9 +244,16 @@ func (s *State) Run() error {
 		NoDriverTemplates: s.Config.NoDriverTemplates,
 		NoBackReferencing: s.Config.NoBackReferencing,
 		StructTagCasing:   s.Config.StructTagCasing,
+		TagCases:          s.Config.TagCases,
 		TagIgnore:         make(map[string]struct{}),
 		Tags:              s.Config.Tags,
 		RelationTag:       s.Config.RelationTag,
+		AutoColumns:       s.Config.AutoColumns,
+		OptimisticLock:    s.Config.OptimisticLock,
+		JSONTypes:         s.Config.JSONTypes,
+		ResultTypes:       s.Config.ResultTypes,
+		Relationships:     s.Config.Relationships,
+		Polymorphic:       s.Config.Polymorphic,
 		Dialect:           s.Dialect,
 		Schema:            s.Schema,
 		LQ:                strmangle.QuoteCharacter(s.Dialect.LQ),
@@ -185,29 +287,101 @@ func (s *State) Run() error {
 		testDirExtMap = groupTemplates(s.TestTemplates)
 	}
 
+	// Each group of tables is output to the same files
+	var groups, testGroups [][]drivers.Table
 	for _, table := range s.Tables {
 		if table.IsJoinTable {
 			continue
 		}
 
-		data.Table = table
+		if s.Config.OutputLayout == OutputLayoutSingle && len(groups) != 0 {
+			groups[0] = append(groups[0], table)
+		} else {
+			groups = append(groups, []drivers.Table{table})
+		}
 
-		// Generate the regular templates
-		if err := generateOutput(s, regularDirExtMap, data); err != nil {
+		// The generated tests insert rows so there are none for views
+		if table.IsView {
+			continue
+		}
+		if s.Config.OutputLayout == OutputLayoutSingle && len(testGroups) != 0 {
+			testGroups[0] = append(testGroups[0], table)
+		} else {
+			testGroups = append(testGroups, []drivers.Table{table})
+		}
+	}
+
+	for _, tables := range groups {
+		if err := generateOutput(s, regularDirExtMap, data, tables); err != nil {
 			return errors.Wrap(err, "unable to generate output")
 		}
+	}
 
-		// Generate the test templates
-		if !s.Config.NoTests {
-			if err := generateTestOutput(s, testDirExtMap, data); err != nil {
+	if !s.Config.NoTests {
+		for _, tables := range testGroups {
+			if err := generateTestOutput(s, testDirExtMap, data, tables); err != nil {
 				return errors.Wrap(err, "unable to generate test output")
 			}
 		}
 	}
 
+	if s.Config.Clean {
+		removed, err := removeStaleFiles(s.Config.OutFolder, s.written, s.Config.DryRun)
+		if err != nil {
+			return errors.Wrap(err, "unable to clean output folder")
+		}
+		for _, path := range removed {
+			if s.Config.DryRun {
+				s.dryRun = append(s.dryRun, dryRunFile{Action: dryRunRemove, Path: path})
+			} else if s.Config.Debug {
+				fmt.Fprintln(os.Stderr, "removed stale file:", path)
+			}
+		}
+	}
+
+	if s.Config.DryRun {
+		writeDryRunSummary(dryRunOut, s.dryRun)
+	}
+
 	return nil
 }
 
+// outputFileName is the name of the files the templates of tables are
+// output to, without the extension
+func (s *State) outputFileName(tables []drivers.Table) (string, error) {
+	data := s.outputFileData(tables)
+
+	buf := &bytes.Buffer{}
+	if err := s.outputFile.Execute(buf, data); err != nil {
+		return "", errors.Wrap(err, "failed to execute output file name template")
+	}
+	if buf.Len() == 0 {
+		return "", errors.Errorf("output file name template gave an empty name for %s", data.Table)
+	}
+
+	return buf.String(), nil
+}
+
+// outputFileData is what the templates naming and heading the file of
+// tables are given
+func (s *State) outputFileData(tables []drivers.Table) outputFileData {
+	data := outputFileData{PkgName: s.Config.PkgName}
+	if s.Config.OutputLayout != OutputLayoutSingle {
+		data.Table = tables[0].Name
+		data.Alias = s.Config.Aliases.Table(tables[0].Name)
+	}
+
+	return data
+}
+
+// markWritten records a file output by Run, name is relative to the OutFolder
+func (s *State) markWritten(name string) {
+	if s.written == nil {
+		s.written = make(map[string]struct{})
+	}
+	s.written[name] = struct{}{}
+}
+
 // Cleanup closes any resources that must be closed
 func (s *State) Cleanup() error {
 	// Nothing here atm, used to close the driver
@@ -217,7 +391,8 @@ func (s *State) Cleanup() error {
 // initTemplates loads all template folders into the state object.
 //
 // If TemplateDirs is set it uses those, else it pulls from assets.
-// Then it allows drivers to override, followed by replacements.
+// Then it allows drivers to override, followed by ExtraTemplateDirs
+// and replacements.
 //
 // Because there's the chance for windows paths to jumped in
 // all paths are converted to the native OS's slash style.
@@ -229,20 +404,8 @@ func (s *State) initTemplates() ([]lazyTemplate, error) {
 
 	templates := make(map[string]templateLoader)
 	if len(s.Config.TemplateDirs) != 0 {
-		for _, dir := range s.Config.TemplateDirs {
-			abs, err := filepath.Abs(dir)
-			if err != nil {
-				return nil, errors.Wrap(err, "could not find abs dir of templates directory")
-			}
-
-			base := filepath.Base(abs)
-			root := filepath.Dir(abs)
-			tpls, err := findTemplates(root, base)
-			if err != nil {
-				return nil, err
-			}
-
-			mergeTemplates(templates, tpls)
+		if err := findTemplateDirs(templates, s.Config.TemplateDirs); err != nil {
+			return nil, err
 		}
 	} else {
 		for _, a := range templatebin.AssetNames() {
@@ -263,6 +426,10 @@ func (s *State) initTemplates() ([]lazyTemplate, error) {
 		}
 	}
 
+	if err := findTemplateDirs(templates, s.Config.ExtraTemplateDirs); err != nil {
+		return nil, err
+	}
+
 	for _, replace := range s.Config.Replacements {
 		splits := strings.Split(replace, ";")
 		if len(splits) != 2 {
@@ -342,6 +509,28 @@ func groupTemplates(templates *templateList) dirExtMap {
 	return dirs
 }
 
+// findTemplateDirs finds the templates in each of dirs and merges them into
+// templates, later directories overriding templates of the same name.
+func findTemplateDirs(templates map[string]templateLoader, dirs []string) error {
+	for _, dir := range dirs {
+		abs, err := filepath.Abs(dir)
+		if err != nil {
+			return errors.Wrap(err, "could not find abs dir of templates directory")
+		}
+
+		base := filepath.Base(abs)
+		root := filepath.Dir(abs)
+		tpls, err := findTemplates(root, base)
+		if err != nil {
+			return err
+		}
+
+		mergeTemplates(templates, tpls)
+	}
+
+	return nil
+}
+
 // findTemplates uses a root path: (/home/user/gopath/src/../sqlboiler/)
 // and a base path: /templates
 // to create a bunch of file loaders of the form:
@@ -351,6 +540,9 @@ func findTemplates(root, base string) (map[string]templateLoader, error) {
 	templates := make(map[string]templateLoader)
 	rootBase := filepath.Join(root, base)
 	err := filepath.Walk(rootBase, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return errors.Wrapf(err, "could not read templates in: %s", rootBase)
+		}
 		if fi.IsDir() {
 			return nil
 		}
@@ -388,12 +580,21 @@ func (s *State) initDBInfo(config map[string]interface{}) error {
 		return errors.New("no tables found in database")
 	}
 
+	if err := setPKeys(dbInfo.Tables, s.Config.PrimaryKeys); err != nil {
+		return err
+	}
 	if err := checkPKeys(dbInfo.Tables); err != nil {
 		return err
 	}
 
 	s.Schema = dbInfo.Schema
-	s.Tables = dbInfo.Tables
+	var skipped []string
+	s.Tables, skipped = filterForeignTables(dbInfo.Tables)
+	if s.Config.Debug {
+		for _, skip := range skipped {
+			fmt.Fprintln(os.Stderr, "skipped", skip)
+		}
+	}
 	s.Dialect = dbInfo.Dialect
 
 	return nil
@@ -430,6 +631,9 @@ func (s *State) processTypeReplacements() error {
 					t.Columns[j] = columnMerge(c, r.Replace)
 
 					if len(r.Imports.Standard) != 0 || len(r.Imports.ThirdParty) != 0 {
+						if s.Config.Imports.BasedOnType == nil {
+							s.Config.Imports.BasedOnType = make(importers.Map)
+						}
 						s.Config.Imports.BasedOnType[t.Columns[j].Type] = importers.Set{
 							Standard:   r.Imports.Standard,
 							ThirdParty: r.Imports.ThirdParty,
@@ -443,6 +647,257 @@ func (s *State) processTypeReplacements() error {
 	return nil
 }
 
+// processEnumTypes replaces the string type of non-nullable columns that use
+// a named enum with the type generated for that enum in boil_types. Enums
+// whose values are not valid Go identifiers keep their string type since no
+// constants can be generated for them.
+func (s *State) processEnumTypes() {
+	for i := range s.Tables {
+		t := s.Tables[i]
+
+		for j := range t.Columns {
+			c := t.Columns[j]
+			if c.Type != "string" {
+				continue
+			}
+
+			name := strmangle.ParseEnumName(c.DBType)
+			vals := strmangle.ParseEnumVals(c.DBType)
+			if len(name) == 0 || len(vals) == 0 || !strmangle.IsEnumNormal(vals) {
+				continue
+			}
+
+			t.Columns[j].Type = strmangle.TitleCase(name)
+		}
+	}
+}
+
+// checkEnumTypes ensures the types generated for the named enums with
+// AddEnumTypes don't take a name the models, JSON types or result types are
+// already generated with.
+func (s *State) checkEnumTypes() error {
+	if !s.Config.AddEnumTypes {
+		return nil
+	}
+
+	names := modelNames(s.Config.Aliases, s.Tables)
+	for key := range s.Config.JSONTypes {
+		dot := strings.IndexByte(key, '.')
+		tableAlias := s.Config.Aliases.Table(key[:dot])
+		names[tableAlias.UpSingular+tableAlias.Column(key[dot+1:])] = "the json type of " + key
+	}
+	for _, r := range s.Config.ResultTypes {
+		names[r.Name] = "a result type"
+	}
+
+	for _, t := range s.Tables {
+		for _, c := range t.Columns {
+			name := strmangle.ParseEnumName(c.DBType)
+			vals := strmangle.ParseEnumVals(c.DBType)
+			if len(name) == 0 || len(vals) == 0 || !strmangle.IsEnumNormal(vals) {
+				continue
+			}
+
+			typ := strmangle.TitleCase(name)
+			if what, ok := names[typ]; ok {
+				return errors.Errorf("the type %s of enum %s is also the name of %s", typ, name, what)
+			}
+		}
+	}
+
+	return nil
+}
+
+// modelNames returns the package level names the models are generated with,
+// their struct, slice and the function starting their queries, along with
+// what each of them is.
+func modelNames(a Aliases, tables []drivers.Table) map[string]string {
+	names := make(map[string]string)
+	for _, t := range tables {
+		if t.IsJoinTable {
+			continue
+		}
+
+		table := a.Table(t.Name)
+		names[table.UpSingular] = fmt.Sprintf("the model of table %q", t.Name)
+		names[table.UpSingular+"Slice"] = fmt.Sprintf("the slice of the model of table %q", t.Name)
+		names[table.UpPlural] = fmt.Sprintf("the query function of table %q", t.Name)
+	}
+
+	return names
+}
+
+// nullablePointerTypes are the pointers used instead of the null package's
+// types when generating nullable columns as pointers
+var nullablePointerTypes = map[string]string{
+	"null.String":  "*string",
+	"null.Byte":    "*byte",
+	"null.Bool":    "*bool",
+	"null.Int":     "*int",
+	"null.Int8":    "*int8",
+	"null.Int16":   "*int16",
+	"null.Int32":   "*int32",
+	"null.Int64":   "*int64",
+	"null.Uint":    "*uint",
+	"null.Uint8":   "*uint8",
+	"null.Uint16":  "*uint16",
+	"null.Uint32":  "*uint32",
+	"null.Uint64":  "*uint64",
+	"null.Float32": "*float32",
+	"null.Float64": "*float64",
+	"null.Time":    "*time.Time",
+}
+
+// processNullablePointers changes the nullable columns using one of the
+// null package's types to use a pointer to the Go type instead
+func (s *State) processNullablePointers() {
+	for i := range s.Tables {
+		t := s.Tables[i]
+
+		for j := range t.Columns {
+			c := t.Columns[j]
+			if !c.Nullable {
+				continue
+			}
+
+			if ptr, ok := nullablePointerTypes[c.Type]; ok {
+				t.Columns[j].Type = ptr
+			}
+		}
+	}
+}
+
+// processJSONTypes replaces the type of the columns in JSONTypes with a type
+// generated for each of them, which has the Scan and Value methods that
+// unmarshal and marshal the configured type. It's named after the aliases of
+// the table and column, ex: JetManifest for jets.manifest.
+func (s *State) processJSONTypes() error {
+	for key, jsonType := range s.Config.JSONTypes {
+		dot := strings.IndexByte(key, '.')
+		if dot < 0 {
+			return errors.Errorf("json type %q must be keyed by table.column", key)
+		}
+		if len(jsonType.Type) == 0 {
+			return errors.Errorf("json type for %s has no type", key)
+		}
+
+		tableName, colName := key[:dot], key[dot+1:]
+		var col *drivers.Column
+		if t := findTable(s.Tables, tableName); t != nil {
+			col = findColumn(*t, colName)
+		}
+		if col == nil {
+			return errors.Errorf("json type configured for unknown column %s", key)
+		}
+
+		tableAlias := s.Config.Aliases.Table(tableName)
+		col.Type = tableAlias.UpSingular + tableAlias.Column(colName)
+
+		if s.Config.Imports.BasedOnType == nil {
+			s.Config.Imports.BasedOnType = make(importers.Map)
+		}
+		s.Config.Imports.BasedOnType[col.Type] = importers.Set{
+			Standard:   append(importers.List{`"database/sql/driver"`, `"encoding/json"`}, jsonType.Imports.Standard...),
+			ThirdParty: jsonType.Imports.ThirdParty,
+		}
+	}
+
+	return nil
+}
+
+// processResultTypes checks the ResultTypes and adds the imports of
+// boil_result_types, where their structs are generated. The fields of a
+// struct are named after the title case of its columns.
+func (s *State) processResultTypes() error {
+	if len(s.Config.ResultTypes) == 0 {
+		return nil
+	}
+
+	models := modelNames(s.Config.Aliases, s.Tables)
+
+	if s.Config.Imports.Singleton == nil {
+		s.Config.Imports.Singleton = make(importers.Map)
+	}
+
+	boilResultTypes := s.Config.Imports.Singleton["boil_result_types"]
+	boilResultTypes.Standard = append(boilResultTypes.Standard, `"database/sql"`)
+	boilResultTypes.ThirdParty = append(boilResultTypes.ThirdParty,
+		`"github.com/friendsofgo/errors"`,
+		`"github.com/volatiletech/sqlboiler/v4/boil"`,
+		`"github.com/volatiletech/sqlboiler/v4/queries"`,
+	)
+	if !s.Config.NoContext {
+		boilResultTypes.Standard = append(boilResultTypes.Standard, `"context"`)
+	}
+
+	names := make(map[string]struct{})
+	var colTypes []string
+	for _, r := range s.Config.ResultTypes {
+		if !rgxExportedName.MatchString(r.Name) {
+			return errors.Errorf("result type %q must be an exported Go name", r.Name)
+		}
+		if _, ok := names[r.Name]; ok {
+			return errors.Errorf("result type %s is declared more than once", r.Name)
+		}
+		names[r.Name] = struct{}{}
+		for _, name := range []string{r.Name, r.Name + "Slice"} {
+			if what, ok := models[name]; ok {
+				return errors.Errorf("result type %s declares %s which is also the name of %s", r.Name, name, what)
+			}
+		}
+		if len(r.Columns) == 0 {
+			return errors.Errorf("result type %s has no columns", r.Name)
+		}
+
+		fields := make(map[string]string)
+		for _, c := range r.Columns {
+			if len(c.Name) == 0 || len(c.Type) == 0 {
+				return errors.Errorf("columns of result type %s need a name and a type", r.Name)
+			}
+
+			field := strmangle.TitleCase(c.Name)
+			if other, ok := fields[field]; ok {
+				return errors.Errorf("columns %q and %q of result type %s are both named %s", other, c.Name, r.Name, field)
+			}
+			fields[field] = c.Name
+
+			colTypes = append(colTypes, c.Type)
+		}
+
+		boilResultTypes.Standard = append(boilResultTypes.Standard, r.Imports.Standard...)
+		boilResultTypes.ThirdParty = append(boilResultTypes.ThirdParty, r.Imports.ThirdParty...)
+	}
+
+	s.Config.Imports.Singleton["boil_result_types"] = importers.AddTypeImports(boilResultTypes, s.Config.Imports.BasedOnType, colTypes)
+	return nil
+}
+
+// addInterfaceImports adds the imports of boil_interfaces, which takes the
+// primary keys of every model as arguments to Find.
+func (s *State) addInterfaceImports() {
+	if s.Config.Imports.Singleton == nil {
+		s.Config.Imports.Singleton = make(importers.Map)
+	}
+
+	boilInterfaces := s.Config.Imports.Singleton["boil_interfaces"]
+	boilInterfaces.ThirdParty = append(boilInterfaces.ThirdParty, `"github.com/volatiletech/sqlboiler/v4/boil"`)
+	if !s.Config.NoContext {
+		boilInterfaces.Standard = append(boilInterfaces.Standard, `"context"`)
+	}
+
+	var pkeyTypes []string
+	for _, t := range s.Tables {
+		if t.IsJoinTable || t.IsView || t.PKey == nil {
+			continue
+		}
+		for _, c := range t.PKey.Columns {
+			pkeyTypes = append(pkeyTypes, t.GetColumn(c).Type)
+		}
+	}
+
+	s.Config.Imports.Singleton["boil_interfaces"] = importers.AddTypeImports(boilInterfaces, s.Config.Imports.BasedOnType, pkeyTypes)
+}
+
 // matchColumn checks if a column 'c' matches specifiers in 'm'.
 // Anything defined in m is checked against a's values, the
 // match is a done using logical and (all specifiers must match).
@@ -543,6 +998,11 @@ func shouldReplaceInTable(t drivers.Table, r TypeReplace) bool {
 
 // initOutFolders creates the folders that will hold the generated output.
 func (s *State) initOutFolders(lazyTemplates []lazyTemplate) error {
+	// A dry run doesn't touch the file system
+	if s.Config.DryRun {
+		return nil
+	}
+
 	if s.Config.Wipe {
 		if err := os.RemoveAll(s.Config.OutFolder); err != nil {
 			return err
@@ -596,15 +1056,241 @@ func (s *State) initTags(tags []string) error {
 }
 
 func (s *State) initAliases(a *Aliases) error {
-	FillAliases(a, s.Tables)
+	FillAliasesInflected(a, s.Tables, s.Config.Inflections)
+	if err := CheckAliases(*a, s.Tables); err != nil {
+		return err
+	}
+
+	names := make(fieldNames)
+	if err := checkRelationships(*a, s.Tables, s.Config.Relationships, names); err != nil {
+		return err
+	}
+	return checkPolymorphic(*a, s.Tables, s.Config.Polymorphic, names)
+}
+
+// checkRelationships checks the relationships defined in the config. Their
+// loaders match the loaded rows up by the primary key of their table, and
+// their names can't clash with the fields and relationships it already has.
+func checkRelationships(a Aliases, tables []drivers.Table, rels []Relationship, names fieldNames) error {
+	for _, rel := range rels {
+		t := findTable(tables, rel.Table)
+		if t == nil {
+			return errors.Errorf("relationship %s configured for unknown table %q", rel.Name, rel.Table)
+		}
+		if t.IsView || t.IsJoinTable {
+			return errors.Errorf("relationship %s configured for %q, which has no relationships", rel.Name, rel.Table)
+		}
+		if t.PKey == nil || len(t.PKey.Columns) != 1 {
+			return errors.Errorf("relationship %s needs table %q to have a primary key of one column", rel.Name, rel.Table)
+		}
+		if !rgxExportedName.MatchString(rel.Name) {
+			return errors.Errorf("relationship %q of table %q must be an exported Go name", rel.Name, rel.Table)
+		}
+
+		f := findTable(tables, rel.ForeignTable)
+		if f == nil {
+			return errors.Errorf("relationship %s of table %q has the unknown foreign table %q", rel.Name, rel.Table, rel.ForeignTable)
+		}
+		if f.IsView || f.IsJoinTable {
+			return errors.Errorf("relationship %s of table %q can't load %q, views and join tables aren't supported", rel.Name, rel.Table, rel.ForeignTable)
+		}
+		if f.Name == t.Name {
+			return errors.Errorf("relationship %s of table %q can't refer to its own table, it's joined on by name", rel.Name, rel.Table)
+		}
+		if len(strings.TrimSpace(rel.On)) == 0 {
+			return errors.Errorf("relationship %s of table %q has no join condition", rel.Name, rel.Table)
+		}
+
+		if err := names.add(a, *t, rel.Name, "relationship "+rel.Name+" in the config"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkPolymorphic checks the polymorphic associations and fills in the
+// default names of their columns. An association gets a relationship from
+// its table to each of its types, named after the association and the model
+// of the type, and one back from each type named after its table.
+func checkPolymorphic(a Aliases, tables []drivers.Table, polymorphic []Polymorphic, names fieldNames) error {
+	for i := range polymorphic {
+		p := &polymorphic[i]
+
+		t := findTable(tables, p.Table)
+		if t == nil {
+			return errors.Errorf("polymorphic association %s configured for unknown table %q", p.Name, p.Table)
+		}
+		if t.IsView || t.IsJoinTable {
+			return errors.Errorf("polymorphic association %s configured for %q, which has no relationships", p.Name, p.Table)
+		}
+		name := strmangle.TitleCase(p.Name)
+		if !rgxExportedName.MatchString(name) {
+			return errors.Errorf("polymorphic association %q of table %q must have a name that makes a Go name", p.Name, p.Table)
+		}
+
+		if len(p.TypeColumn) == 0 {
+			p.TypeColumn = p.Name + "_type"
+		}
+		if len(p.IDColumn) == 0 {
+			p.IDColumn = p.Name + "_id"
+		}
+
+		typeCol := findColumn(*t, p.TypeColumn)
+		if typeCol == nil {
+			return errors.Errorf("type column %q of polymorphic association %s not found in table %q", p.TypeColumn, p.Name, p.Table)
+		}
+		if !strings.Contains(strings.ToLower(typeCol.Type), "string") {
+			return errors.Errorf("type column %q of polymorphic association %s must be a string, got %s", p.TypeColumn, p.Name, typeCol.Type)
+		}
+		idCol := findColumn(*t, p.IDColumn)
+		if idCol == nil {
+			return errors.Errorf("id column %q of polymorphic association %s not found in table %q", p.IDColumn, p.Name, p.Table)
+		}
+
+		if len(p.Types) == 0 {
+			return errors.Errorf("polymorphic association %s of table %q has no types", p.Name, p.Table)
+		}
+
+		owners := make([]string, 0, len(p.Types))
+		for owner := range p.Types {
+			owners = append(owners, owner)
+		}
+		sort.Strings(owners)
+
+		values := make(map[string]string)
+		for _, owner := range owners {
+			value := p.Types[owner]
+			if len(value) == 0 {
+				return errors.Errorf("table %q of polymorphic association %s has an empty type", owner, p.Name)
+			}
+			if other, ok := values[value]; ok {
+				return errors.Errorf("tables %q and %q of polymorphic association %s both have the type %q", other, owner, p.Name, value)
+			}
+			values[value] = owner
+
+			o := findTable(tables, owner)
+			if o == nil {
+				return errors.Errorf("polymorphic association %s of table %q has the unknown table %q", p.Name, p.Table, owner)
+			}
+			if o.IsView || o.IsJoinTable {
+				return errors.Errorf("polymorphic association %s of table %q can't belong to %q, views and join tables aren't supported", p.Name, p.Table, owner)
+			}
+			if o.PKey == nil || len(o.PKey.Columns) != 1 {
+				return errors.Errorf("polymorphic association %s needs table %q to have a primary key of one column", p.Name, owner)
+			}
+			pkeyCol := findColumn(*o, o.PKey.Columns[0])
+			if isPrimitive(pkeyCol.Type) && isPrimitive(idCol.Type) && pkeyCol.Type != idCol.Type {
+				return errors.Errorf("id column %q of polymorphic association %s has the type %s but the primary key of %q has the type %s", p.IDColumn, p.Name, idCol.Type, owner, pkeyCol.Type)
+			}
+
+			what := "relationship of polymorphic association " + p.Name
+			if err := names.add(a, *t, name+a.Table(owner).UpSingular, what); err != nil {
+				return err
+			}
+			if err := names.add(a, *o, a.Table(p.Table).UpPlural, what+" of table "+p.Table); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
-// checkPKeys ensures every table has a primary key column
+// fieldNames are the names taken by the fields and relationships of the
+// tables, keyed by table and name with what they name
+type fieldNames map[string]map[string]string
+
+// add takes the name of a relationship of t, it's an error when a column or
+// another relationship already has it
+func (f fieldNames) add(a Aliases, t drivers.Table, name, what string) error {
+	names, ok := f[t.Name]
+	if !ok {
+		table := a.Table(t.Name)
+		names = make(map[string]string)
+		for _, c := range t.Columns {
+			names[table.Column(c.Name)] = "column " + c.Name
+		}
+
+		fkeys, err := checkRelationshipAliases(a, t, names)
+		if err != nil {
+			return err
+		}
+		for name, fkey := range fkeys {
+			names[name] = "relationship " + fkey
+		}
+		f[t.Name] = names
+	}
+
+	if other, ok := names[name]; ok {
+		return errors.Errorf("%s of table %q is named %s like its %s", what, t.Name, name, other)
+	}
+	names[name] = what
+	return nil
+}
+
+func findTable(tables []drivers.Table, name string) *drivers.Table {
+	for i := range tables {
+		if tables[i].Name == name {
+			return &tables[i]
+		}
+	}
+	return nil
+}
+
+func findColumn(t drivers.Table, name string) *drivers.Column {
+	for i := range t.Columns {
+		if t.Columns[i].Name == name {
+			return &t.Columns[i]
+		}
+	}
+	return nil
+}
+
+// setPKeys gives the tables without a primary key in the database the
+// logical primary key configured for them, it's made of columns that
+// exist and can't be null
+func setPKeys(tables []drivers.Table, pkeys map[string][]string) error {
+	for tableName, colNames := range pkeys {
+		t := findTable(tables, tableName)
+		if t == nil {
+			return errors.Errorf("primary key configured for unknown table %q", tableName)
+		}
+		if t.IsView {
+			return errors.Errorf("primary key configured for view %q, views are read-only", tableName)
+		}
+		if t.PKey != nil {
+			return errors.Errorf("primary key configured for table %q which already has the primary key %s", tableName, t.PKey.Name)
+		}
+		if len(colNames) == 0 {
+			return errors.Errorf("primary key configured for table %q has no columns", tableName)
+		}
+
+		for _, colName := range colNames {
+			col := findColumn(*t, colName)
+			if col == nil {
+				return errors.Errorf("primary key column %q not found in table %q", colName, tableName)
+			}
+			if col.Nullable {
+				return errors.Errorf("primary key column %q in table %q must not be nullable", colName, tableName)
+			}
+		}
+
+		t.PKey = &drivers.PrimaryKey{
+			Name:    tableName + "_pkey",
+			Columns: colNames,
+		}
+	}
+
+	return nil
+}
+
+// checkPKeys ensures every table has a primary key column, views are
+// read-only and do not need one
 func checkPKeys(tables []drivers.Table) error {
 	var missingPkey []string
 	for _, t := range tables {
-		if t.PKey == nil {
+		if t.PKey == nil && !t.IsView {
 			missingPkey = append(missingPkey, t.Name)
 		}
 	}
@@ -616,6 +1302,133 @@ func checkPKeys(tables []drivers.Table) error {
 	return nil
 }
 
+// filterForeignTables removes the foreign keys and relationships that
+// refer to tables which are not being generated, like tables in another
+// schema, as well as the join tables using them. It also returns what was
+// removed of the tables being generated so it can be reported, models can't
+// refer to the packages of other schemas.
+func filterForeignTables(tables []drivers.Table) ([]drivers.Table, []string) {
+	names := make(map[string]bool, len(tables))
+	for _, t := range tables {
+		names[t.Name] = true
+	}
+
+	var skipped []string
+	filtered := make([]drivers.Table, 0, len(tables))
+	for _, t := range tables {
+		var fkeys []drivers.ForeignKey
+		var foreign []string
+		for _, fk := range t.FKeys {
+			if names[fk.ForeignTable] {
+				fkeys = append(fkeys, fk)
+			} else {
+				foreign = append(foreign, fmt.Sprintf("foreign key %s of table %q, table %q is not generated", fk.Name, t.Name, fk.ForeignTable))
+			}
+		}
+		if t.IsJoinTable && len(foreign) != 0 {
+			skipped = append(skipped, fmt.Sprintf("join table %q, it refers to a table that is not generated", t.Name))
+			continue
+		}
+		skipped = append(skipped, foreign...)
+		t.FKeys = fkeys
+
+		var toOne []drivers.ToOneRelationship
+		for _, rel := range t.ToOneRelationships {
+			if names[rel.ForeignTable] {
+				toOne = append(toOne, rel)
+			}
+		}
+		t.ToOneRelationships = toOne
+
+		var toMany []drivers.ToManyRelationship
+		for _, rel := range t.ToManyRelationships {
+			if names[rel.ForeignTable] {
+				toMany = append(toMany, rel)
+			}
+		}
+		t.ToManyRelationships = toMany
+
+		filtered = append(filtered, t)
+	}
+
+	return filtered, skipped
+}
+
+// initOutputFile checks the output layout and parses the template naming
+// the output files
+func (s *State) initOutputFile() error {
+	name := s.Config.OutputFile
+	switch s.Config.OutputLayout {
+	case "", OutputLayoutTable:
+		if len(name) == 0 {
+			name = "{{.Table}}"
+		}
+	case OutputLayoutSingle:
+		if len(name) == 0 {
+			name = "{{.PkgName}}"
+		}
+	default:
+		return errors.Errorf("unknown output layout %q, must be one of table or single", s.Config.OutputLayout)
+	}
+
+	var err error
+	s.outputFile, err = template.New("output_file").Funcs(templateFunctions).Parse(name)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse output file name template")
+	}
+
+	return nil
+}
+
+// initHeader parses the template of the header written at the top of
+// the generated go files
+func (s *State) initHeader() error {
+	if len(s.Config.Header) == 0 {
+		return nil
+	}
+
+	var err error
+	s.header, err = template.New("header").Funcs(templateFunctions).Parse(s.Config.Header)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse header template")
+	}
+
+	return nil
+}
+
+// isValidTagCasing checks the casing is one the struct templates know about,
+// an empty casing means snake case
+func isValidTagCasing(casing string) bool {
+	switch casing {
+	case "", "snake", "camel", "title", "alias":
+		return true
+	}
+	return false
+}
+
+// checkOptimisticLock ensures every table configured for optimistic locking
+// exists and has a non-nullable integer version column
+func checkOptimisticLock(tables []drivers.Table, lock map[string]string) error {
+	for tableName, colName := range lock {
+		t := findTable(tables, tableName)
+		if t == nil {
+			return errors.Errorf("optimistic lock configured for unknown table %q", tableName)
+		}
+		col := findColumn(*t, colName)
+		if col == nil {
+			return errors.Errorf("optimistic lock column %q not found in table %q", colName, tableName)
+		}
+
+		switch col.Type {
+		case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		default:
+			return errors.Errorf("optimistic lock column %q in table %q must be a non-nullable integer, got %s", colName, tableName, col.Type)
+		}
+	}
+
+	return nil
+}
+
 func mergeTemplates(dst, src map[string]templateLoader) {
 	for k, v := range src {
 		dst[k] = v
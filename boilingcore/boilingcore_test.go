@@ -4,12 +4,17 @@ import (
 	"bufio"
 	"bytes"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"reflect"
 	"regexp"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/volatiletech/sqlboiler/v4/importers"
@@ -42,16 +47,21 @@ func TestNew(t *testing.T) {
 	}()
 
 	config := &Config{
-		DriverName: "mock",
-		PkgName:    "models",
-		OutFolder:  out,
-		NoTests:    true,
+		DriverName:     "mock",
+		PkgName:        "models",
+		OutFolder:      out,
+		NoTests:        true,
+		AddSoftDeletes: true,
+		AddValidation:  true,
 		DriverConfig: map[string]interface{}{
 			drivers.ConfigSchema:    "schema",
 			drivers.ConfigBlacklist: []string{"hangars"},
 		},
-		Imports:   importers.NewDefaultImports(),
-		TagIgnore: []string{"pass"},
+		Imports:        importers.NewDefaultImports(),
+		TagIgnore:      []string{"pass"},
+		OptimisticLock: map[string]string{"airports": "lock_version"},
+		Tags:           []string{"db"},
+		TagCases:       map[string]string{"db": "camel", "yaml": "title"},
 	}
 
 	state, err = New(config)
@@ -63,6 +73,170 @@ func TestNew(t *testing.T) {
 		t.Errorf("Unable to execute State.Run: %s", err)
 	}
 
+	checkGeneratedContains(t, filepath.Join(out, "pilots.go"),
+		`func PilotExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {`,
+		`sql := "select exists(select 1 from \"pilots\" where \"id\"=$1 limit 1)"`,
+	)
+
+	// Timestamps are set automatically
+	checkGeneratedContains(t, filepath.Join(out, "airports.go"),
+		`if o.CreatedAt.IsZero() {`,
+		`queries.SetScanner(&o.UpdatedAt, currTime)`,
+		`wl = strmangle.SetComplement(wl, []string{"created_at"})`,
+	)
+
+	// Composite primary keys take every key column in order
+	checkGeneratedContains(t, filepath.Join(out, "jet_seats.go"),
+		`func FindJetSeat(ctx context.Context, exec boil.ContextExecutor, jetID int, seat string, selectCols ...string) (*JetSeat, error) {`,
+		`"select %s from \"jet_seats\" where \"jet_id\"=$1 AND \"seat\"=$2", sel,`,
+		`func JetSeatExists(ctx context.Context, exec boil.ContextExecutor, jetID int, seat string) (bool, error) {`,
+		`sql := "DELETE FROM \"jet_seats\" WHERE \"jet_id\"=$1 AND \"seat\"=$2"`,
+	)
+
+	// Updates of locked tables check and bump the version column
+	checkGeneratedContains(t, filepath.Join(out, "airports.go"),
+		`wl = append(strmangle.SetComplement(wl, []string{"lock_version"}), "lock_version")`,
+		`strmangle.WhereClause("\"", "\"", len(wl)+len(airportPrimaryKeyColumns)+1, []string{"lock_version"}),`,
+		`o.LockVersion++`,
+		`return 0, ErrOptimisticLock`,
+	)
+	checkGeneratedContains(t, filepath.Join(out, "boil_types.go"),
+		`var ErrOptimisticLock = errors.New(`,
+	)
+
+	// UpdateAll on a query is a single UPDATE using the query's where clause
+	checkGeneratedContains(t, filepath.Join(out, "pilots.go"),
+		`func (q pilotQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)`,
+	)
+
+	// Array columns are typed slices
+	checkGeneratedContains(t, filepath.Join(out, "pilots.go"),
+		"Ratings types.Int64Array  `",
+		"Tags    types.StringArray `",
+		`"github.com/volatiletech/sqlboiler/v4/types"`,
+	)
+
+	// Set columns get constants for their members
+	checkGeneratedContains(t, filepath.Join(out, "boil_types.go"),
+		`JetsPaintRed   = "red"`,
+		`JetsPaintBlue  = "blue"`,
+	)
+
+	// Struct tags use snake case unless configured per tag and nullable
+	// columns are omitted when empty
+	checkGeneratedContains(t, filepath.Join(out, "jets.go"),
+		"`db:\"airportID\" boil:\"airport_id\" json:\"airport_id\" toml:\"airport_id\" yaml:\"AirportID\"`",
+		"`db:\"color\" boil:\"color\" json:\"color,omitempty\" toml:\"color\" yaml:\"Color,omitempty\"`",
+	)
+
+	// Validate checks required columns and length limits
+	checkGeneratedContains(t, filepath.Join(out, "jets.go"),
+		`func (o *Jet) Validate() error {`,
+		`if len(o.Name) == 0 {`,
+		`if utf8.RuneCountInString(o.Name) > 10 {`,
+		`if o.Color.Valid && utf8.RuneCountInString(o.Color.String) > 20 {`,
+		`if o.Cargo == nil {`,
+	)
+
+	// Soft deletes can be undone and are hidden from finders
+	checkGeneratedContains(t, filepath.Join(out, "licenses.go"),
+		`func (o *License) SoftDelete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {`,
+		`func (o *License) Restore(ctx context.Context, exec boil.ContextExecutor) (int64, error) {`,
+		`sql := "UPDATE \"licenses\" SET \"deleted_at\" = NULL WHERE \"id\"=$1"`,
+		`"select %s from \"licenses\" where \"id\"=$1 and \"deleted_at\" is null", sel,`,
+	)
+	checkGeneratedContains(t, filepath.Join(out, "licenses.go"),
+		`mods = append(mods, qm.From("\"licenses\""), qmhelper.WhereNotDeleted("\"licenses\".\"deleted_at\""))`,
+	)
+	checkGeneratedContains(t, filepath.Join(out, "pilots.go"),
+		`qmhelper.WhereNotDeleted("\"licenses\".\"deleted_at\""),`,
+	)
+
+	// DeleteAll on a query is a single DELETE, or an UPDATE of the deleted
+	// column when soft deleting
+	checkGeneratedContains(t, filepath.Join(out, "licenses.go"),
+		`func (q licenseQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {`,
+		`	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
+
+	result, err := q.Query.ExecContext(ctx, exec)`,
+	)
+	checkGeneratedContains(t, filepath.Join(out, "pilots.go"),
+		`func (q pilotQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {`,
+		`	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)`,
+	)
+
+	// Count and Exists drop the selected columns of the query and ask the
+	// database rather than loading rows
+	checkGeneratedContains(t, filepath.Join(out, "pilots.go"),
+		`	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)`,
+		`	queries.SetSelect(q.Query, nil)
+	queries.SetExists(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&exists)`,
+	)
+
+	// Many-to-many relationships go through the join table
+	checkGeneratedContains(t, filepath.Join(out, "pilots.go"),
+		`func (pilotL) LoadLanguages(`,
+		`qm.InnerJoin("\"pilot_languages\" as \"a\" on \"languages\".\"id\" = \"a\".\"language_id\"")`,
+		`qm.WhereIn("\"a\".\"pilot_id\" in ?", args...)`,
+		`query := "insert into \"pilot_languages\" (\"pilot_id\", \"language_id\") values ($1, $2)"`,
+		`"delete from \"pilot_languages\" where \"pilot_id\" = $1 and \"language_id\" in (%s)"`,
+	)
+
+	// Eager loads given their own select keep the columns they're matched on
+	checkGeneratedContains(t, filepath.Join(out, "jets.go"),
+		`	if mods != nil {
+		mods.Apply(query)
+		// A load selecting its own columns still needs the one it's matched on
+		queries.EnsureSelect(query, `+"`jet_seats.jet_id`"+`)
+	}`,
+	)
+	checkGeneratedContains(t, filepath.Join(out, "pilots.go"),
+		`	if len(queries.GetSelect(query)) == 0 {
+		queries.SetSelect(query, []string{"\"languages\".id, \"languages\".language"})
+	}
+	// The join column is always last so the loaded objects can be matched up
+	queries.AppendSelect(query, "\"a\".\"pilot_id\"")`,
+		`err = results.Scan(append(ptrs, &localJoinCol)...)`,
+	)
+
+	// Views are read-only models without keys
+	checkGeneratedContains(t, filepath.Join(out, "pilot_stats.go"),
+		`func PilotStats(mods ...qm.QueryMod) pilotStatQuery {`,
+		`func (q pilotStatQuery) All(ctx context.Context, exec boil.ContextExecutor) (PilotStatSlice, error) {`,
+		`case boil.AfterSelectHook:`,
+	)
+	checkGeneratedOmits(t, filepath.Join(out, "pilot_stats.go"),
+		`) Insert(`,
+		`) Update(`,
+		`) Delete(`,
+		`) Upsert(`,
+		`func FindPilotStat(`,
+		`BeforeInsertHook`,
+	)
+
+	goTestGenerated(t, state.Config.OutFolder, "-c")
+}
+
+// goTestGenerated makes the generated package in dir a module using this
+// copy of sqlboiler and runs go test in it with args
+func goTestGenerated(t *testing.T, dir string, args ...string) {
+	t.Helper()
+
 	buf := &bytes.Buffer{}
 
 	cmd := exec.Command("go", "env", "GOMOD")
@@ -72,36 +246,176 @@ func TestNew(t *testing.T) {
 	}
 
 	cmd = exec.Command("go", "mod", "init", "github.com/volatiletech/sqlboiler-test")
-	cmd.Dir = state.Config.OutFolder
+	cmd.Dir = dir
 	cmd.Stderr = buf
 
 	if err = cmd.Run(); err != nil {
 		t.Errorf("go mod init cmd execution failed: %s", err)
-		outputCompileErrors(buf, state.Config.OutFolder)
+		outputCompileErrors(buf, dir)
 		fmt.Println()
 	}
 
 	cmd = exec.Command("go", "mod", "edit", fmt.Sprintf("-replace=github.com/volatiletech/sqlboiler/v4=%s", filepath.Dir(string(goModFilePath))))
-	cmd.Dir = state.Config.OutFolder
+	cmd.Dir = dir
 	cmd.Stderr = buf
 
 	if err = cmd.Run(); err != nil {
 		t.Errorf("go mod init cmd execution failed: %s", err)
-		outputCompileErrors(buf, state.Config.OutFolder)
+		outputCompileErrors(buf, dir)
 		fmt.Println()
 	}
 
-	cmd = exec.Command("go", "test", "-c")
-	cmd.Dir = state.Config.OutFolder
+	cmd = exec.Command("go", append([]string{"test"}, args...)...)
+	cmd.Dir = dir
 	cmd.Stderr = buf
+	cmd.Stdout = buf
 
 	if err = cmd.Run(); err != nil {
 		t.Errorf("go test cmd execution failed: %s", err)
-		outputCompileErrors(buf, state.Config.OutFolder)
+		t.Log(buf.String())
+		outputCompileErrors(buf, dir)
 		fmt.Println()
 	}
 }
 
+// testConfig returns the config the generator tests start from, it's for
+// the models of the mock driver without their tests in a temporary
+// directory that's removed at the end of the test
+func testConfig(t *testing.T) *Config {
+	t.Helper()
+
+	out, err := ioutil.TempDir("", "boil_test")
+	if err != nil {
+		t.Fatalf("unable to create tempdir: %s", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(out) })
+
+	return &Config{
+		DriverName: "mock",
+		PkgName:    "models",
+		OutFolder:  out,
+		NoTests:    true,
+		DriverConfig: map[string]interface{}{
+			drivers.ConfigSchema: "schema",
+		},
+		Imports: importers.NewDefaultImports(),
+	}
+}
+
+// generate runs the generator with config
+func generate(t *testing.T, config *Config) {
+	t.Helper()
+
+	s, err := New(config)
+	if err != nil {
+		t.Fatalf("Unable to create State using config: %s", err)
+	}
+	if err = s.Run(); err != nil {
+		t.Fatalf("Unable to execute State.Run: %s", err)
+	}
+}
+
+// generateModels generates models with a testConfig, changed by configure
+// if it's not nil, and returns the config
+func generateModels(t *testing.T, configure func(*Config)) *Config {
+	t.Helper()
+
+	config := testConfig(t)
+	if configure != nil {
+		configure(config)
+	}
+	generate(t, config)
+
+	return config
+}
+
+// borrowUpsert configures the generator to use the upsert of a driver and
+// its imports, the mock driver has no upsert of its own
+func borrowUpsert(driver string) func(*Config) {
+	return func(c *Config) {
+		c.ExtraTemplateDirs = append(c.ExtraTemplateDirs, filepath.Join("..", "drivers", "sqlboiler-"+driver, "driver", "override", "templates"))
+		c.Imports = importers.Merge(c.Imports, importers.Collection{
+			All: importers.Set{Standard: importers.List{`"strconv"`}},
+			Singleton: importers.Map{
+				driver + "_upsert": {
+					Standard:   importers.List{`"fmt"`, `"strings"`},
+					ThirdParty: importers.List{`"github.com/volatiletech/strmangle"`, `"github.com/volatiletech/sqlboiler/v4/drivers"`},
+				},
+			},
+		})
+	}
+}
+
+// runGeneratedTest adds src as a test file to the models generated in dir
+// and runs go test in it with args
+func runGeneratedTest(t *testing.T, dir, src string, args ...string) {
+	t.Helper()
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "generated_test.go"), []byte(src), 0664); err != nil {
+		t.Fatal(err)
+	}
+
+	goTestGenerated(t, dir, args...)
+}
+
+// generatedTest is a test function called Name in Src, a test file of the
+// generated models package
+type generatedTest struct {
+	Name string
+	Src  string
+}
+
+// runGeneratedTests adds each of the tests as a file to the models generated
+// in dir and runs them with a single go test, with args added to it
+func runGeneratedTests(t *testing.T, dir string, tests []generatedTest, args ...string) {
+	t.Helper()
+
+	names := make([]string, len(tests))
+	for i, test := range tests {
+		file := filepath.Join(dir, fmt.Sprintf("generated_%02d_test.go", i))
+		if err := ioutil.WriteFile(file, []byte(test.Src), 0664); err != nil {
+			t.Fatal(err)
+		}
+		names[i] = test.Name
+	}
+
+	goTestGenerated(t, dir, append([]string{"-run", "^(" + strings.Join(names, "|") + ")$"}, args...)...)
+}
+
+// checkGeneratedContains fails the test if the generated file does not
+// contain each of the expected snippets
+func checkGeneratedContains(t *testing.T, file string, snippets ...string) {
+	t.Helper()
+
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	for _, s := range snippets {
+		if !bytes.Contains(b, []byte(s)) {
+			t.Errorf("%s does not contain:\n%s", filepath.Base(file), s)
+		}
+	}
+}
+
+func checkGeneratedOmits(t *testing.T, file string, snippets ...string) {
+	t.Helper()
+
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	for _, s := range snippets {
+		if bytes.Contains(b, []byte(s)) {
+			t.Errorf("%s should not contain:\n%s", filepath.Base(file), s)
+		}
+	}
+}
+
 func outputCompileErrors(buf *bytes.Buffer, outFolder string) {
 	type errObj struct {
 		errMsg     string
@@ -303,3 +617,3135 @@ func TestProcessTypeReplacements(t *testing.T) {
 		t.Error("imports were not adjusted")
 	}
 }
+
+func TestProcessTypeReplacementsNumeric(t *testing.T) {
+	s := new(State)
+	s.Config = &Config{}
+	s.Tables = []drivers.Table{
+		{
+			Name: "products",
+			Columns: []drivers.Column{
+				{Name: "id", Type: "int", DBType: "integer"},
+				{Name: "price", Type: "types.Decimal", DBType: "numeric"},
+				{Name: "discount", Type: "types.NullDecimal", DBType: "numeric", Nullable: true},
+			},
+		},
+	}
+
+	s.Config.TypeReplaces = []TypeReplace{
+		{
+			Match: drivers.Column{
+				DBType: "numeric",
+			},
+			Replace: drivers.Column{
+				Type: "decimal.Decimal",
+			},
+			Imports: importers.Set{
+				ThirdParty: []string{`"github.com/shopspring/decimal"`},
+			},
+		},
+	}
+
+	if err := s.processTypeReplacements(); err != nil {
+		t.Fatal(err)
+	}
+
+	cols := s.Tables[0].Columns
+	if typ := cols[1].Type; typ != "decimal.Decimal" {
+		t.Error("type was wrong:", typ)
+	}
+	if typ := cols[2].Type; typ != "types.NullDecimal" {
+		t.Error("nullable column should not match, type was:", typ)
+	}
+
+	imps := importers.AddTypeImports(importers.Set{}, s.Config.Imports.BasedOnType, []string{cols[0].Type, cols[1].Type})
+	if len(imps.ThirdParty) != 1 || imps.ThirdParty[0] != `"github.com/shopspring/decimal"` {
+		t.Error("imports were wrong:", imps.ThirdParty)
+	}
+}
+
+func TestNewBlacklistPatterns(t *testing.T) {
+	out := generateModels(t, func(c *Config) {
+		c.DriverConfig[drivers.ConfigBlacklist] = []string{"hangars", "jet_*", "*_stats", "pilots.tag?"}
+	}).OutFolder
+
+	for _, excluded := range []string{"hangars.go", "jet_seats.go", "pilot_stats.go"} {
+		if _, err := os.Stat(filepath.Join(out, excluded)); !os.IsNotExist(err) {
+			t.Errorf("want no %s for a blacklisted table", excluded)
+		}
+	}
+
+	checkGeneratedOmits(t, filepath.Join(out, "jets.go"), `JetSeats`)
+	checkGeneratedContains(t, filepath.Join(out, "pilots.go"), `Ratings types.Int64Array`)
+	checkGeneratedOmits(t, filepath.Join(out, "pilots.go"), `Tags `)
+}
+
+func TestNewAliases(t *testing.T) {
+	config := generateModels(t, func(c *Config) {
+		c.DriverConfig[drivers.ConfigBlacklist] = []string{"hangars"}
+		c.Aliases = Aliases{Tables: map[string]TableAlias{
+			"jet_seats": {UpPlural: "Seats", UpSingular: "Seat", DownSingular: "seat"},
+		}}
+	})
+	out := config.OutFolder
+
+	checkGeneratedContains(t, filepath.Join(out, "jet_seats.go"),
+		`type Seat struct {`,
+		`func Seats(mods ...qm.QueryMod) seatQuery {`,
+		`func FindSeat(ctx context.Context, exec boil.ContextExecutor, jetID int, seat string, selectCols ...string) (*Seat, error) {`,
+	)
+	checkGeneratedOmits(t, filepath.Join(out, "jet_seats.go"), `type JetSeat `, `jetSeatQuery`)
+
+	config.Aliases = Aliases{Tables: map[string]TableAlias{
+		"jet_seats": {UpSingular: "Jet"},
+	}}
+	if _, err := New(config); err == nil {
+		t.Error("expected an error for two tables named Jet")
+	}
+}
+
+func TestNewRelationshipAliases(t *testing.T) {
+	config := generateModels(t, func(c *Config) {
+		c.DriverConfig[drivers.ConfigBlacklist] = []string{"hangars"}
+		c.Aliases = Aliases{Tables: map[string]TableAlias{
+			"jets": {Relationships: map[string]RelationshipAlias{
+				"jets_airport_id_fk": {Local: "Departures", Foreign: "Origin"},
+			}},
+		}}
+	})
+	out := config.OutFolder
+
+	checkGeneratedContains(t, filepath.Join(out, "jets.go"),
+		`func (o *Jet) Origin(mods ...qm.QueryMod) airportQuery {`,
+	)
+	checkGeneratedContains(t, filepath.Join(out, "airports.go"),
+		`func (o *Airport) Departures(mods ...qm.QueryMod) jetQuery {`,
+	)
+	checkGeneratedOmits(t, filepath.Join(out, "jets.go"), `func (o *Jet) Airport(`)
+
+	config.Aliases = Aliases{Tables: map[string]TableAlias{
+		"jets": {Relationships: map[string]RelationshipAlias{
+			"jets_airport_id_fk": {Foreign: "Pilot"},
+		}},
+	}}
+	if _, err := New(config); err == nil || !strings.Contains(err.Error(), "jets_pilot_id_fk and jets_airport_id_fk") {
+		t.Errorf("expected an error for two relationships of jets named Pilot, got: %v", err)
+	}
+}
+
+func TestNewExtraTemplates(t *testing.T) {
+	config := testConfig(t)
+	out := config.OutFolder
+
+	extra := filepath.Join(out, "extra_templates")
+	if err := os.MkdirAll(filepath.Join(extra, "singleton"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	method := `{{- $alias := .Aliases.Table .Table.Name}}
+// TableName returns the name of the table {{$alias.UpSingular}} is stored in
+func (o *{{$alias.UpSingular}}) TableName() string {
+	return "{{.Table.Name}}"
+}
+`
+	if err := ioutil.WriteFile(filepath.Join(extra, "99_table_name.go.tpl"), []byte(method), 0644); err != nil {
+		t.Fatal(err)
+	}
+	singleton := `var modelCount = {{len .Tables}}
+`
+	if err := ioutil.WriteFile(filepath.Join(extra, "singleton", "boil_count.go.tpl"), []byte(singleton), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	models := filepath.Join(out, "models")
+	config.OutFolder = models
+	config.ExtraTemplateDirs = []string{extra}
+	config.DriverConfig[drivers.ConfigWhitelist] = []string{"pilots", "jets"}
+	generate(t, config)
+
+	checkGeneratedContains(t, filepath.Join(models, "pilots.go"),
+		`type Pilot struct {`,
+		"func (o *Pilot) TableName() string {\n\treturn \"pilots\"\n}",
+	)
+	checkGeneratedContains(t, filepath.Join(models, "jets.go"),
+		`type Jet struct {`,
+		"func (o *Jet) TableName() string {\n\treturn \"jets\"\n}",
+	)
+	checkGeneratedContains(t, filepath.Join(models, "boil_count.go"), `var modelCount = 2`)
+	checkGeneratedContains(t, filepath.Join(models, "boil_queries.go"), `func NewQuery(`)
+
+	config.ExtraTemplateDirs = []string{filepath.Join(out, "missing")}
+	if _, err := New(config); err == nil {
+		t.Error("expected an error for a missing templates directory")
+	}
+}
+
+func TestNewSchemas(t *testing.T) {
+	config := testConfig(t)
+	out := config.OutFolder
+	config.Schemas = map[string]string{
+		"flights":     filepath.Join(out, "flights"),
+		"maintenance": filepath.Join(out, "hangar"),
+	}
+	config.DriverConfig[drivers.ConfigWhitelist] = []string{"pilots", "jets"}
+
+	for _, c := range config.SchemaConfigs() {
+		generate(t, c)
+	}
+
+	for dir, pkg := range map[string]string{"flights": "flights", "hangar": "hangar"} {
+		checkGeneratedContains(t, filepath.Join(out, dir, "pilots.go"), "package "+pkg+"\n", `type Pilot struct {`)
+		checkGeneratedContains(t, filepath.Join(out, dir, "jets.go"), "package "+pkg+"\n", `func (o *Jet) Pilot(`)
+		checkGeneratedContains(t, filepath.Join(out, dir, "boil_queries.go"), "package "+pkg+"\n")
+	}
+}
+
+func TestFilterForeignTables(t *testing.T) {
+	t.Parallel()
+
+	tables := []drivers.Table{
+		{
+			Name: "pilots",
+			ToManyRelationships: []drivers.ToManyRelationship{
+				{Table: "pilots", ForeignTable: "jets"},
+				{Table: "pilots", ForeignTable: "languages", ToJoinTable: true, JoinTable: "pilot_languages"},
+			},
+		},
+		{
+			Name: "jets",
+			FKeys: []drivers.ForeignKey{
+				{Name: "jets_pilot_id_fk", Table: "jets", ForeignTable: "pilots"},
+				{Name: "jets_hangar_id_fk", Table: "jets", ForeignTable: "hangars"},
+			},
+			ToOneRelationships: []drivers.ToOneRelationship{
+				{Table: "jets", ForeignTable: "licenses"},
+			},
+		},
+		{
+			Name:        "pilot_languages",
+			IsJoinTable: true,
+			FKeys: []drivers.ForeignKey{
+				{Name: "pilot_languages_pilot_id_fk", Table: "pilot_languages", ForeignTable: "pilots"},
+				{Name: "pilot_languages_language_id_fk", Table: "pilot_languages", ForeignTable: "languages"},
+			},
+		},
+	}
+
+	got, skipped := filterForeignTables(tables)
+	want := []string{
+		`foreign key jets_hangar_id_fk of table "jets", table "hangars" is not generated`,
+		`join table "pilot_languages", it refers to a table that is not generated`,
+	}
+	if !reflect.DeepEqual(skipped, want) {
+		t.Errorf("want the skipped foreign keys and join tables reported, got: %q", skipped)
+	}
+	if len(got) != 2 || got[0].Name != "pilots" || got[1].Name != "jets" {
+		t.Fatalf("want the join table to another schema removed, got: %#v", got)
+	}
+	if rels := got[0].ToManyRelationships; len(rels) != 1 || rels[0].ForeignTable != "jets" {
+		t.Errorf("want only the relationship to jets, got: %#v", rels)
+	}
+	if fkeys := got[1].FKeys; len(fkeys) != 1 || fkeys[0].Name != "jets_pilot_id_fk" {
+		t.Errorf("want only the foreign key to pilots, got: %#v", fkeys)
+	}
+	if rels := got[1].ToOneRelationships; len(rels) != 0 {
+		t.Errorf("want no one to one relationships, got: %#v", rels)
+	}
+}
+
+func TestNewClean(t *testing.T) {
+	config := generateModels(t, func(c *Config) {
+		c.Clean = true
+		c.DriverConfig[drivers.ConfigWhitelist] = []string{"pilots", "jets", "licenses"}
+	})
+	out := config.OutFolder
+
+	checkGeneratedContains(t, filepath.Join(out, "licenses.go"), `type License struct {`)
+
+	// A hand written file, one that was generated and then edited to remove
+	// the disclaimer, and another package in a sub folder
+	files := map[string]string{
+		"pilots_ext.go":  "package models\n",
+		"edited.go":      "// Hand edited\n" + string(noEditDisclaimer) + "package models\n",
+		"sub/hangars.go": string(noEditDisclaimer) + "package sub\n",
+	}
+	for name, contents := range files {
+		path := filepath.Join(out, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// licenses was dropped
+	config.DriverConfig[drivers.ConfigWhitelist] = []string{"pilots", "jets"}
+	generate(t, config)
+
+	for _, name := range []string{"pilots.go", "jets.go", "boil_queries.go", "pilots_ext.go", "edited.go", "sub/hangars.go"} {
+		if _, err := os.Stat(filepath.Join(out, name)); err != nil {
+			t.Errorf("%s should not have been removed: %v", name, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(out, "licenses.go")); !os.IsNotExist(err) {
+		t.Errorf("licenses.go should have been removed: %v", err)
+	}
+}
+
+func TestNewOutputLayout(t *testing.T) {
+	tests := []struct {
+		Layout string
+		File   string
+		Want   map[string][]string
+	}{
+		{
+			Want: map[string][]string{
+				"pilots.go": {`type Pilot struct {`},
+				"jets.go":   {`type Jet struct {`},
+			},
+		},
+		{
+			Layout: OutputLayoutTable,
+			File:   "{{.Alias.DownSingular}}_model",
+			Want: map[string][]string{
+				"pilot_model.go": {`type Pilot struct {`},
+				"jet_model.go":   {`type Jet struct {`},
+			},
+		},
+		{
+			Layout: OutputLayoutSingle,
+			Want: map[string][]string{
+				"models.go": {`type Pilot struct {`, `type Jet struct {`, `func Pilots(mods ...qm.QueryMod) pilotQuery {`},
+			},
+		},
+		{
+			Layout: OutputLayoutSingle,
+			File:   "all_{{.PkgName}}",
+			Want: map[string][]string{
+				"all_models.go": {`type Pilot struct {`, `type Jet struct {`},
+			},
+		},
+	}
+
+	for i, test := range tests {
+		out := generateModels(t, func(c *Config) {
+			c.OutputLayout = test.Layout
+			c.OutputFile = test.File
+			c.DriverConfig[drivers.ConfigWhitelist] = []string{"pilots", "jets"}
+		}).OutFolder
+
+		infos, err := ioutil.ReadDir(out)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var files []string
+		for _, info := range infos {
+			if !strings.HasPrefix(info.Name(), "boil_") {
+				files = append(files, info.Name())
+			}
+		}
+		if len(files) != len(test.Want) {
+			t.Errorf("%d) want %d model files, got: %v", i, len(test.Want), files)
+		}
+
+		for file, snippets := range test.Want {
+			checkGeneratedContains(t, filepath.Join(out, file), snippets...)
+		}
+	}
+
+	_, err := New(&Config{DriverName: "mock", OutputLayout: "schema"})
+	if err == nil {
+		t.Error("expected an error for an unknown output layout")
+	}
+	_, err = New(&Config{DriverName: "mock", OutputFile: "{{.Table"})
+	if err == nil {
+		t.Error("expected an error for a bad output file template")
+	}
+}
+
+func TestNewHeader(t *testing.T) {
+	config := generateModels(t, func(c *Config) {
+		c.Header = "// Copyright the {{.PkgName}} authors\n// Models of {{.Table}}\n\n//go:build !nomodels\n"
+		c.DriverConfig[drivers.ConfigWhitelist] = []string{"pilots", "jets"}
+	})
+	out := config.OutFolder
+
+	checkGeneratedContains(t, filepath.Join(out, "pilots.go"),
+		string(noEditDisclaimer)+"// Copyright the models authors\n// Models of pilots\n\n//go:build !nomodels\n\npackage models\n",
+	)
+	checkGeneratedContains(t, filepath.Join(out, "jets.go"), "// Models of jets\n")
+	checkGeneratedContains(t, filepath.Join(out, "boil_queries.go"),
+		string(noEditDisclaimer)+"// Copyright the models authors\n// Models of\n\n//go:build !nomodels\n\npackage models\n",
+	)
+
+	// The header keeps the files recognizable as generated ones
+	if generated, err := isGeneratedFile(filepath.Join(out, "pilots.go")); err != nil || !generated {
+		t.Errorf("pilots.go should be a generated file: %v", err)
+	}
+
+	goTestGenerated(t, out, "-run", "XXX")
+
+	config.Header = "Copyright the authors"
+	s, err := New(config)
+	if err != nil {
+		t.Fatalf("Unable to create State using config: %s", err)
+	}
+	if err = s.Run(); err == nil {
+		t.Error("expected an error for a header that is not a comment")
+	}
+
+	config.Header = "// {{.Table"
+	if _, err := New(config); err == nil {
+		t.Error("expected an error for a bad header template")
+	}
+}
+
+func TestNewBuildTags(t *testing.T) {
+	config := generateModels(t, func(c *Config) {
+		c.Header = "// Copyright the authors"
+		c.BuildTags = "!nomodels && (linux || darwin)"
+		c.DriverConfig[drivers.ConfigWhitelist] = []string{"pilots", "jets"}
+	})
+	out := config.OutFolder
+
+	constraint := "// Copyright the authors\n\n//go:build !nomodels && (linux || darwin)\n// +build !nomodels\n// +build linux darwin\n\npackage models\n"
+	checkGeneratedContains(t, filepath.Join(out, "pilots.go"), string(noEditDisclaimer)+constraint)
+	checkGeneratedContains(t, filepath.Join(out, "boil_queries.go"), string(noEditDisclaimer)+constraint)
+
+	// Pilot is declared twice unless the tags leave out the generated files
+	err := ioutil.WriteFile(filepath.Join(out, "nomodels.go"), []byte("//go:build nomodels\n\npackage models\n\ntype Pilot struct{}\n"), 0664)
+	if err != nil {
+		t.Fatal(err)
+	}
+	goTestGenerated(t, out, "-run", "XXX")
+	if err = os.Remove(filepath.Join(out, "go.mod")); err != nil {
+		t.Fatal(err)
+	}
+	goTestGenerated(t, out, "-tags", "nomodels", "-run", "XXX")
+
+	config.BuildTags = "!nomodels &&"
+	if _, err := New(config); err == nil {
+		t.Error("expected an error for bad build tags")
+	}
+}
+
+func TestNewDryRun(t *testing.T) {
+	saveDryRunOut := dryRunOut
+	defer func() {
+		dryRunOut = saveDryRunOut
+	}()
+	summary := &bytes.Buffer{}
+	dryRunOut = summary
+
+	config := testConfig(t)
+	out := filepath.Join(config.OutFolder, "models")
+	config.OutFolder = out
+	config.DryRun = true
+	config.DriverConfig[drivers.ConfigWhitelist] = []string{"pilots", "jets", "licenses"}
+
+	generate(t, config)
+	if _, err := os.Stat(out); !os.IsNotExist(err) {
+		t.Errorf("a dry run should not create the output folder: %v", err)
+	}
+	for _, line := range []string{
+		"create    " + filepath.Join(out, "pilots.go") + "\n",
+		"create    " + filepath.Join(out, "licenses.go") + "\n",
+		"dry run: 6 to create, 0 to overwrite, 0 unchanged, 0 to remove\n",
+	} {
+		if !strings.Contains(summary.String(), line) {
+			t.Errorf("summary does not contain %q:\n%s", line, summary.String())
+		}
+	}
+
+	config.DryRun = false
+	generate(t, config)
+
+	edited := []byte("// Code generated by SQLBoiler (edited)\npackage models\n")
+	if err := ioutil.WriteFile(filepath.Join(out, "jets.go"), edited, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// licenses was dropped
+	summary.Reset()
+	config.DryRun = true
+	config.Clean = true
+	config.DriverConfig[drivers.ConfigWhitelist] = []string{"pilots", "jets"}
+	generate(t, config)
+
+	for _, line := range []string{
+		"unchanged " + filepath.Join(out, "boil_queries.go") + "\n",
+		"overwrite " + filepath.Join(out, "jets.go") + "\n",
+		"remove    " + filepath.Join(out, "licenses.go") + "\n",
+	} {
+		if !strings.Contains(summary.String(), line) {
+			t.Errorf("summary does not contain %q:\n%s", line, summary.String())
+		}
+	}
+
+	if b, err := ioutil.ReadFile(filepath.Join(out, "jets.go")); err != nil || !bytes.Equal(b, edited) {
+		t.Errorf("a dry run should not overwrite files: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(out, "licenses.go")); err != nil {
+		t.Errorf("a dry run should not remove files: %v", err)
+	}
+}
+
+func TestNewStructTagCasing(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(&Config{DriverName: "mock", StructTagCasing: "kebab"})
+	if err == nil {
+		t.Error("expected an error for an unknown struct tag casing")
+	}
+}
+
+func TestCheckOptimisticLock(t *testing.T) {
+	t.Parallel()
+
+	tables := []drivers.Table{
+		{
+			Name: "pilots",
+			Columns: []drivers.Column{
+				{Name: "id", Type: "int"},
+				{Name: "version", Type: "int64"},
+				{Name: "maybe_version", Type: "null.Int64", Nullable: true},
+				{Name: "name", Type: "string"},
+			},
+		},
+	}
+
+	tests := []struct {
+		Lock map[string]string
+		Err  bool
+	}{
+		{Lock: nil},
+		{Lock: map[string]string{"pilots": "version"}},
+		{Lock: map[string]string{"jets": "version"}, Err: true},
+		{Lock: map[string]string{"pilots": "lock_version"}, Err: true},
+		{Lock: map[string]string{"pilots": "maybe_version"}, Err: true},
+		{Lock: map[string]string{"pilots": "name"}, Err: true},
+	}
+
+	for i, test := range tests {
+		err := checkOptimisticLock(tables, test.Lock)
+		if test.Err && err == nil {
+			t.Errorf("%d) expected an error", i)
+		} else if !test.Err && err != nil {
+			t.Errorf("%d) unexpected error: %v", i, err)
+		}
+	}
+}
+
+func TestSetPKeys(t *testing.T) {
+	t.Parallel()
+
+	tables := func() []drivers.Table {
+		return []drivers.Table{
+			{
+				Name: "pilots",
+				Columns: []drivers.Column{
+					{Name: "id", Type: "int"},
+					{Name: "code", Type: "string"},
+					{Name: "name", Type: "null.String", Nullable: true},
+				},
+			},
+			{
+				Name:    "jets",
+				Columns: []drivers.Column{{Name: "id", Type: "int"}},
+				PKey:    &drivers.PrimaryKey{Name: "jets_pkey", Columns: []string{"id"}},
+			},
+			{
+				Name:    "pilot_stats",
+				Columns: []drivers.Column{{Name: "pilot_id", Type: "int"}},
+				IsView:  true,
+			},
+		}
+	}
+
+	tests := []struct {
+		PKeys map[string][]string
+		Err   bool
+	}{
+		{PKeys: nil},
+		{PKeys: map[string][]string{"pilots": {"id"}}},
+		{PKeys: map[string][]string{"pilots": {"id", "code"}}},
+		{PKeys: map[string][]string{"airports": {"id"}}, Err: true},
+		{PKeys: map[string][]string{"jets": {"id"}}, Err: true},
+		{PKeys: map[string][]string{"pilot_stats": {"pilot_id"}}, Err: true},
+		{PKeys: map[string][]string{"pilots": {}}, Err: true},
+		{PKeys: map[string][]string{"pilots": {"uuid"}}, Err: true},
+		{PKeys: map[string][]string{"pilots": {"id", "name"}}, Err: true},
+	}
+
+	for i, test := range tests {
+		tbls := tables()
+		err := setPKeys(tbls, test.PKeys)
+		if test.Err {
+			if err == nil {
+				t.Errorf("%d) expected an error", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d) unexpected error: %v", i, err)
+			continue
+		}
+
+		if cols, ok := test.PKeys["pilots"]; ok {
+			if tbls[0].PKey == nil || !reflect.DeepEqual(tbls[0].PKey.Columns, cols) {
+				t.Errorf("%d) want the primary key %v, got: %#v", i, cols, tbls[0].PKey)
+			}
+		} else if tbls[0].PKey != nil {
+			t.Errorf("%d) want no primary key, got: %#v", i, tbls[0].PKey)
+		}
+	}
+}
+
+func TestCheckRelationships(t *testing.T) {
+	t.Parallel()
+
+	pkey := &drivers.PrimaryKey{Name: "pkey", Columns: []string{"id"}}
+	tables := []drivers.Table{
+		{Name: "pilots", PKey: pkey, Columns: []drivers.Column{{Name: "id"}, {Name: "name"}}},
+		{Name: "messages", PKey: pkey, Columns: []drivers.Column{{Name: "id"}, {Name: "sender_id"}}, FKeys: []drivers.ForeignKey{
+			{Name: "messages_sender_id_fk", Table: "messages", Column: "sender_id", ForeignTable: "pilots", ForeignColumn: "id"},
+		}},
+		{Name: "flight_logs", Columns: []drivers.Column{{Name: "id"}}},
+		{Name: "pilot_stats", Columns: []drivers.Column{{Name: "pilot_id"}}, IsView: true},
+	}
+	tables[0].ToManyRelationships = []drivers.ToManyRelationship{
+		{Name: "messages_sender_id_fk", Table: "pilots", Column: "id", ForeignTable: "messages", ForeignColumn: "sender_id"},
+	}
+
+	a := Aliases{}
+	FillAliases(&a, tables)
+
+	on := "messages.sender_id = pilots.id or messages.recipient_id = pilots.id"
+	tests := []struct {
+		Name string
+		Rels []Relationship
+		Err  bool
+	}{
+		{Name: "None"},
+		{Name: "ToMany", Rels: []Relationship{{Table: "pilots", Name: "AllMessages", ForeignTable: "messages", On: on, ToMany: true}}},
+		{Name: "ToOne", Rels: []Relationship{{Table: "messages", Name: "Author", ForeignTable: "pilots", On: "pilots.id = messages.sender_id"}}},
+		{Name: "UnknownTable", Err: true, Rels: []Relationship{{Table: "jets", Name: "AllMessages", ForeignTable: "messages", On: on}}},
+		{Name: "View", Err: true, Rels: []Relationship{{Table: "pilot_stats", Name: "AllMessages", ForeignTable: "messages", On: on}}},
+		{Name: "NoPrimaryKey", Err: true, Rels: []Relationship{{Table: "flight_logs", Name: "AllMessages", ForeignTable: "messages", On: on}}},
+		{Name: "Unexported", Err: true, Rels: []Relationship{{Table: "pilots", Name: "allMessages", ForeignTable: "messages", On: on}}},
+		{Name: "UnknownForeignTable", Err: true, Rels: []Relationship{{Table: "pilots", Name: "AllMessages", ForeignTable: "notes", On: on}}},
+		{Name: "ForeignView", Err: true, Rels: []Relationship{{Table: "pilots", Name: "Stats", ForeignTable: "pilot_stats", On: on}}},
+		{Name: "SelfReference", Err: true, Rels: []Relationship{{Table: "pilots", Name: "Friends", ForeignTable: "pilots", On: on}}},
+		{Name: "NoCondition", Err: true, Rels: []Relationship{{Table: "pilots", Name: "AllMessages", ForeignTable: "messages", On: " "}}},
+		{Name: "SameColumn", Err: true, Rels: []Relationship{{Table: "pilots", Name: "Name", ForeignTable: "messages", On: on}}},
+		{Name: "SameRelationship", Err: true, Rels: []Relationship{{Table: "pilots", Name: "SenderMessages", ForeignTable: "messages", On: on}}},
+		{Name: "SameConfigRelationship", Err: true, Rels: []Relationship{
+			{Table: "pilots", Name: "AllMessages", ForeignTable: "messages", On: on},
+			{Table: "pilots", Name: "AllMessages", ForeignTable: "messages", On: on},
+		}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			err := checkRelationships(a, tables, test.Rels, make(fieldNames))
+			if test.Err && err == nil {
+				t.Error("expected an error")
+			} else if !test.Err && err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestCheckPolymorphic(t *testing.T) {
+	t.Parallel()
+
+	pkey := &drivers.PrimaryKey{Name: "pkey", Columns: []string{"id"}}
+	tables := []drivers.Table{
+		{Name: "pilots", PKey: pkey, Columns: []drivers.Column{{Name: "id", Type: "int"}, {Name: "name", Type: "string"}}},
+		{Name: "jets", PKey: pkey, Columns: []drivers.Column{{Name: "id", Type: "int64"}}},
+		{Name: "comments", PKey: pkey, Columns: []drivers.Column{
+			{Name: "id", Type: "int"},
+			{Name: "commentable_type", Type: "string"},
+			{Name: "commentable_id", Type: "int"},
+			{Name: "subject_kind", Type: "null.String", Nullable: true},
+			{Name: "subject_ref", Type: "null.Int", Nullable: true},
+			{Name: "body", Type: "string"},
+		}},
+		{Name: "reviews", PKey: pkey, Columns: []drivers.Column{
+			{Name: "id", Type: "int"},
+			{Name: "commentable_type", Type: "string"},
+			{Name: "commentable_id", Type: "int"},
+			{Name: "commentable_pilot", Type: "string"},
+		}},
+		{Name: "pilot_stats", Columns: []drivers.Column{{Name: "pilot_id"}}, IsView: true},
+	}
+
+	a := Aliases{}
+	FillAliases(&a, tables)
+
+	pilots := map[string]string{"pilots": "Pilot"}
+	tests := []struct {
+		Name string
+		Poly Polymorphic
+		Err  bool
+	}{
+		{Name: "Defaults", Poly: Polymorphic{Table: "comments", Name: "commentable", Types: pilots}},
+		{Name: "Columns", Poly: Polymorphic{Table: "comments", Name: "subject", TypeColumn: "subject_kind", IDColumn: "subject_ref", Types: pilots}},
+		{Name: "UnknownTable", Err: true, Poly: Polymorphic{Table: "notes", Name: "commentable", Types: pilots}},
+		{Name: "View", Err: true, Poly: Polymorphic{Table: "pilot_stats", Name: "commentable", Types: pilots}},
+		{Name: "NoName", Err: true, Poly: Polymorphic{Table: "comments", TypeColumn: "commentable_type", IDColumn: "commentable_id", Types: pilots}},
+		{Name: "NoTypeColumn", Err: true, Poly: Polymorphic{Table: "comments", Name: "subject", Types: pilots}},
+		{Name: "TypeColumnNotString", Err: true, Poly: Polymorphic{Table: "comments", Name: "commentable", TypeColumn: "commentable_id", Types: pilots}},
+		{Name: "NoIDColumn", Err: true, Poly: Polymorphic{Table: "comments", Name: "commentable", IDColumn: "pilot_id", Types: pilots}},
+		{Name: "NoTypes", Err: true, Poly: Polymorphic{Table: "comments", Name: "commentable"}},
+		{Name: "EmptyType", Err: true, Poly: Polymorphic{Table: "comments", Name: "commentable", Types: map[string]string{"pilots": ""}}},
+		{Name: "SameType", Err: true, Poly: Polymorphic{Table: "comments", Name: "commentable", Types: map[string]string{"pilots": "Pilot", "comments": "Pilot"}}},
+		{Name: "UnknownType", Err: true, Poly: Polymorphic{Table: "comments", Name: "commentable", Types: map[string]string{"airports": "Airport"}}},
+		{Name: "ViewType", Err: true, Poly: Polymorphic{Table: "comments", Name: "commentable", Types: map[string]string{"pilot_stats": "PilotStat"}}},
+		{Name: "IDTypeMismatch", Err: true, Poly: Polymorphic{Table: "comments", Name: "commentable", Types: map[string]string{"jets": "Jet"}}},
+		{Name: "SameColumn", Err: true, Poly: Polymorphic{Table: "reviews", Name: "commentable", Types: pilots}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			polymorphic := []Polymorphic{test.Poly}
+			err := checkPolymorphic(a, tables, polymorphic, make(fieldNames))
+			if test.Err && err == nil {
+				t.Error("expected an error")
+			} else if !test.Err && err != nil {
+				t.Error(err)
+			}
+		})
+	}
+
+	polymorphic := []Polymorphic{{Table: "comments", Name: "commentable", Types: pilots}}
+	if err := checkPolymorphic(a, tables, polymorphic, make(fieldNames)); err != nil {
+		t.Fatal(err)
+	}
+	if p := polymorphic[0]; p.TypeColumn != "commentable_type" || p.IDColumn != "commentable_id" {
+		t.Errorf("want the default columns filled in, got: %#v", p)
+	}
+
+	// Both relationships of an association take their names
+	twice := []Polymorphic{polymorphic[0], {Table: "comments", Name: "subject", TypeColumn: "subject_kind", IDColumn: "subject_ref", Types: pilots}}
+	if err := checkPolymorphic(a, tables, twice, make(fieldNames)); err == nil {
+		t.Error("want an error for two associations giving pilots the same relationship")
+	}
+
+	names := make(fieldNames)
+	if err := checkPolymorphic(a, tables, polymorphic, names); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := names["comments"]["CommentablePilot"]; !ok {
+		t.Error("want the comments' relationship to their pilots named CommentablePilot")
+	}
+	if _, ok := names["pilots"]["Comments"]; !ok {
+		t.Error("want the pilots' relationship to their comments named Comments")
+	}
+	rels := []Relationship{{Table: "pilots", Name: "Comments", ForeignTable: "comments", On: "comments.commentable_id = pilots.id"}}
+	if err := checkRelationships(a, tables, rels, names); err == nil {
+		t.Error("want an error for a relationship named like one of an association")
+	}
+}
+
+func TestProcessEnumTypes(t *testing.T) {
+	s := new(State)
+	s.Config = &Config{AddEnumTypes: true}
+	s.Tables = []drivers.Table{
+		{
+			Columns: []drivers.Column{
+				{Name: "mood", Type: "string", DBType: "enum.mood('happy','sad')"},
+				{Name: "maybe_mood", Type: "null.String", DBType: "enum.mood('happy','sad')", Nullable: true},
+				{Name: "size", Type: "string", DBType: "enum('small','large')"},
+				{Name: "weird", Type: "string", DBType: "enum.weird('not ok!')"},
+				{Name: "name", Type: "string", DBType: "text"},
+			},
+		},
+	}
+
+	s.processEnumTypes()
+
+	want := []string{"Mood", "null.String", "string", "string", "string"}
+	for i, c := range s.Tables[0].Columns {
+		if c.Type != want[i] {
+			t.Errorf("%s: want type %s, got %s", c.Name, want[i], c.Type)
+		}
+	}
+}
+
+func TestCheckEnumTypes(t *testing.T) {
+	t.Parallel()
+
+	tables := []drivers.Table{
+		{
+			Name: "pilots",
+			Columns: []drivers.Column{
+				{Name: "id", Type: "int"},
+				{Name: "mood", Type: "string", DBType: "enum.mood('happy','sad')"},
+				{Name: "manifest", Type: "[]byte"},
+			},
+		},
+	}
+
+	tests := []struct {
+		Aliases     map[string]TableAlias
+		JSONTypes   map[string]JSONType
+		ResultTypes []ResultType
+		Err         string
+	}{
+		{},
+		{Aliases: map[string]TableAlias{"pilots": {UpSingular: "Mood"}}, Err: `model of table "pilots"`},
+		{Aliases: map[string]TableAlias{"pilots": {UpSingular: "Mo"}}},
+		{Aliases: map[string]TableAlias{"pilots": {UpPlural: "Mood"}}, Err: `query function of table "pilots"`},
+		{Aliases: map[string]TableAlias{"pilots": {UpSingular: "Mo", Columns: map[string]string{"manifest": "od"}}}, JSONTypes: map[string]JSONType{"pilots.manifest": {Type: "Manifest"}}, Err: "json type of pilots.manifest"},
+		{ResultTypes: []ResultType{{Name: "Mood"}}, Err: "result type"},
+	}
+
+	for i, test := range tests {
+		s := new(State)
+		s.Tables = tables
+		s.Config = &Config{AddEnumTypes: true, Aliases: Aliases{Tables: test.Aliases}, JSONTypes: test.JSONTypes, ResultTypes: test.ResultTypes}
+		FillAliases(&s.Config.Aliases, s.Tables)
+
+		err := s.checkEnumTypes()
+		if len(test.Err) == 0 {
+			if err != nil {
+				t.Errorf("%d) want no error, got: %v", i, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), test.Err) {
+			t.Errorf("%d) want an error about %s, got: %v", i, test.Err, err)
+		}
+	}
+}
+
+func TestProcessResultTypesModelNames(t *testing.T) {
+	t.Parallel()
+
+	tables := []drivers.Table{
+		{Name: "pilots", Columns: []drivers.Column{{Name: "id", Type: "int"}}},
+	}
+
+	tests := []struct {
+		Name string
+		Err  string
+	}{
+		{Name: "PilotCount"},
+		{Name: "Pilot", Err: `model of table "pilots"`},
+		{Name: "Pilots", Err: `query function of table "pilots"`},
+		{Name: "PilotSlice", Err: `slice of the model of table "pilots"`},
+	}
+
+	for i, test := range tests {
+		s := new(State)
+		s.Tables = tables
+		s.Config = &Config{
+			Imports:     importers.NewDefaultImports(),
+			ResultTypes: []ResultType{{Name: test.Name, Columns: []ResultColumn{{Name: "id", Type: "int"}}}},
+		}
+		FillAliases(&s.Config.Aliases, s.Tables)
+
+		err := s.processResultTypes()
+		if len(test.Err) == 0 {
+			if err != nil {
+				t.Errorf("%d) want no error, got: %v", i, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), test.Err) {
+			t.Errorf("%d) want an error about the %s, got: %v", i, test.Err, err)
+		}
+	}
+}
+
+func TestNewNullablePointers(t *testing.T) {
+	config := generateModels(t, func(c *Config) {
+		c.NullablePointers = true
+		c.AddSoftDeletes = true
+	})
+	tmp := config.OutFolder
+
+	checkGeneratedContains(t, filepath.Join(tmp, "airports.go"),
+		"*int ",
+		"*time.Time ",
+		"whereHelperptr_int",
+	)
+	checkGeneratedContains(t, filepath.Join(tmp, "licenses.go"),
+		"o.DeletedAt = new(time.Time)",
+	)
+	checkGeneratedOmits(t, filepath.Join(tmp, "jets.go"), "null.String")
+
+	// The generated tests randomize the pointer fields as the type they
+	// point to, leaving them nil for a zero value when they can be null
+	tmp2 := generateModels(t, func(c *Config) {
+		c.NullablePointers = true
+		c.AddSoftDeletes = true
+		c.NoTests = false
+	}).OutFolder
+
+	checkGeneratedContains(t, filepath.Join(tmp2, "licenses_test.go"),
+		`if o.DeletedAt == nil {`,
+	)
+
+	randomizeTest := `package models
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+)
+
+type nullMain struct{}
+
+func (nullMain) setup() error           { return nil }
+func (nullMain) conn() (*sql.DB, error) { return nil, nil }
+func (nullMain) teardown() error        { return nil }
+
+func init() {
+	dbMain = nullMain{}
+}
+
+func TestRandomizePointers(t *testing.T) {
+	seed := randomize.NewSeed()
+
+	var jet Jet
+	if err := randomizeStruct(seed, &jet, jetDBTypes, false); err != nil {
+		t.Fatal(err)
+	}
+	if jet.Color == nil || len(*jet.Color) == 0 || jet.Paint == nil || len(jet.Name) == 0 {
+		t.Errorf("want every field set when they can't be null: %#v", jet)
+	}
+
+	nulls := 0
+	for i := 0; i < 30; i++ {
+		var jet Jet
+		if err := randomizeStruct(seed, &jet, jetDBTypes, true); err != nil {
+			t.Fatal(err)
+		}
+		if jet.Color == nil {
+			nulls++
+		} else if len(*jet.Color) == 0 {
+			t.Error("want a nil pointer rather than a pointer to a zero value")
+		}
+	}
+	if nulls == 0 {
+		t.Error("want some nil pointers when they can be null")
+	}
+}
+`
+	runGeneratedTest(t, tmp2, randomizeTest, "-run", "TestRandomizePointers")
+}
+
+func TestNewInterfaces(t *testing.T) {
+	config := testConfig(t)
+	tmp := config.OutFolder
+	config.AddSoftDeletes = true
+
+	generate(t, config)
+	if _, err := os.Stat(filepath.Join(tmp, "boil_interfaces.go")); !os.IsNotExist(err) {
+		t.Errorf("boil_interfaces.go should only be generated when asked for: %v", err)
+	}
+
+	config.AddInterfaces = true
+	generate(t, config)
+
+	checkGeneratedContains(t, filepath.Join(tmp, "boil_interfaces.go"),
+		`Find(ctx context.Context, exec boil.ContextExecutor, jetID int, seat string, selectCols ...string) (*JetSeat, error)`,
+		`Delete(ctx context.Context, exec boil.ContextExecutor, o *License, hardDelete bool) (int64, error)`,
+		`return FindJetSeat(ctx, exec, jetID, seat, selectCols...)`,
+	)
+	checkGeneratedOmits(t, filepath.Join(tmp, "boil_interfaces.go"),
+		"PilotStatRepository",
+		"PilotLanguageRepository",
+	)
+
+	pkgs, err := parser.ParseDir(token.NewFileSet(), tmp, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Every method of the interfaces must be generated for its model, with
+	// Find being the package level Find function
+	funcs := make(map[string]bool)
+	interfaces := make(map[string]*ast.InterfaceType)
+	for _, f := range pkgs["models"].Files {
+		for _, decl := range f.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				name := d.Name.Name
+				if d.Recv != nil {
+					recv := d.Recv.List[0].Type
+					if star, ok := recv.(*ast.StarExpr); ok {
+						recv = star.X
+					}
+					name = recv.(*ast.Ident).Name + "." + name
+				}
+				funcs[name] = true
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					if ts, ok := spec.(*ast.TypeSpec); ok {
+						if it, ok := ts.Type.(*ast.InterfaceType); ok {
+							interfaces[ts.Name.Name] = it
+						}
+					}
+				}
+			}
+		}
+	}
+
+	for _, model := range []string{"Pilot", "Jet", "Airport", "License", "Language", "JetSeat"} {
+		it, ok := interfaces[model+"Repository"]
+		if !ok {
+			t.Errorf("no repository interface for %s", model)
+			continue
+		}
+
+		var methods []string
+		for _, m := range it.Methods.List {
+			name := m.Names[0].Name
+			methods = append(methods, name)
+			generated := model + "." + name
+			if name == "Find" {
+				generated = "Find" + model
+			}
+			if !funcs[generated] {
+				t.Errorf("%sRepository.%s is not generated as %s", model, name, generated)
+			}
+		}
+
+		if got := strings.Join(methods, ","); got != "Find,Insert,Update,Delete,Reload" {
+			t.Errorf("%sRepository has the wrong methods: %s", model, got)
+		}
+	}
+}
+
+func TestNewJSONTypes(t *testing.T) {
+	config := generateModels(t, func(c *Config) {
+		c.NoTests = false
+		c.JSONTypes = map[string]JSONType{
+			"jets.manifest": {
+				Type:    "shipping.Manifest",
+				Imports: importers.Set{ThirdParty: importers.List{`"github.com/me/shipping"`}},
+			},
+		}
+	})
+	tmp := config.OutFolder
+
+	checkGeneratedContains(t, filepath.Join(tmp, "jets.go"),
+		`"database/sql/driver"`,
+		`"encoding/json"`,
+		`"github.com/me/shipping"`,
+		"Manifest   JetManifest `",
+		`type JetManifest shipping.Manifest`,
+		`func (j *JetManifest) Scan(src interface{}) error {`,
+		`return json.Unmarshal(src, j)`,
+		`func (j JetManifest) Value() (driver.Value, error) {
+	if reflect.ValueOf(j).IsZero() {
+		return nil, nil
+	}
+	return json.Marshal(j)
+}`,
+	)
+	checkGeneratedContains(t, filepath.Join(tmp, "jets_test.go"),
+		`func (j *JetManifest) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {`,
+	)
+	checkGeneratedOmits(t, filepath.Join(tmp, "pilots.go"), `"encoding/json"`, `JetManifest`)
+
+	config.JSONTypes = map[string]JSONType{"jets.cargo_hold": {Type: "shipping.Hold"}}
+	if _, err := New(config); err == nil || !strings.Contains(err.Error(), "jets.cargo_hold") {
+		t.Errorf("expected an error about the unknown column, got: %v", err)
+	}
+}
+
+func TestNewContext(t *testing.T) {
+	config := testConfig(t)
+	tmp := config.OutFolder
+	config.AddGlobal = true
+	config.AddPanic = true
+	config.AddSoftDeletes = true
+
+	generate(t, config)
+
+	files, err := filepath.Glob(filepath.Join(tmp, "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Every function taking an executor takes the context first and only
+	// the context variants of the executor's methods are called
+	for _, file := range files {
+		checkGeneratedOmits(t, file, "boil.Executor", "exec.Exec(", "exec.Query(", "exec.QueryRow(")
+
+		f, err := parser.ParseFile(token.NewFileSet(), file, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+
+			var params []string
+			for _, field := range fn.Type.Params.List {
+				sel, ok := field.Type.(*ast.SelectorExpr)
+				if !ok {
+					continue
+				}
+				typ := sel.X.(*ast.Ident).Name + "." + sel.Sel.Name
+				for range field.Names {
+					params = append(params, typ)
+				}
+			}
+
+			for i, typ := range params {
+				if typ == "boil.ContextExecutor" && (i == 0 || params[i-1] != "context.Context") {
+					t.Errorf("%s: %s does not take a context before its executor", filepath.Base(file), fn.Name.Name)
+				}
+			}
+		}
+	}
+
+	checkGeneratedContains(t, filepath.Join(tmp, "pilots.go"),
+		`func (o *Pilot) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {`,
+		`if err := o.doBeforeInsertHooks(ctx, exec); err != nil {`,
+		`_, err = exec.ExecContext(ctx, cache.query, vals...)`,
+		`func (o *Pilot) InsertG(ctx context.Context, columns boil.Columns) error {`,
+		`return o.Insert(ctx, boil.GetContextDB(), columns)`,
+		`ret, err := FindPilot(ctx, exec, o.ID)`,
+	)
+
+	config.NoContext = true
+	generate(t, config)
+
+	checkGeneratedContains(t, filepath.Join(tmp, "pilots.go"),
+		`func (o *Pilot) Insert(exec boil.Executor, columns boil.Columns) error {`,
+		`_, err = exec.Exec(cache.query, vals...)`,
+	)
+	checkGeneratedOmits(t, filepath.Join(tmp, "pilots.go"), "context.Context", "ExecContext")
+}
+
+func TestNewGlobalVariants(t *testing.T) {
+	config := testConfig(t)
+	tmp := config.OutFolder
+	config.AddGlobal = true
+	config.NoContext = true
+
+	generate(t, config)
+
+	// Without a context the G variants use the db set by boil.SetDB as is
+	checkGeneratedContains(t, filepath.Join(tmp, "pilots.go"),
+		`func FindPilotG(iD int, selectCols ...string) (*Pilot, error) {
+	return FindPilot(boil.GetDB(), iD, selectCols...)
+}`,
+		`func (o *Pilot) InsertG(columns boil.Columns) error {
+	return o.Insert(boil.GetDB(), columns)
+}`,
+		`func (o *Pilot) ReloadG() error {`,
+		`	return o.Reload(boil.GetDB())`,
+	)
+
+	config.NoContext = false
+	config.AddGlobal = false
+	generate(t, config)
+
+	checkGeneratedOmits(t, filepath.Join(tmp, "pilots.go"), "GetContextDB", "GetDB", "InsertG", "FindPilotG")
+}
+
+func TestNewUpsert(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+	}
+
+	// Views have no upsert, leave out pilot_stats
+	tmp := generateModels(t, func(c *Config) {
+		borrowUpsert("mysql")(c)
+		c.DriverConfig[drivers.ConfigWhitelist] = []string{"pilots", "languages"}
+	}).OutFolder
+
+	// MySQL conflicts on any of the unique keys, it needs one of them set
+	// to find the upserted row again
+	mysqlTest := `package models
+
+import (
+	"context"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+)
+
+func TestUpsert(t *testing.T) {
+	ctx := context.Background()
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	l := &Language{ID: 1, Language: "Klingon"}
+	if err := l.Upsert(ctx, exec, boil.Infer(), boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Upsert(ctx, exec, boil.None(), boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err := (&Language{ID: 2}).Upsert(ctx, exec, boil.Infer(), boil.Infer()); err == nil {
+		t.Error("expected an error without a unique column set")
+	}
+
+	calls := exec.Calls()
+	if len(calls) != 2 {
+		t.Fatalf("want 2 statements, got: %#v", calls)
+	}
+	for i, want := range []string{
+		` + "`" + `INSERT INTO "languages" ("id","language") VALUES ($1,$2) ON DUPLICATE KEY UPDATE "language" = VALUES("language")` + "`" + `,
+		` + "`" + `INSERT IGNORE INTO "languages" ("id","language") VALUES ($1,$2)` + "`" + `,
+	} {
+		if calls[i].Query != want {
+			t.Errorf("%d) want the query %s, got: %s", i, want, calls[i].Query)
+		}
+	}
+}
+`
+	runGeneratedTest(t, tmp, mysqlTest, "-run", "TestUpsert")
+}
+
+func TestNewUpsertImmutableColumns(t *testing.T) {
+	// Without automatic timestamps created_at can be updated like any other column
+	tmp := generateModels(t, func(c *Config) {
+		borrowUpsert("psql")(c)
+		c.NoAutoTimestamps = true
+	}).OutFolder
+
+	checkGeneratedContains(t, filepath.Join(tmp, "airports.go"),
+		`airportImmutableColumns = []string{}`,
+	)
+}
+
+func TestNewNoRowsAffected(t *testing.T) {
+	// With no_rows_affected only the error is returned
+	tmp := generateModels(t, func(c *Config) {
+		c.NoRowsAffected = true
+	}).OutFolder
+
+	checkGeneratedContains(t, filepath.Join(tmp, "pilots.go"),
+		`func (o *Pilot) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {`,
+		`func (o *Pilot) Delete(ctx context.Context, exec boil.ContextExecutor) error {`,
+	)
+}
+
+func TestNewPrimaryKeys(t *testing.T) {
+	config := testConfig(t)
+	config.DriverConfig[drivers.ConfigWhitelist] = []string{"pilots", "flight_logs"}
+
+	// flight_logs has no primary key in the database, TestNewModelsWhitelisted
+	// configures one
+	if _, err := New(config); err == nil {
+		t.Fatal("expected an error for a table without a primary key")
+	}
+}
+
+func TestNewResultTypes(t *testing.T) {
+	config := testConfig(t)
+	config.DriverConfig[drivers.ConfigWhitelist] = []string{"pilots", "jets"}
+
+	bad := [][]ResultType{
+		{{Name: "pilotJetCount", Columns: []ResultColumn{{Name: "jets", Type: "int64"}}}},
+		{{Name: "Pilot", Columns: []ResultColumn{{Name: "jets", Type: "int64"}}}},
+		{{Name: "PilotJetCount"}},
+		{{Name: "PilotJetCount", Columns: []ResultColumn{{Name: "jets"}}}},
+		{{Name: "PilotJetCount", Columns: []ResultColumn{{Name: "jets", Type: "int64"}, {Name: "Jets", Type: "int"}}}},
+		{
+			{Name: "PilotJetCount", Columns: []ResultColumn{{Name: "jets", Type: "int64"}}},
+			{Name: "PilotJetCount", Columns: []ResultColumn{{Name: "jets", Type: "int64"}}},
+		},
+	}
+	for i, resultTypes := range bad {
+		config.ResultTypes = resultTypes
+		if _, err := New(config); err == nil {
+			t.Errorf("%d) expected an error for bad result types", i)
+		}
+	}
+}
+
+func TestNewRelationshipFields(t *testing.T) {
+	tmp := generateModels(t, nil).OutFolder
+
+	// To-one relationships are pointers so that a missing row is nil rather
+	// than a zero value, to-many relationships are slices
+	tests := []struct {
+		File   string
+		Struct string
+		Want   map[string]string
+	}{
+		{
+			File:   "jets.go",
+			Struct: "jetR",
+			Want:   map[string]string{"Pilot": "*Pilot", "Airport": "*Airport", "JetSeats": "JetSeatSlice"},
+		},
+		{
+			File:   "pilots.go",
+			Struct: "pilotR",
+			Want: map[string]string{
+				"Jet":               "*Jet",
+				"Licenses":          "LicenseSlice",
+				"Languages":         "LanguageSlice",
+				"SenderMessages":    "MessageSlice",
+				"RecipientMessages": "MessageSlice",
+			},
+		},
+		{
+			File:   "employees.go",
+			Struct: "employeeR",
+			Want:   map[string]string{"Manager": "*Employee", "ManagerEmployees": "EmployeeSlice"},
+		},
+	}
+
+	for _, test := range tests {
+		f, err := parser.ParseFile(token.NewFileSet(), filepath.Join(tmp, test.File), nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		obj := f.Scope.Lookup(test.Struct)
+		if obj == nil {
+			t.Errorf("%s has no %s", test.File, test.Struct)
+			continue
+		}
+
+		got := make(map[string]string)
+		for _, field := range obj.Decl.(*ast.TypeSpec).Type.(*ast.StructType).Fields.List {
+			typ := ""
+			switch x := field.Type.(type) {
+			case *ast.StarExpr:
+				typ = "*" + x.X.(*ast.Ident).Name
+			case *ast.Ident:
+				typ = x.Name
+			}
+			got[field.Names[0].Name] = typ
+		}
+		if !reflect.DeepEqual(got, test.Want) {
+			t.Errorf("%s: want fields %v, got: %v", test.Struct, test.Want, got)
+		}
+	}
+}
+
+// TestNewModels generates the models of the mock driver once, with the
+// options most of the generated code depends on turned on together, checks
+// the code generated for them and runs tests of it with the mock executor
+func TestNewModels(t *testing.T) {
+	tmp := generateModels(t, func(c *Config) {
+		// Views have no upsert, leave out pilot_stats
+		borrowUpsert("psql")(c)
+		c.DriverConfig[drivers.ConfigBlacklist] = []string{"pilot_stats"}
+		c.AddGlobal = true
+		c.AddPanic = true
+		c.AddSoftDeletes = true
+		c.JSONTypes = map[string]JSONType{
+			"jets.manifest": {Type: "map[string]int"},
+			"jets.cargo":    {Type: "map[string]int"},
+		}
+		c.ResultTypes = []ResultType{
+			{
+				Name: "PilotJetCount",
+				Columns: []ResultColumn{
+					{Name: "pilot_name", Type: "string"},
+					{Name: "jets", Type: "int64"},
+					{Name: "last_flight", Type: "null.Time"},
+				},
+			},
+		}
+	}).OutFolder
+
+	snippets := []struct {
+		File     string
+		Contains []string
+		Omits    []string
+	}{
+		{
+			File: "pilots.go",
+			Contains: []string{
+				`func (q pilotQuery) Iterate(ctx context.Context, exec boil.ContextExecutor) (*PilotIterator, error) {`,
+				`func (it *PilotIterator) Value() *Pilot {`,
+				`	if err := it.Value().doAfterSelectHooks(it.ctx, it.exec); err != nil {`,
+			},
+		},
+		{
+			File: "pilots.go",
+			Contains: []string{
+				`func FindPilotP(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) *Pilot {`,
+				`func (o *Pilot) InsertP(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) {`,
+				`		panic(boil.WrapErr(err))`,
+			},
+		},
+		// The G variants call the regular ones with the db set by boil.SetDB
+		{
+			File: "pilots.go",
+			Contains: []string{
+				`func FindPilotG(ctx context.Context, iD int, selectCols ...string) (*Pilot, error) {
+	return FindPilot(ctx, boil.GetContextDB(), iD, selectCols...)
+}`,
+				`func (o *Pilot) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}`,
+				`func (o *Pilot) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}`,
+				`func (o *Pilot) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}`,
+				`	return o.Reload(ctx, boil.GetContextDB())`,
+			},
+		},
+		{
+			File: "airports.go",
+			Contains: []string{
+				`airportImmutableColumns = []string{"created_at"}`,
+				`		update := updateColumns.UpsertUpdateColumnSet(
+			airportAllColumns,
+			airportPrimaryKeyColumns,
+			airportImmutableColumns,
+		)`,
+			},
+		},
+		{
+			File:     "pilots.go",
+			Contains: []string{`pilotImmutableColumns = []string{}`},
+		},
+		{
+			File: "pilots.go",
+			Contains: []string{
+				`func (o *Pilot) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {`,
+				`func (o *Pilot) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {`,
+				`func (q pilotQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {`,
+				`func (q pilotQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {`,
+			},
+		},
+		// employees.manager_id references employees.id, the foreign key side
+		// is the manager and the other side the employees that report to it
+		{
+			File: "employees.go",
+			Contains: []string{
+				`func (o *Employee) Manager(mods ...qm.QueryMod) employeeQuery {`,
+				`qm.Where("\"id\" = ?", o.ManagerID),`,
+				`func (o *Employee) ManagerEmployees(mods ...qm.QueryMod) employeeQuery {`,
+				`qm.Where("\"employees\".\"manager_id\"=?", o.ID),`,
+			},
+		},
+		// messages.sender_id and messages.recipient_id both reference pilots
+		{
+			File: "messages.go",
+			Contains: []string{
+				`func (o *Message) Sender(mods ...qm.QueryMod) pilotQuery {`,
+				`qm.Where("\"id\" = ?", o.SenderID),`,
+				`func (o *Message) Recipient(mods ...qm.QueryMod) pilotQuery {`,
+				`qm.Where("\"id\" = ?", o.RecipientID),`,
+			},
+		},
+		{
+			File: "pilots.go",
+			Contains: []string{
+				`func (o *Pilot) SenderMessages(mods ...qm.QueryMod) messageQuery {`,
+				`qm.Where("\"messages\".\"sender_id\"=?", o.ID),`,
+				`func (o *Pilot) RecipientMessages(mods ...qm.QueryMod) messageQuery {`,
+				`qm.Where("\"messages\".\"recipient_id\"=?", o.ID),`,
+			},
+		},
+		// licenses.pilot_id can't be null so licenses can only be added to a
+		// pilot, removing them fails
+		{
+			File: "pilots.go",
+			Contains: []string{
+				`func (o *Pilot) AddLicenses(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*License) error {`,
+				`func (o *Pilot) RemoveLicenses(ctx context.Context, exec boil.ContextExecutor, related ...*License) error {
+	return errors.New("models: cannot remove Licenses of a pilot, licenses.pilot_id is not nullable")
+}`,
+			},
+			Omits: []string{`SetLicenses`},
+		},
+		// employees.manager_id can be null so reports can also be set and removed
+		{
+			File: "employees.go",
+			Contains: []string{
+				`func (o *Employee) AddManagerEmployees(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Employee) error {`,
+				`func (o *Employee) SetManagerEmployees(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Employee) error {`,
+				`func (o *Employee) RemoveManagerEmployees(ctx context.Context, exec boil.ContextExecutor, related ...*Employee) error {`,
+			},
+		},
+		// jets.pilot_id can be null so a jet's pilot can be removed from either side
+		{
+			File: "jets.go",
+			Contains: []string{
+				`func (o *Jet) SetPilot(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Pilot) error {`,
+				`func (o *Jet) RemovePilot(ctx context.Context, exec boil.ContextExecutor, related *Pilot) error {`,
+			},
+		},
+		{
+			File:     "pilots.go",
+			Contains: []string{`func (o *Pilot) RemoveJet(ctx context.Context, exec boil.ContextExecutor, related *Jet) error {`},
+		},
+		// licenses.pilot_id can't be null, removing a license's pilot would
+		// orphan it so the removal fails
+		{
+			File: "licenses.go",
+			Contains: []string{
+				`func (o *License) SetPilot(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Pilot) error {`,
+				`func (o *License) RemovePilot(ctx context.Context, exec boil.ContextExecutor, related *Pilot) error {
+	return errors.New("models: cannot remove the Pilot of a license, licenses.pilot_id is not nullable")
+}`,
+			},
+		},
+		{
+			File: "pilots.go",
+			Contains: []string{
+				`		pilotFindCache[key] = query`,
+				`	key := strings.Join(selectCols, ",")
+	pilotFindCacheMut.RLock()
+	query, cached := pilotFindCache[key]
+	pilotFindCacheMut.RUnlock()`,
+				`	key := "email:" + strings.Join(selectCols, ",")`,
+			},
+		},
+		{
+			File: "boil_result_types.go",
+			Contains: []string{
+				`type PilotJetCount struct {`,
+				"PilotName  string    `boil:\"pilot_name\" json:\"pilot_name\" toml:\"pilot_name\" yaml:\"pilot_name\"`",
+				"LastFlight null.Time `boil:\"last_flight\" json:\"last_flight\" toml:\"last_flight\" yaml:\"last_flight\"`",
+				`func ScanPilotJetCount(ctx context.Context, exec boil.ContextExecutor, q *queries.Query) (*PilotJetCount, error) {`,
+				`func ScanPilotJetCountSlice(ctx context.Context, exec boil.ContextExecutor, q *queries.Query) (PilotJetCountSlice, error) {`,
+			},
+		},
+	}
+
+	for _, s := range snippets {
+		checkGeneratedContains(t, filepath.Join(tmp, s.File), s.Contains...)
+		checkGeneratedOmits(t, filepath.Join(tmp, s.File), s.Omits...)
+	}
+
+	if testing.Short() {
+		return
+	}
+
+	runGeneratedTests(t, tmp, []generatedTest{
+		// Iterate over canned rows with the mock executor, the hooks run for
+		// each record and the rows are closed when stopping early
+		{Name: "TestIterate", Src: `package models
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func TestIterate(t *testing.T) {
+	ctx := context.Background()
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	rows := boiltest.Result{
+		Columns: []string{"id", "name"},
+		Rows:    [][]interface{}{{int64(1), "Ann"}, {int64(2), "Bob"}, {int64(3), "Cat"}},
+	}
+
+	// The hook is left out of the other tests run with these models
+	defer func() { pilotAfterSelectHooks = nil }()
+
+	var selected []int
+	AddPilotHook(boil.AfterSelectHook, func(ctx context.Context, exec boil.ContextExecutor, o *Pilot) error {
+		if o.Name == "Cat" {
+			return errors.New("no cats")
+		}
+		selected = append(selected, o.ID)
+		return nil
+	})
+
+	exec.Expect(rows)
+	it, err := Pilots(qm.Select("id", "name")).Iterate(ctx, exec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for it.Next() {
+		if it.Value().ID == 2 {
+			break
+		}
+	}
+	if err := it.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if n := exec.Stats().InUse; n != 0 {
+		t.Errorf("want the rows closed, %d connections in use", n)
+	}
+	if len(selected) != 2 {
+		t.Errorf("want the hooks run for the 2 records read, got: %v", selected)
+	}
+
+	exec.Expect(rows)
+	it, err = Pilots(qm.Select("id", "name")).Iterate(ctx, exec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	for it.Next() {
+		n++
+	}
+	if n != 2 || it.Err() == nil || it.Err().Error() != "no cats" {
+		t.Errorf("want the hook error after 2 records, got %d and: %v", n, it.Err())
+	}
+	if n := exec.Stats().InUse; n != 0 {
+		t.Errorf("want the rows closed after an error, %d connections in use", n)
+	}
+}
+`},
+		// Force errors with the mock executor and check the P variants panic
+		// with them instead of returning them
+		{Name: "TestPanicVariants", Src: `package models
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+)
+
+func expectPanic(t *testing.T, fn func()) {
+	t.Helper()
+
+	defer func() {
+		err, ok := recover().(error)
+		if !ok || !boil.IsBoilErr(err) || !strings.HasSuffix(err.Error(), ": forced") {
+			t.Errorf("want a panic with the forced error, got: %v", err)
+		}
+	}()
+
+	fn()
+}
+
+var errForced = errors.New("forced")
+
+func TestPanicVariants(t *testing.T) {
+	ctx := context.Background()
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	exec.Expect(boiltest.Result{Err: errForced})
+	expectPanic(t, func() { FindPilotP(ctx, exec, 1) })
+
+	exec.Expect(boiltest.Result{Err: errForced})
+	expectPanic(t, func() { (&Pilot{Name: "Tim"}).InsertP(ctx, exec, boil.Infer()) })
+
+	if calls := exec.Calls(); len(calls) != 2 {
+		t.Errorf("want 2 statements run, got: %#v", calls)
+	}
+}
+`},
+		// The caller's context is the one the statements are run with
+		{Name: "TestContext", Src: `package models
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+)
+
+type flightKey struct{}
+
+// flightExecutor counts the statements run without the caller's context
+type flightExecutor struct {
+	*boiltest.Executor
+	lost *[]string
+}
+
+func (e flightExecutor) check(ctx context.Context, query string) {
+	if ctx.Value(flightKey{}) != "ba123" {
+		*e.lost = append(*e.lost, query)
+	}
+}
+
+func (e flightExecutor) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	e.check(ctx, query)
+	return e.Executor.ExecContext(ctx, query, args...)
+}
+
+func (e flightExecutor) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	e.check(ctx, query)
+	return e.Executor.QueryContext(ctx, query, args...)
+}
+
+func (e flightExecutor) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	e.check(ctx, query)
+	return e.Executor.QueryRowContext(ctx, query, args...)
+}
+
+func TestContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), flightKey{}, "ba123")
+	var lost []string
+	exec := flightExecutor{Executor: boiltest.NewExecutor(), lost: &lost}
+	defer exec.Close()
+
+	row := boiltest.Result{Columns: []string{"id", "size"}, Rows: [][]interface{}{{int64(1), int64(0)}}}
+	exec.Expect(boiltest.Result{Columns: []string{"size"}, Rows: [][]interface{}{{int64(0)}}})
+	a := &Airport{ID: 1}
+	if err := a.Insert(ctx, exec, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	exec.Expect(boiltest.Result{RowsAffected: 1})
+	if _, err := a.Update(ctx, exec, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	exec.Expect(row)
+	if err := a.Reload(ctx, exec); err != nil {
+		t.Fatal(err)
+	}
+	exec.Expect(row)
+	if _, err := FindAirport(ctx, exec, 1); err != nil {
+		t.Fatal(err)
+	}
+	exec.Expect(row)
+	if _, err := Airports().All(ctx, exec); err != nil {
+		t.Fatal(err)
+	}
+	exec.Expect(boiltest.Result{Columns: []string{"count"}, Rows: [][]interface{}{{int64(1)}}})
+	if _, err := Airports().Count(ctx, exec); err != nil {
+		t.Fatal(err)
+	}
+	exec.Expect(boiltest.Result{RowsAffected: 1})
+	if _, err := Airports().UpdateAll(ctx, exec, M{"size": 2}); err != nil {
+		t.Fatal(err)
+	}
+	exec.Expect(boiltest.Result{RowsAffected: 1})
+	if _, err := a.Delete(ctx, exec); err != nil {
+		t.Fatal(err)
+	}
+
+	if calls := exec.Calls(); len(calls) != 8 {
+		t.Errorf("want 8 statements, got: %#v", calls)
+	}
+	if len(lost) != 0 {
+		t.Errorf("these statements were run without the caller's context: %v", lost)
+	}
+}
+`},
+		// A null of the nullable manifest comes back as a null, the cargo
+		// can't be null so it's always marshaled
+		{Name: "TestJSONTypesNull", Src: `package models
+
+import (
+	"context"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+)
+
+func TestJSONTypesNull(t *testing.T) {
+	ctx := context.Background()
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	exec.Expect(boiltest.Result{
+		Columns: []string{"id", "cargo", "manifest"},
+		Rows:    [][]interface{}{{int64(1), []byte("{\"crates\":12}"), nil}},
+	})
+	jet, err := FindJet(ctx, exec, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if jet.Manifest != nil || jet.Cargo["crates"] != 12 {
+		t.Fatalf("want a null manifest and 12 crates, got: %v and %v", jet.Manifest, jet.Cargo)
+	}
+
+	exec.Reset()
+	if _, err = jet.Update(ctx, exec, boil.Whitelist("manifest", "cargo")); err != nil {
+		t.Fatal(err)
+	}
+	args := exec.Calls()[0].Args
+	if len(args) != 3 || args[0] != nil || string(args[1].([]byte)) != "{\"crates\":12}" {
+		t.Errorf("want the manifest stored as a null and the cargo as json, got: %v", args)
+	}
+
+	if v, err := JetCargo(nil).Value(); err != nil || string(v.([]byte)) != "null" {
+		t.Errorf("want an empty cargo marshaled, got: %v, %v", v, err)
+	}
+}
+`},
+		// Without conflict columns postgres conflicts on the primary key
+		{Name: "TestUpsert", Src: `package models
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+)
+
+func TestUpsert(t *testing.T) {
+	ctx := context.Background()
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	l := &Language{ID: 1, Language: "Klingon"}
+	if err := l.Upsert(ctx, exec, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Upsert(ctx, exec, true, []string{"language"}, boil.Infer(), boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Upsert(ctx, exec, false, nil, boil.None(), boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := exec.Calls()
+	if len(calls) != 3 {
+		t.Fatalf("want 3 statements, got: %#v", calls)
+	}
+	for i, want := range []string{
+		` + "`" + `ON CONFLICT ("id") DO UPDATE SET "language" = EXCLUDED."language"` + "`" + `,
+		` + "`" + `ON CONFLICT ("language") DO UPDATE SET "language" = EXCLUDED."language"` + "`" + `,
+		` + "`" + `ON CONFLICT DO NOTHING` + "`" + `,
+	} {
+		if !strings.Contains(calls[i].Query, want) {
+			t.Errorf("%d) want the query to contain %s, got: %s", i, want, calls[i].Query)
+		}
+	}
+}
+`},
+		// airports.size defaults to 0, inferring leaves it out when it's zero
+		// and reads it back from the database
+		{Name: "TestInsertColumns", Src: `package models
+
+import (
+	"context"
+	"testing"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+)
+
+func TestInsertColumns(t *testing.T) {
+	ctx := context.Background()
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	tests := []struct {
+		Size    null.Int
+		Columns boil.Columns
+		Query   string
+		Args    int
+	}{
+		{
+			Columns: boil.Infer(),
+			Query:   ` + "`" + `INSERT INTO "airports" ("id","created_at","updated_at","lock_version") VALUES ($1,$2,$3,$4) RETURNING "size"` + "`" + `,
+			Args:    4,
+		},
+		{
+			Size:    null.IntFrom(5),
+			Columns: boil.Blacklist("size"),
+			Query:   ` + "`" + `INSERT INTO "airports" ("id","created_at","updated_at","lock_version") VALUES ($1,$2,$3,$4) RETURNING "size"` + "`" + `,
+			Args:    4,
+		},
+		{
+			Columns: boil.Greylist("size"),
+			Query:   ` + "`" + `INSERT INTO "airports" ("id","size","created_at","updated_at","lock_version") VALUES ($1,$2,$3,$4,$5)` + "`" + `,
+			Args:    5,
+		},
+	}
+
+	for i, test := range tests {
+		exec.Reset()
+		exec.Expect(boiltest.Result{Columns: []string{"size"}, Rows: [][]interface{}{{int64(0)}}})
+
+		a := &Airport{ID: i + 1, Size: test.Size}
+		if err := a.Insert(ctx, exec, test.Columns); err != nil {
+			t.Fatalf("%d) %v", i, err)
+		}
+
+		calls := exec.Calls()
+		if len(calls) != 1 {
+			t.Fatalf("%d) want 1 statement, got: %#v", i, calls)
+		}
+		if calls[0].Query != test.Query {
+			t.Errorf("%d) want the query %s, got: %s", i, test.Query, calls[0].Query)
+		}
+		if len(calls[0].Args) != test.Args {
+			t.Errorf("%d) want %d args, got: %v", i, test.Args, calls[0].Args)
+		}
+		for _, arg := range calls[0].Args {
+			if arg == int64(5) {
+				t.Errorf("%d) the blacklisted size should not be sent, got: %v", i, calls[0].Args)
+			}
+		}
+	}
+}
+`},
+		// The counts come from the results of the statements
+		{Name: "TestRowsAffected", Src: `package models
+
+import (
+	"context"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+)
+
+func TestRowsAffected(t *testing.T) {
+	ctx := context.Background()
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	exec.Expect(
+		boiltest.Result{RowsAffected: 0},
+		boiltest.Result{RowsAffected: 1},
+		boiltest.Result{RowsAffected: 3},
+		boiltest.Result{RowsAffected: 2},
+	)
+
+	p := &Pilot{ID: 1, Name: "Tim"}
+	if n, err := p.Update(ctx, exec, boil.Whitelist("name")); err != nil || n != 0 {
+		t.Errorf("want 0 rows updated, got %d, %v", n, err)
+	}
+	if n, err := p.Delete(ctx, exec); err != nil || n != 1 {
+		t.Errorf("want 1 row deleted, got %d, %v", n, err)
+	}
+	if n, err := Pilots().UpdateAll(ctx, exec, M{"name": "Tom"}); err != nil || n != 3 {
+		t.Errorf("want 3 rows updated, got %d, %v", n, err)
+	}
+	if n, err := Pilots().DeleteAll(ctx, exec); err != nil || n != 2 {
+		t.Errorf("want 2 rows deleted, got %d, %v", n, err)
+	}
+}
+`},
+		// The columns are set and the rows filtered in a single statement
+		{Name: "TestUpdateAll", Src: `package models
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func TestUpdateAll(t *testing.T) {
+	ctx := context.Background()
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	exec.Expect(boiltest.Result{RowsAffected: 12})
+	n, err := Jets(qm.Where("airport_id = ? and name = ?", 5, "Concorde")).UpdateAll(ctx, exec, M{"name": "grounded", "color": "red"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 12 {
+		t.Errorf("want 12 rows updated, got %d", n)
+	}
+
+	calls := exec.Calls()
+	if len(calls) != 1 {
+		t.Fatalf("want 1 statement, got: %#v", calls)
+	}
+	want := ` + "`" + `UPDATE "jets" SET "color" = $1, "name" = $2 WHERE (airport_id = $3 and name = $4);` + "`" + `
+	if calls[0].Query != want {
+		t.Errorf("want the query:\n%s\ngot:\n%s", want, calls[0].Query)
+	}
+	if args := []interface{}{"red", "grounded", int64(5), "Concorde"}; !reflect.DeepEqual(calls[0].Args, args) {
+		t.Errorf("want the args %v, got: %v", args, calls[0].Args)
+	}
+}
+`},
+		// A hard delete removes the rows, a soft delete sets the deleted
+		// column instead, both in a single statement
+		{Name: "TestDeleteAll", Src: `package models
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func TestDeleteAll(t *testing.T) {
+	ctx := context.Background()
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	tests := []struct {
+		Hard  bool
+		Rows  int64
+		Query string
+		Args  int
+	}{
+		{
+			Hard:  true,
+			Rows:  3,
+			Query: ` + "`" + `DELETE FROM "licenses" WHERE (pilot_id = $1) AND ("licenses"."deleted_at" is null);` + "`" + `,
+			Args:  1,
+		},
+		{
+			Rows:  2,
+			Query: ` + "`" + `UPDATE "licenses" SET "deleted_at" = $1 WHERE (pilot_id = $2) AND ("licenses"."deleted_at" is null);` + "`" + `,
+			Args:  2,
+		},
+	}
+
+	for i, test := range tests {
+		exec.Reset()
+		exec.Expect(boiltest.Result{RowsAffected: test.Rows})
+
+		n, err := Licenses(qm.Where("pilot_id = ?", 5)).DeleteAll(ctx, exec, test.Hard)
+		if err != nil {
+			t.Fatalf("%d) %v", i, err)
+		}
+		if n != test.Rows {
+			t.Errorf("%d) want %d rows deleted, got %d", i, test.Rows, n)
+		}
+
+		calls := exec.Calls()
+		if len(calls) != 1 {
+			t.Fatalf("%d) want 1 statement, got: %#v", i, calls)
+		}
+		if calls[0].Query != test.Query {
+			t.Errorf("%d) want the query:\n%s\ngot:\n%s", i, test.Query, calls[0].Query)
+		}
+		args := calls[0].Args
+		if len(args) != test.Args || args[len(args)-1] != int64(5) {
+			t.Errorf("%d) want %d args ending with the pilot, got: %v", i, test.Args, args)
+		}
+		if !test.Hard {
+			if _, ok := args[0].(time.Time); !ok {
+				t.Errorf("%d) want the deleted time set, got: %v", i, args[0])
+			}
+		}
+	}
+}
+`},
+		// The selected columns and the ordering of the query are dropped, the
+		// filters are kept
+		{Name: "TestCountExists", Src: `package models
+
+import (
+	"context"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func TestCountExists(t *testing.T) {
+	ctx := context.Background()
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	filtered := func() pilotQuery {
+		return Pilots(qm.Select("name"), qm.Where("mood = ?", "happy"), qm.OrderBy("name desc"))
+	}
+
+	exec.Expect(
+		boiltest.Result{Columns: []string{"count"}, Rows: [][]interface{}{{int64(3)}}},
+		boiltest.Result{Columns: []string{"exists"}, Rows: [][]interface{}{{true}}},
+	)
+
+	count, err := filtered().Count(ctx, exec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 3 {
+		t.Errorf("want 3, got %d", count)
+	}
+	exists, err := filtered().Exists(ctx, exec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !exists {
+		t.Error("want exists")
+	}
+
+	calls := exec.Calls()
+	if len(calls) != 2 {
+		t.Fatalf("want 2 statements, got: %#v", calls)
+	}
+	for i, want := range []string{
+		` + "`" + `SELECT COUNT(*) FROM "pilots" WHERE (mood = $1);` + "`" + `,
+		` + "`" + `SELECT EXISTS(SELECT * FROM "pilots" WHERE (mood = $1));` + "`" + `,
+	} {
+		if calls[i].Query != want {
+			t.Errorf("%d) want the query:\n%s\ngot:\n%s", i, want, calls[i].Query)
+		}
+		if len(calls[i].Args) != 1 || calls[i].Args[0] != "happy" {
+			t.Errorf("%d) want the mood as the only arg, got: %v", i, calls[i].Args)
+		}
+	}
+}
+`},
+		// Soft deleted rows are hidden after the other filters unless the
+		// query asks for them
+		{Name: "TestSoftDeleteWhere", Src: `package models
+
+import (
+	"context"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func TestSoftDeleteWhere(t *testing.T) {
+	ctx := context.Background()
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	if _, err := Licenses(qm.Where("pilot_id = ?", 5)).All(ctx, exec); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Licenses(qm.Where("pilot_id = ?", 5), qm.WithDeleted()).All(ctx, exec); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := exec.Calls()
+	if len(calls) != 2 {
+		t.Fatalf("want 2 queries, got: %#v", calls)
+	}
+	for i, want := range []string{
+		` + "`" + `SELECT * FROM "licenses" WHERE (pilot_id = $1) AND ("licenses"."deleted_at" is null);` + "`" + `,
+		` + "`" + `SELECT * FROM "licenses" WHERE (pilot_id = $1);` + "`" + `,
+	} {
+		if calls[i].Query != want {
+			t.Errorf("%d) want the query:\n%s\ngot:\n%s", i, want, calls[i].Query)
+		}
+		if len(calls[i].Args) != 1 || calls[i].Args[0] != int64(5) {
+			t.Errorf("%d) want the pilot as the only arg, got: %v", i, calls[i].Args)
+		}
+	}
+}
+`},
+		{Name: "TestMultipleForeignKeys", Src: `package models
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func TestMultipleForeignKeys(t *testing.T) {
+	ctx := context.Background()
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	messageCols := []string{"id", "sender_id", "recipient_id", "body"}
+	exec.Expect(
+		boiltest.Result{Columns: []string{"id", "name"}, Rows: [][]interface{}{{int64(1), "Ann"}}},
+		boiltest.Result{Columns: messageCols, Rows: [][]interface{}{{int64(10), int64(1), int64(2), "hi Bob"}}},
+		boiltest.Result{Columns: messageCols, Rows: [][]interface{}{{int64(11), int64(2), int64(1), "hi Ann"}, {int64(12), int64(3), int64(1), "hey Ann"}}},
+	)
+
+	ann, err := Pilots(
+		qm.Select("id", "name"),
+		qm.Load(PilotRels.SenderMessages),
+		qm.Load(PilotRels.RecipientMessages),
+	).One(ctx, exec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ann.R.SenderMessages) != 1 || ann.R.SenderMessages[0].R.Sender != ann {
+		t.Errorf("want the sent message with Ann as its sender, got: %v", ann.R.SenderMessages)
+	}
+	if len(ann.R.RecipientMessages) != 2 || ann.R.RecipientMessages[0].R.Recipient != ann {
+		t.Errorf("want the received messages with Ann as their recipient, got: %v", ann.R.RecipientMessages)
+	}
+
+	calls := exec.Calls()
+	if len(calls) != 3 || !strings.Contains(calls[1].Query, "sender_id") || !strings.Contains(calls[2].Query, "recipient_id") {
+		t.Errorf("want each relationship loaded by its own column, got: %v", calls)
+	}
+}
+`},
+		{Name: "TestToManySetOps", Src: `package models
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+)
+
+func TestToManySetOps(t *testing.T) {
+	ctx := context.Background()
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	ann := &Employee{ID: 1, Name: "Ann"}
+	bob := &Employee{ID: 2, Name: "Bob"}
+	cat := &Employee{ID: 3, Name: "Cat"}
+	dan := &Employee{ID: 4, Name: "Dan"}
+
+	// Add assigns the foreign key of each related row and updates it
+	if err := ann.AddManagerEmployees(ctx, exec, false, bob, cat); err != nil {
+		t.Fatal(err)
+	}
+	if bob.ManagerID != null.IntFrom(1) || cat.ManagerID != null.IntFrom(1) {
+		t.Errorf("want the manager ids assigned, got %v and %v", bob.ManagerID, cat.ManagerID)
+	}
+	if len(ann.R.ManagerEmployees) != 2 || bob.R.Manager != ann || cat.R.Manager != ann {
+		t.Error("want both sides of the relationship set")
+	}
+	want := []boiltest.Call{
+		{Query: "UPDATE \"employees\" SET \"manager_id\"=$1 WHERE \"id\"=$2", Args: []interface{}{int64(1), int64(2)}},
+		{Query: "UPDATE \"employees\" SET \"manager_id\"=$1 WHERE \"id\"=$2", Args: []interface{}{int64(1), int64(3)}},
+	}
+	if calls := exec.Calls(); !reflect.DeepEqual(calls, want) {
+		t.Errorf("want:\n%v\ngot:\n%v", want, calls)
+	}
+
+	// Add with insert assigns the foreign key and inserts the related rows
+	exec.Reset()
+	if err := ann.AddManagerEmployees(ctx, exec, true, dan); err != nil {
+		t.Fatal(err)
+	}
+	if calls := exec.Calls(); len(calls) != 1 || calls[0].Args[2] != int64(1) {
+		t.Errorf("want dan inserted with ann as manager, got: %v", calls)
+	}
+
+	// Set unassigns the current rows before adding the new ones
+	exec.Reset()
+	if err := ann.SetManagerEmployees(ctx, exec, false, dan); err != nil {
+		t.Fatal(err)
+	}
+	if bob.ManagerID.Valid || cat.ManagerID.Valid || bob.R.Manager != nil {
+		t.Error("want the previous reports unassigned")
+	}
+	if len(ann.R.ManagerEmployees) != 1 || ann.R.ManagerEmployees[0] != dan || dan.R.Manager != ann {
+		t.Errorf("want dan as the only report, got: %v", ann.R.ManagerEmployees)
+	}
+	want = []boiltest.Call{
+		{Query: "update \"employees\" set \"manager_id\" = null where \"manager_id\" = $1", Args: []interface{}{int64(1)}},
+		{Query: "UPDATE \"employees\" SET \"manager_id\"=$1 WHERE \"id\"=$2", Args: []interface{}{int64(1), int64(4)}},
+	}
+	if calls := exec.Calls(); !reflect.DeepEqual(calls, want) {
+		t.Errorf("want:\n%v\ngot:\n%v", want, calls)
+	}
+
+	// Remove sets the foreign key of the related rows to null
+	exec.Reset()
+	if err := ann.RemoveManagerEmployees(ctx, exec, dan); err != nil {
+		t.Fatal(err)
+	}
+	if dan.ManagerID.Valid || dan.R.Manager != nil || len(ann.R.ManagerEmployees) != 0 {
+		t.Error("want dan removed from both sides")
+	}
+	if calls := exec.Calls(); len(calls) != 1 || calls[0].Args[0] != nil || calls[0].Args[1] != int64(4) {
+		t.Errorf("want dan's manager id set to null, got: %v", calls)
+	}
+}
+`},
+		{Name: "TestNullableForeignKeys", Src: `package models
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+)
+
+func TestNullableForeignKeys(t *testing.T) {
+	ctx := context.Background()
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	pilot := &Pilot{ID: 1, Name: "Ann"}
+	jet := &Jet{ID: 2, Name: "Swift"}
+
+	if err := jet.SetPilot(ctx, exec, false, pilot); err != nil {
+		t.Fatal(err)
+	}
+	if jet.PilotID != null.IntFrom(1) || jet.R.Pilot != pilot || pilot.R.Jet != jet {
+		t.Fatal("want the jet's pilot set on both sides")
+	}
+
+	// Removing nulls out the foreign key, the jet itself is kept
+	exec.Reset()
+	if err := jet.RemovePilot(ctx, exec, pilot); err != nil {
+		t.Fatal(err)
+	}
+	if jet.PilotID.Valid || jet.R.Pilot != nil || pilot.R.Jet != nil {
+		t.Error("want the jet's pilot removed from both sides")
+	}
+	calls := exec.Calls()
+	if len(calls) != 1 || calls[0].Query != "UPDATE \"jets\" SET \"pilot_id\"=$1 WHERE \"id\"=$2" || calls[0].Args[0] != nil {
+		t.Errorf("want the pilot id updated to null, got: %v", calls)
+	}
+
+	// A license always has a pilot, it can only be moved to another one
+	license := &License{ID: 3, PilotID: 1}
+	exec.Reset()
+	if err := license.SetPilot(ctx, exec, false, &Pilot{ID: 4}); err != nil {
+		t.Fatal(err)
+	}
+	if license.PilotID != 4 {
+		t.Errorf("want the license moved to pilot 4, got: %d", license.PilotID)
+	}
+	calls = exec.Calls()
+	if len(calls) != 1 || calls[0].Args[0] != int64(4) {
+		t.Errorf("want the pilot id updated, got: %v", calls)
+	}
+
+	// Removing it fails without running anything
+	exec.Reset()
+	if err := license.RemovePilot(ctx, exec, license.R.Pilot); err == nil || !strings.Contains(err.Error(), "licenses.pilot_id is not nullable") {
+		t.Errorf("want an error about the pilot id, got: %v", err)
+	}
+	if err := license.R.Pilot.RemoveLicenses(ctx, exec, license); err == nil || !strings.Contains(err.Error(), "licenses.pilot_id is not nullable") {
+		t.Errorf("want an error about the pilot id, got: %v", err)
+	}
+	if calls := exec.Calls(); len(calls) != 0 || license.PilotID != 4 || license.R.Pilot == nil {
+		t.Errorf("want the license left with its pilot, got: %v", calls)
+	}
+}
+`},
+		{Name: "TestResultTypes", Src: `package models
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+func TestResultTypes(t *testing.T) {
+	ctx := context.Background()
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	landed := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	exec.Expect(boiltest.Result{
+		Columns: []string{"pilot_name", "jets", "last_flight"},
+		Rows: [][]interface{}{
+			{"Ann", int64(2), landed},
+			{"Bob", int64(0), nil},
+		},
+	})
+
+	q := queries.Raw("select p.name as pilot_name, count(j.id) as jets, max(j.landed_at) as last_flight from pilots p left join jets j on j.pilot_id = p.id group by p.name")
+	counts, err := ScanPilotJetCountSlice(ctx, exec, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(counts) != 2 {
+		t.Fatalf("want 2 rows, got: %d", len(counts))
+	}
+	if c := counts[0]; c.PilotName != "Ann" || c.Jets != 2 || !c.LastFlight.Valid || !c.LastFlight.Time.Equal(landed) {
+		t.Errorf("want Ann's 2 jets, got: %#v", c)
+	}
+	if c := counts[1]; c.PilotName != "Bob" || c.Jets != 0 || c.LastFlight.Valid {
+		t.Errorf("want Bob's 0 jets, got: %#v", c)
+	}
+
+	exec.Expect(boiltest.Result{
+		Columns: []string{"pilot_name", "jets", "last_flight"},
+		Rows:    [][]interface{}{{"Ann", int64(2), landed}},
+	})
+	count, err := ScanPilotJetCount(ctx, exec, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count.PilotName != "Ann" || count.Jets != 2 {
+		t.Errorf("want Ann's 2 jets, got: %#v", count)
+	}
+
+	exec.Expect(boiltest.Result{Columns: []string{"pilot_name", "jets", "last_flight"}})
+	if _, err = ScanPilotJetCount(ctx, exec, q); err != sql.ErrNoRows {
+		t.Errorf("want sql.ErrNoRows, got: %v", err)
+	}
+}
+`},
+		{Name: "TestRelationshipFields", Src: `package models
+
+import (
+	"context"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func TestRelationshipFields(t *testing.T) {
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	exec.Expect(boiltest.Result{
+		Columns: []string{"id", "pilot_id", "airport_id", "name"},
+		Rows: [][]interface{}{
+			{int64(1), int64(5), int64(9), "Swift"},
+			{int64(2), nil, int64(9), "Idle"},
+		},
+	})
+	exec.Expect(boiltest.Result{
+		Columns: []string{"id", "name"},
+		Rows:    [][]interface{}{{int64(5), "Ann"}},
+	})
+
+	jets, err := Jets(qm.Load(JetRels.Pilot)).All(context.Background(), exec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jets) != 2 {
+		t.Fatalf("want 2 jets, got: %d", len(jets))
+	}
+	if jets[0].R == nil || jets[0].R.Pilot == nil || jets[0].R.Pilot.ID != 5 {
+		t.Errorf("want the first jet's pilot loaded, got: %#v", jets[0].R)
+	}
+	if jets[1].R != nil && jets[1].R.Pilot != nil {
+		t.Errorf("want no pilot for the second jet, got: %#v", jets[1].R.Pilot)
+	}
+}
+`},
+		{Name: "TestEagerLoadMods", Src: `package models
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func TestEagerLoadMods(t *testing.T) {
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	exec.Expect(boiltest.Result{
+		Columns: []string{"id", "name"},
+		Rows:    [][]interface{}{{int64(1), "Ann"}, {int64(2), "Bob"}},
+	})
+	exec.Expect(boiltest.Result{
+		Columns: []string{"id", "pilot_id"},
+		Rows:    [][]interface{}{{int64(8), int64(2)}, {int64(7), int64(1)}, {int64(6), int64(1)}},
+	})
+
+	pilots, err := Pilots(
+		qm.Where("name like ?", "%"),
+		qm.Load(PilotRels.Licenses, qm.Where("deleted_at is null and id > ?", 5), qm.OrderBy("id desc")),
+	).All(context.Background(), exec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calls := exec.Calls()
+	if len(calls) != 2 {
+		t.Fatalf("want a query for the pilots and one for their licenses, got: %v", calls)
+	}
+
+	// The mods of the load apply to the licenses query only, after the where
+	// that matches the licenses to the pilots
+	if strings.Contains(calls[0].Query, "deleted_at") || strings.Contains(calls[0].Query, "ORDER BY") {
+		t.Errorf("want the pilots query left alone, got: %s", calls[0].Query)
+	}
+	load := calls[1].Query
+	where := strings.Index(load, "(deleted_at is null and id > $3)")
+	order := strings.Index(load, "ORDER BY id desc")
+	if !strings.Contains(load, "\"licenses\".\"pilot_id\" IN ($1,$2)") || where < 0 || order < where {
+		t.Errorf("want the licenses query to have the where and order of the load, got: %s", load)
+	}
+	if len(calls[1].Args) != 3 || calls[1].Args[2] != int64(5) {
+		t.Errorf("want the pilot ids and the argument of the where, got: %v", calls[1].Args)
+	}
+
+	if n := len(pilots[0].R.Licenses); n != 2 || pilots[0].R.Licenses[0].ID != 7 || pilots[0].R.Licenses[1].ID != 6 {
+		t.Errorf("want Ann's licenses in the order of the query, got: %v", pilots[0].R.Licenses)
+	}
+	if n := len(pilots[1].R.Licenses); n != 1 || pilots[1].R.Licenses[0].ID != 8 {
+		t.Errorf("want Bob's license, got: %v", pilots[1].R.Licenses)
+	}
+}
+`},
+		{Name: "TestEagerLoadAll", Src: `package models
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func TestEagerLoadAll(t *testing.T) {
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	// Jets have three relationships, loaded in alphabetical order
+	exec.Expect(boiltest.Result{
+		Columns: []string{"id", "pilot_id", "airport_id", "name"},
+		Rows: [][]interface{}{
+			{int64(1), int64(5), int64(9), "Swift"},
+			{int64(2), int64(6), int64(9), "Nimble"},
+			{int64(3), nil, int64(8), "Idle"},
+		},
+	})
+	exec.Expect(boiltest.Result{
+		Columns: []string{"id"},
+		Rows:    [][]interface{}{{int64(8)}, {int64(9)}},
+	})
+	exec.Expect(boiltest.Result{
+		Columns: []string{"jet_id", "seat"},
+		Rows:    [][]interface{}{{int64(1), "1A"}, {int64(1), "1B"}, {int64(2), "1A"}},
+	})
+	exec.Expect(boiltest.Result{
+		Columns: []string{"id", "name"},
+		Rows:    [][]interface{}{{int64(5), "Ann"}, {int64(6), "Bob"}},
+	})
+
+	jets, err := Jets(qm.LoadAll()).All(context.Background(), exec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calls := exec.Calls()
+	if len(calls) != 4 {
+		t.Fatalf("want a query for the jets and one for each relationship, got %d: %v", len(calls), calls)
+	}
+	for i, table := range []string{"jets", "airports", "jet_seats", "pilots"} {
+		if !strings.Contains(calls[i].Query, "FROM \"" + table + "\"") {
+			t.Errorf("%d) want a query of %s, got: %s", i, table, calls[i].Query)
+		}
+	}
+
+	if jets[0].R.Airport.ID != 9 || len(jets[0].R.JetSeats) != 2 || jets[0].R.Pilot.Name != "Ann" {
+		t.Errorf("want every relationship of the first jet loaded, got: %#v", jets[0].R)
+	}
+	if jets[1].R.Airport.ID != 9 || len(jets[1].R.JetSeats) != 1 || jets[1].R.Pilot.Name != "Bob" {
+		t.Errorf("want every relationship of the second jet loaded, got: %#v", jets[1].R)
+	}
+	if jets[2].R.Airport.ID != 8 || len(jets[2].R.JetSeats) != 0 || jets[2].R.Pilot != nil {
+		t.Errorf("want the third jet's airport only, got: %#v", jets[2].R)
+	}
+
+	// Nested loads and mods still go through Load
+	exec.Reset()
+	exec.Expect(boiltest.Result{
+		Columns: []string{"id", "pilot_id", "airport_id", "name"},
+		Rows:    [][]interface{}{{int64(1), int64(5), int64(9), "Swift"}},
+	})
+	exec.Expect(boiltest.Result{Columns: []string{"id"}, Rows: [][]interface{}{{int64(9)}}})
+	exec.Expect(boiltest.Result{Columns: []string{"jet_id", "seat"}})
+	exec.Expect(boiltest.Result{Columns: []string{"id", "name"}, Rows: [][]interface{}{{int64(5), "Ann"}}})
+	exec.Expect(boiltest.Result{Columns: []string{"id", "pilot_id"}})
+
+	_, err = Jets(qm.LoadAll(), qm.Load("Pilot.Jet")).All(context.Background(), exec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls = exec.Calls(); len(calls) != 5 {
+		t.Errorf("want the pilots loaded once on the way to their jets, got %d: %v", len(calls), calls)
+	}
+}
+`},
+		// The finders reuse their sql for the same select columns, the
+		// benchmark compares that to building it on every call
+		{Name: "TestFindCache", Src: `package models
+
+import (
+	"context"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+)
+
+func TestFindCache(t *testing.T) {
+	ctx := context.Background()
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	for i := 0; i < 2; i++ {
+		FindPilot(ctx, exec, 1)
+		FindPilot(ctx, exec, 1, "id", "name")
+		FindPilotByEmail(ctx, exec, "a@example.com")
+	}
+
+	want := []string{
+		"select * from \"pilots\" where \"id\"=$1",
+		"select \"id\",\"name\" from \"pilots\" where \"id\"=$1",
+		"select * from \"pilots\" where \"email\"=$1",
+	}
+	calls := exec.Calls()
+	if len(calls) != 6 {
+		t.Fatalf("want 6 statements, got: %#v", calls)
+	}
+	for i, c := range calls {
+		if c.Query != want[i%3] {
+			t.Errorf("%d) want: %s\ngot: %s", i, want[i%3], c.Query)
+		}
+	}
+
+	if len(pilotFindCache) != 3 {
+		t.Errorf("want 3 cached queries, got: %v", pilotFindCache)
+	}
+}
+
+func BenchmarkFindPilot(b *testing.B) {
+	ctx := context.Background()
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	b.Run("cached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			FindPilot(ctx, exec, 1, "id", "name")
+			exec.Reset()
+		}
+	})
+
+	b.Run("uncached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			pilotFindCacheMut.Lock()
+			pilotFindCache = make(map[string]string)
+			pilotFindCacheMut.Unlock()
+
+			FindPilot(ctx, exec, 1, "id", "name")
+			exec.Reset()
+		}
+	})
+}
+`},
+	}, "-bench", "BenchmarkFindPilot", "-benchtime", "10x")
+}
+
+// TestNewModelsWhitelisted is TestNewModels for the tables that are only
+// generated when whitelisted and the options that change relationships the
+// tests of TestNewModels rely on
+func TestNewModelsWhitelisted(t *testing.T) {
+	tmp := generateModels(t, func(c *Config) {
+		c.DriverConfig[drivers.ConfigWhitelist] = []string{
+			"pilots", "jets", "airports", "licenses", "languages", "pilot_languages",
+			"employees", "messages", "comments", "flight_logs",
+		}
+		c.PrimaryKeys = map[string][]string{"flight_logs": {"id"}}
+		c.Aliases = Aliases{Tables: map[string]TableAlias{
+			"employees": {Relationships: map[string]RelationshipAlias{
+				"employees_manager_id_fk": {Local: "Reports", Foreign: "Boss"},
+			}},
+		}}
+		c.Relationships = []Relationship{
+			{
+				Table:        "pilots",
+				Name:         "AllMessages",
+				ForeignTable: "messages",
+				On:           `messages.sender_id = pilots.id or messages.recipient_id = pilots.id`,
+				ToMany:       true,
+			},
+			{
+				Table:        "messages",
+				Name:         "Author",
+				ForeignTable: "pilots",
+				On:           `pilots.id = messages.sender_id`,
+			},
+		}
+		c.Polymorphic = []Polymorphic{
+			{Table: "comments", Name: "commentable", Types: map[string]string{"pilots": "Pilot", "jets": "Jet"}},
+		}
+	}).OutFolder
+
+	snippets := []struct {
+		File     string
+		Contains []string
+		Omits    []string
+	}{
+		// Both sides of a self reference can be renamed by aliasing the
+		// foreign key
+		{
+			File: "employees.go",
+			Contains: []string{
+				`func (o *Employee) Boss(mods ...qm.QueryMod) employeeQuery {`,
+				`func (o *Employee) Reports(mods ...qm.QueryMod) employeeQuery {`,
+				`func (o *Employee) AddReports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Employee) error {`,
+			},
+			Omits: []string{`ManagerEmployees`},
+		},
+		{
+			File: "flight_logs.go",
+			Contains: []string{
+				`func FindFlightLog(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*FlightLog, error) {`,
+				`func (o *FlightLog) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {`,
+				`func (o *FlightLog) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {`,
+			},
+		},
+		{
+			File: "pilots.go",
+			Contains: []string{
+				"AllMessages MessageSlice `boil:\"AllMessages\"",
+				"func (o *Pilot) AllMessages(mods ...qm.QueryMod) messageQuery {",
+				"func (pilotL) LoadAllMessages(ctx context.Context, e boil.ContextExecutor, singular bool, maybePilot interface{}, mods queries.Applicator) error {",
+			},
+		},
+		{
+			File: "messages.go",
+			Contains: []string{
+				"Author *Pilot `boil:\"Author\"",
+				"func (messageL) LoadAuthor(",
+			},
+		},
+		{
+			File: "comments.go",
+			Contains: []string{
+				"CommentableJet   *Jet   `boil:\"CommentableJet\"",
+				"CommentablePilot *Pilot `boil:\"CommentablePilot\"",
+				"func (o *Comment) SetCommentablePilot(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Pilot) error {",
+			},
+		},
+		{
+			File: "jets.go",
+			Contains: []string{
+				"Comments CommentSlice `boil:\"Comments\"",
+				"func (o *Jet) AddComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Comment) error {",
+			},
+		},
+	}
+
+	for _, s := range snippets {
+		checkGeneratedContains(t, filepath.Join(tmp, s.File), s.Contains...)
+		checkGeneratedOmits(t, filepath.Join(tmp, s.File), s.Omits...)
+	}
+
+	if testing.Short() {
+		return
+	}
+
+	runGeneratedTests(t, tmp, []generatedTest{
+		{Name: "TestSelfReference", Src: `package models
+
+import (
+	"context"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var employeeCols = []string{"id", "name", "manager_id"}
+
+func TestSelfReference(t *testing.T) {
+	ctx := context.Background()
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	exec.Expect(
+		boiltest.Result{Columns: employeeCols, Rows: [][]interface{}{{int64(1), "Ann", nil}}},
+		boiltest.Result{Columns: employeeCols, Rows: [][]interface{}{{int64(2), "Bob", int64(1)}, {int64(3), "Cat", int64(1)}}},
+	)
+
+	boss, err := Employees(qm.Where("id = ?", 1), qm.Load(EmployeeRels.Reports)).One(ctx, exec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(boss.R.Reports) != 2 {
+		t.Fatalf("want 2 reports, got %d", len(boss.R.Reports))
+	}
+	for _, report := range boss.R.Reports {
+		if report.R.Boss != boss {
+			t.Errorf("want the boss of %s set to the loaded employee", report.Name)
+		}
+	}
+
+	exec.Reset()
+	exec.Expect(
+		boiltest.Result{Columns: employeeCols, Rows: [][]interface{}{{int64(2), "Bob", int64(1)}, {int64(3), "Cat", int64(1)}}},
+		boiltest.Result{Columns: employeeCols, Rows: [][]interface{}{{int64(1), "Ann", nil}}},
+	)
+
+	reports, err := Employees(qm.Where("manager_id = ?", 1), qm.Load(EmployeeRels.Boss)).All(ctx, exec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 2 || reports[0].R.Boss == nil || reports[0].R.Boss != reports[1].R.Boss {
+		t.Fatal("want both employees to share the loaded boss")
+	}
+	if boss := reports[0].R.Boss; boss.ID != 1 || len(boss.R.Reports) != 2 {
+		t.Errorf("want the boss to have both reports, got %d", len(boss.R.Reports))
+	}
+
+	calls := exec.Calls()
+	if len(calls) != 2 || len(calls[1].Args) != 1 || calls[1].Args[0] != int64(1) {
+		t.Errorf("want the boss loaded once by id, got: %v", calls)
+	}
+}
+`},
+		{Name: "TestPrimaryKeys", Src: `package models
+
+import (
+	"context"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+)
+
+func TestPrimaryKeys(t *testing.T) {
+	ctx := context.Background()
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	exec.Expect(boiltest.Result{
+		Columns: []string{"id", "entry", "remark"},
+		Rows:    [][]interface{}{{int64(7), "Landed", nil}},
+	})
+	log, err := FindFlightLog(ctx, exec, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if log.ID != 7 || log.Entry != "Landed" {
+		t.Errorf("want flight log 7, got: %#v", log)
+	}
+	calls := exec.Calls()
+	if len(calls) != 1 || calls[0].Query != "select * from \"flight_logs\" where \"id\"=$1" || calls[0].Args[0] != int64(7) {
+		t.Errorf("want the flight log found by id, got: %v", calls)
+	}
+
+	exec.Reset()
+	exec.Expect(boiltest.Result{RowsAffected: 1})
+	log.Entry = "Taxied"
+	if _, err = log.Update(ctx, exec, boil.Whitelist("entry")); err != nil {
+		t.Fatal(err)
+	}
+	calls = exec.Calls()
+	if len(calls) != 1 || calls[0].Query != "UPDATE \"flight_logs\" SET \"entry\"=$1 WHERE \"id\"=$2" {
+		t.Errorf("want the flight log updated by id, got: %v", calls)
+	}
+
+	exec.Reset()
+	exec.Expect(boiltest.Result{RowsAffected: 1})
+	if _, err = log.Delete(ctx, exec); err != nil {
+		t.Fatal(err)
+	}
+	calls = exec.Calls()
+	if len(calls) != 1 || calls[0].Query != "DELETE FROM \"flight_logs\" WHERE \"id\"=$1" {
+		t.Errorf("want the flight log deleted by id, got: %v", calls)
+	}
+}
+`},
+		// A load given its own select still gets the column its results are
+		// matched up on, through a join table it's selected last
+		{Name: "TestEagerLoadSelect", Src: `package models
+
+import (
+	"context"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func TestEagerLoadSelect(t *testing.T) {
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	exec.Expect(
+		boiltest.Result{
+			Columns: []string{"id", "name"},
+			Rows:    [][]interface{}{{int64(1), "Ann"}, {int64(2), "Bob"}},
+		},
+		boiltest.Result{
+			Columns: []string{"id", "pilot_id"},
+			Rows:    [][]interface{}{{int64(7), int64(1)}, {int64(8), int64(2)}},
+		},
+		boiltest.Result{
+			Columns: []string{"languages.language", "a.pilot_id"},
+			Rows:    [][]interface{}{{"Welsh", int64(2)}},
+		},
+	)
+
+	pilots, err := Pilots(
+		qm.Load(PilotRels.Licenses, qm.Select("id")),
+		qm.Load(PilotRels.Languages, qm.Select("languages.language")),
+	).All(context.Background(), exec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calls := exec.Calls()
+	if len(calls) != 3 {
+		t.Fatalf("want a query for the pilots and one for each load, got: %#v", calls)
+	}
+	for i, want := range []string{
+		` + "`" + `SELECT "id", "licenses"."pilot_id" FROM "licenses" WHERE ("licenses"."pilot_id" IN ($1,$2));` + "`" + `,
+		` + "`" + `SELECT "languages"."language" as "languages.language", "a"."pilot_id" as "a.pilot_id" FROM "languages" INNER JOIN "pilot_languages" as "a" on "languages"."id" = "a"."language_id" WHERE ("a"."pilot_id" IN ($1,$2));` + "`" + `,
+	} {
+		if calls[i+1].Query != want {
+			t.Errorf("%d) want the query:\n%s\ngot:\n%s", i, want, calls[i+1].Query)
+		}
+	}
+
+	if len(pilots[1].R.Licenses) != 1 || pilots[1].R.Licenses[0].ID != 8 {
+		t.Errorf("want Bob's license, got: %v", pilots[1].R.Licenses)
+	}
+	if len(pilots[0].R.Languages) != 0 || len(pilots[1].R.Languages) != 1 || pilots[1].R.Languages[0].Language != "Welsh" {
+		t.Errorf("want Bob to speak Welsh, got: %v and %v", pilots[0].R.Languages, pilots[1].R.Languages)
+	}
+}
+`},
+		{Name: "TestConfigRelationships", Src: `package models
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func TestConfigRelationships(t *testing.T) {
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	join := "INNER JOIN \"pilots\" on messages.sender_id = pilots.id or messages.recipient_id = pilots.id"
+
+	pilot := &Pilot{ID: 7}
+	exec.Expect(boiltest.Result{
+		Columns: []string{"id", "sender_id", "recipient_id", "body"},
+		Rows:    [][]interface{}{{int64(1), int64(7), int64(8), "hi"}},
+	})
+	messages, err := pilot.AllMessages().All(context.Background(), exec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(messages) != 1 || messages[0].Body != "hi" {
+		t.Errorf("want the pilot's message, got: %#v", messages)
+	}
+
+	call := exec.Calls()[0]
+	if !strings.Contains(call.Query, "FROM \"messages\" " + join + " WHERE (\"pilots\".\"id\"=$1)") {
+		t.Errorf("want the messages joined on the condition, got: %s", call.Query)
+	}
+	if len(call.Args) != 1 || call.Args[0] != int64(7) {
+		t.Errorf("want the pilot's id as the argument, got: %v", call.Args)
+	}
+
+	// A message of two loaded pilots is loaded for each of them
+	exec.Reset()
+	exec.Expect(boiltest.Result{
+		Columns: []string{"id", "name"},
+		Rows:    [][]interface{}{{int64(7), "Ann"}, {int64(8), "Bob"}, {int64(9), "Cat"}},
+	})
+	exec.Expect(boiltest.Result{
+		Columns: []string{"id", "sender_id", "recipient_id", "body", "id"},
+		Rows: [][]interface{}{
+			{int64(1), int64(7), int64(8), "hi", int64(7)},
+			{int64(1), int64(7), int64(8), "hi", int64(8)},
+			{int64(2), int64(8), int64(7), "hey", int64(7)},
+		},
+	})
+
+	pilots, err := Pilots(qm.Load(PilotRels.AllMessages)).All(context.Background(), exec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	load := exec.Calls()[1]
+	if !strings.Contains(load.Query, ", \"pilots\".\"id\" as \"pilots.id\" FROM \"messages\" " + join + " WHERE (\"pilots\".\"id\" IN ($1,$2,$3))") {
+		t.Errorf("want the messages of every pilot loaded in one query, got: %s", load.Query)
+	}
+	if len(pilots[0].R.AllMessages) != 2 || len(pilots[1].R.AllMessages) != 1 || len(pilots[2].R.AllMessages) != 0 {
+		t.Errorf("want the messages matched up with their pilots, got: %v, %v, %v",
+			pilots[0].R.AllMessages, pilots[1].R.AllMessages, pilots[2].R.AllMessages)
+	}
+	if pilots[1].R.AllMessages[0].Body != "hi" {
+		t.Errorf("want the message Bob received, got: %#v", pilots[1].R.AllMessages[0])
+	}
+
+	// To-one relationships get the matching row or stay nil
+	exec.Reset()
+	exec.Expect(boiltest.Result{
+		Columns: []string{"id", "sender_id", "recipient_id", "body"},
+		Rows:    [][]interface{}{{int64(1), int64(7), int64(8), "hi"}, {int64(2), int64(6), int64(7), "hey"}},
+	})
+	exec.Expect(boiltest.Result{
+		Columns: []string{"id", "name", "id"},
+		Rows:    [][]interface{}{{int64(7), "Ann", int64(1)}},
+	})
+
+	msgs, err := Messages(qm.Load(MessageRels.Author)).All(context.Background(), exec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msgs[0].R.Author == nil || msgs[0].R.Author.Name != "Ann" || msgs[1].R.Author != nil {
+		t.Errorf("want the author of the first message only, got: %#v, %#v", msgs[0].R.Author, msgs[1].R.Author)
+	}
+}
+`},
+		{Name: "TestPolymorphic", Src: `package models
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func TestPolymorphic(t *testing.T) {
+	ctx := context.Background()
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	// The comments of a pilot are those of its type and id
+	pilot := &Pilot{ID: 7}
+	if _, err := pilot.Comments().All(ctx, exec); err != nil {
+		t.Fatal(err)
+	}
+	call := exec.Calls()[0]
+	if !strings.Contains(call.Query, "WHERE (\"comments\".\"commentable_type\"=$1) AND (\"comments\".\"commentable_id\"=$2)") ||
+		len(call.Args) != 2 || call.Args[0] != "Pilot" || call.Args[1] != int64(7) {
+		t.Errorf("want the comments filtered by type and id, got: %s %v", call.Query, call.Args)
+	}
+
+	// A comment of a jet has no pilot
+	exec.Reset()
+	comment := &Comment{ID: 1, CommentableType: "Jet", CommentableID: 7}
+	if _, err := comment.CommentablePilot().All(ctx, exec); err != nil {
+		t.Fatal(err)
+	}
+	if call = exec.Calls()[0]; !strings.Contains(call.Query, "1 = 0") {
+		t.Errorf("want the pilot of a jet's comment to find nothing, got: %s", call.Query)
+	}
+
+	// Each type is loaded by its own relationship
+	exec.Reset()
+	exec.Expect(boiltest.Result{
+		Columns: []string{"id", "commentable_type", "commentable_id", "body"},
+		Rows: [][]interface{}{
+			{int64(1), "Pilot", int64(7), "a"},
+			{int64(2), "Jet", int64(3), "b"},
+			{int64(3), "Pilot", int64(7), "c"},
+			{int64(4), "Pilot", int64(8), "d"},
+		},
+	})
+	exec.Expect(boiltest.Result{
+		Columns: []string{"id", "name"},
+		Rows:    [][]interface{}{{int64(7), "Ann"}, {int64(8), "Bob"}},
+	})
+	exec.Expect(boiltest.Result{
+		Columns: []string{"id", "name"},
+		Rows:    [][]interface{}{{int64(3), "Swift"}},
+	})
+
+	comments, err := Comments(qm.Load(CommentRels.CommentablePilot), qm.Load(CommentRels.CommentableJet)).All(ctx, exec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calls := exec.Calls()
+	if len(calls) != 3 {
+		t.Fatalf("want a query for the comments and one for each type, got %d: %v", len(calls), calls)
+	}
+	if len(calls[1].Args) != 2 || calls[1].Args[0] != int64(7) || calls[1].Args[1] != int64(8) {
+		t.Errorf("want the pilots of the pilots' comments loaded, got: %v", calls[1].Args)
+	}
+	if len(calls[2].Args) != 1 || calls[2].Args[0] != int64(3) {
+		t.Errorf("want the jet of the jet's comment loaded, got: %v", calls[2].Args)
+	}
+
+	if comments[0].R.CommentablePilot.Name != "Ann" || comments[0].R.CommentableJet != nil {
+		t.Errorf("want the first comment's pilot, got: %#v", comments[0].R)
+	}
+	if comments[1].R.CommentableJet.Name != "Swift" || comments[1].R.CommentablePilot != nil {
+		t.Errorf("want the second comment's jet, got: %#v", comments[1].R)
+	}
+	if ann := comments[2].R.CommentablePilot; ann != comments[0].R.CommentablePilot || len(ann.R.Comments) != 2 {
+		t.Errorf("want both of Ann's comments referred to by her, got: %#v", ann)
+	}
+
+	// The other way around the comments are filtered by type
+	exec.Reset()
+	exec.Expect(boiltest.Result{
+		Columns: []string{"id", "name"},
+		Rows:    [][]interface{}{{int64(7), "Ann"}, {int64(8), "Bob"}},
+	})
+	exec.Expect(boiltest.Result{
+		Columns: []string{"id", "commentable_type", "commentable_id", "body"},
+		Rows:    [][]interface{}{{int64(1), "Pilot", int64(7), "a"}, {int64(3), "Pilot", int64(7), "c"}},
+	})
+
+	pilots, err := Pilots(qm.Load(PilotRels.Comments)).All(ctx, exec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	load := exec.Calls()[1]
+	if !strings.Contains(load.Query, "(\"comments\".\"commentable_type\"=$1) AND (\"comments\".\"commentable_id\" IN ($2,$3))") ||
+		load.Args[0] != "Pilot" {
+		t.Errorf("want the pilots' comments loaded by type and id, got: %s %v", load.Query, load.Args)
+	}
+	if len(pilots[0].R.Comments) != 2 || len(pilots[1].R.Comments) != 0 || pilots[0].R.Comments[0].R.CommentablePilot != pilots[0] {
+		t.Errorf("want Ann's comments loaded, got: %v, %v", pilots[0].R.Comments, pilots[1].R.Comments)
+	}
+
+	// Setting the pilot of a comment sets both its type and id
+	exec.Reset()
+	comment = &Comment{ID: 5, CommentableType: "Jet", CommentableID: 3, R: &commentR{CommentableJet: &Jet{ID: 3}}}
+	if err := comment.SetCommentablePilot(ctx, exec, false, pilot); err != nil {
+		t.Fatal(err)
+	}
+	call = exec.Calls()[0]
+	if call.Query != "UPDATE \"comments\" SET \"commentable_type\"=$1,\"commentable_id\"=$2 WHERE \"id\"=$3" ||
+		call.Args[0] != "Pilot" || call.Args[1] != int64(7) || call.Args[2] != int64(5) {
+		t.Errorf("want the type and id of the comment updated, got: %s %v", call.Query, call.Args)
+	}
+	if comment.CommentableType != "Pilot" || comment.CommentableID != 7 || comment.R.CommentablePilot != pilot {
+		t.Errorf("want the comment to belong to the pilot, got: %#v", comment)
+	}
+	if comment.R.CommentableJet != nil {
+		t.Errorf("want the jet the comment belonged to forgotten, got: %#v", comment.R.CommentableJet)
+	}
+
+	// Adding a comment to a jet does the same from the other side
+	exec.Reset()
+	jet := &Jet{ID: 3}
+	if err := jet.AddComments(ctx, exec, false, comment); err != nil {
+		t.Fatal(err)
+	}
+	if call = exec.Calls()[0]; call.Args[0] != "Jet" || call.Args[1] != int64(3) {
+		t.Errorf("want the comment moved to the jet, got: %s %v", call.Query, call.Args)
+	}
+	if comment.CommentableType != "Jet" || comment.CommentableID != 3 || comment.R.CommentableJet != jet || jet.R.Comments[0] != comment {
+		t.Errorf("want the comment to belong to the jet, got: %#v", comment)
+	}
+	if comment.R.CommentablePilot != nil {
+		t.Errorf("want the pilot the comment belonged to forgotten, got: %#v", comment.R.CommentablePilot)
+	}
+}
+`},
+	})
+}
@@ -0,0 +1,215 @@
+package boilingcore
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/friendsofgo/errors"
+)
+
+// buildExpr is a node of a parsed build constraint expression, a tag when
+// op is empty, otherwise a !, && or || of its args
+type buildExpr struct {
+	op   string
+	tag  string
+	args []*buildExpr
+}
+
+// buildConstraint parses the expression of a //go:build line and returns
+// the //go:build line along with the // +build lines meaning the same, for
+// the versions of go from before //go:build
+func buildConstraint(expr string) (string, error) {
+	p := &buildTagParser{}
+	if err := p.tokenize(expr); err != nil {
+		return "", err
+	}
+
+	x, err := p.or()
+	if err != nil {
+		return "", err
+	}
+	if p.pos != len(p.toks) {
+		return "", errors.Errorf("unexpected %q in build tags %q", p.toks[p.pos], expr)
+	}
+
+	lines := []string{"//go:build " + x.String()}
+	for _, and := range x.splitAnd() {
+		var terms []string
+		for _, lits := range and.dnf() {
+			terms = append(terms, strings.Join(lits, ","))
+		}
+		lines = append(lines, "// +build "+strings.Join(terms, " "))
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// String formats the expression the way gofmt would
+func (x *buildExpr) String() string {
+	switch x.op {
+	case "":
+		return x.tag
+	case "!":
+		return "!" + x.args[0].stringIn("!")
+	default:
+		return x.args[0].stringIn(x.op) + " " + x.op + " " + x.args[1].stringIn(x.op)
+	}
+}
+
+// stringIn formats the expression as an operand of op, in parentheses
+// when it's a different && or ||
+func (x *buildExpr) stringIn(op string) string {
+	if x.op == "" || x.op == "!" || x.op == op {
+		return x.String()
+	}
+	return "(" + x.String() + ")"
+}
+
+// splitAnd splits the expression at its top level &&s, each of them is a
+// // +build line of its own
+func (x *buildExpr) splitAnd() []*buildExpr {
+	if x.op != "&&" {
+		return []*buildExpr{x}
+	}
+	return append(x.args[0].splitAnd(), x.args[1].splitAnd()...)
+}
+
+// dnf returns the expression as an || of &&s of possibly negated tags
+func (x *buildExpr) dnf() [][]string {
+	return x.dnfNot(false)
+}
+
+func (x *buildExpr) dnfNot(not bool) [][]string {
+	op := x.op
+	if not {
+		switch op {
+		case "&&":
+			op = "||"
+		case "||":
+			op = "&&"
+		}
+	}
+
+	switch op {
+	case "":
+		if not {
+			return [][]string{{"!" + x.tag}}
+		}
+		return [][]string{{x.tag}}
+	case "!":
+		return x.args[0].dnfNot(!not)
+	case "||":
+		return append(x.args[0].dnfNot(not), x.args[1].dnfNot(not)...)
+	default:
+		var out [][]string
+		for _, left := range x.args[0].dnfNot(not) {
+			for _, right := range x.args[1].dnfNot(not) {
+				lits := append(append([]string{}, left...), right...)
+				out = append(out, lits)
+			}
+		}
+		return out
+	}
+}
+
+// buildTagParser is a recursive descent parser of build constraint
+// expressions
+type buildTagParser struct {
+	expr string
+	toks []string
+	pos  int
+}
+
+func (p *buildTagParser) tokenize(expr string) error {
+	p.expr = expr
+	for i := 0; i < len(expr); {
+		c := rune(expr[i])
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '(' || c == ')' || c == '!':
+			p.toks = append(p.toks, string(c))
+			i++
+		case strings.HasPrefix(expr[i:], "&&") || strings.HasPrefix(expr[i:], "||"):
+			p.toks = append(p.toks, expr[i:i+2])
+			i += 2
+		case isBuildTagRune(c):
+			j := i
+			for j < len(expr) && isBuildTagRune(rune(expr[j])) {
+				j++
+			}
+			p.toks = append(p.toks, expr[i:j])
+			i = j
+		default:
+			return errors.Errorf("unexpected %q in build tags %q", c, expr)
+		}
+	}
+
+	if len(p.toks) == 0 {
+		return errors.New("build tags must not be empty")
+	}
+	return nil
+}
+
+func isBuildTagRune(c rune) bool {
+	return c < unicode.MaxASCII && (unicode.IsLetter(c) || unicode.IsDigit(c) || c == '_' || c == '.')
+}
+
+func (p *buildTagParser) or() (*buildExpr, error) {
+	x, err := p.and()
+	for err == nil && p.peek() == "||" {
+		p.pos++
+		var y *buildExpr
+		if y, err = p.and(); err == nil {
+			x = &buildExpr{op: "||", args: []*buildExpr{x, y}}
+		}
+	}
+	return x, err
+}
+
+func (p *buildTagParser) and() (*buildExpr, error) {
+	x, err := p.not()
+	for err == nil && p.peek() == "&&" {
+		p.pos++
+		var y *buildExpr
+		if y, err = p.not(); err == nil {
+			x = &buildExpr{op: "&&", args: []*buildExpr{x, y}}
+		}
+	}
+	return x, err
+}
+
+func (p *buildTagParser) not() (*buildExpr, error) {
+	tok := p.peek()
+	p.pos++
+
+	switch tok {
+	case "!":
+		x, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		return &buildExpr{op: "!", args: []*buildExpr{x}}, nil
+	case "(":
+		x, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, errors.Errorf("missing ) in build tags %q", p.expr)
+		}
+		p.pos++
+		return x, nil
+	case "", ")", "&&", "||":
+		return nil, errors.Errorf("missing a tag in build tags %q", p.expr)
+	default:
+		return &buildExpr{tag: tok}, nil
+	}
+}
+
+func (p *buildTagParser) peek() string {
+	if p.pos >= len(p.toks) {
+		return ""
+	}
+	return p.toks[p.pos]
+}
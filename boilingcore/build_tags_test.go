@@ -0,0 +1,41 @@
+package boilingcore
+
+import "testing"
+
+func TestBuildConstraint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Expr string
+		Want string
+	}{
+		{"nomodels", "//go:build nomodels\n// +build nomodels"},
+		{" !nomodels ", "//go:build !nomodels\n// +build !nomodels"},
+		{"linux||darwin", "//go:build linux || darwin\n// +build linux darwin"},
+		{"!nomodels && (linux || darwin)", "//go:build !nomodels && (linux || darwin)\n// +build !nomodels\n// +build linux darwin"},
+		{"(a && b) || c", "//go:build (a && b) || c\n// +build a,b c"},
+		{"!(a || b) && go1.14", "//go:build !(a || b) && go1.14\n// +build !a,!b\n// +build go1.14"},
+		{"(a || b) && !(c && d)", "//go:build (a || b) && !(c && d)\n// +build a b\n// +build !c !d"},
+	}
+
+	for i, test := range tests {
+		got, err := buildConstraint(test.Expr)
+		if err != nil {
+			t.Errorf("%d) %s: %v", i, test.Expr, err)
+			continue
+		}
+		if got != test.Want {
+			t.Errorf("%d) %s\nwant:\n%s\ngot:\n%s", i, test.Expr, test.Want, got)
+		}
+	}
+}
+
+func TestBuildConstraintErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, expr := range []string{"", " ", "a &&", "&& a", "(a || b", "a b", "a)", "a & b", "a,b", "!"} {
+		if _, err := buildConstraint(expr); err == nil {
+			t.Errorf("%q: expected an error", expr)
+		}
+	}
+}
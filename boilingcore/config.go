@@ -2,6 +2,7 @@ package boilingcore
 
 import (
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/spf13/cast"
@@ -17,12 +18,16 @@ type Config struct {
 	PkgName           string   `toml:"pkg_name,omitempty" json:"pkg_name,omitempty"`
 	OutFolder         string   `toml:"out_folder,omitempty" json:"out_folder,omitempty"`
 	TemplateDirs      []string `toml:"template_dirs,omitempty" json:"template_dirs,omitempty"`
+	ExtraTemplateDirs []string `toml:"extra_template_dirs,omitempty" json:"extra_template_dirs,omitempty"`
 	Tags              []string `toml:"tags,omitempty" json:"tags,omitempty"`
 	Replacements      []string `toml:"replacements,omitempty" json:"replacements,omitempty"`
 	Debug             bool     `toml:"debug,omitempty" json:"debug,omitempty"`
 	AddGlobal         bool     `toml:"add_global,omitempty" json:"add_global,omitempty"`
 	AddPanic          bool     `toml:"add_panic,omitempty" json:"add_panic,omitempty"`
 	AddSoftDeletes    bool     `toml:"add_soft_deletes,omitempty" json:"add_soft_deletes,omitempty"`
+	AddEnumTypes      bool     `toml:"add_enum_types,omitempty" json:"add_enum_types,omitempty"`
+	AddValidation     bool     `toml:"add_validation,omitempty" json:"add_validation,omitempty"`
+	AddInterfaces     bool     `toml:"add_interfaces,omitempty" json:"add_interfaces,omitempty"`
 	NoContext         bool     `toml:"no_context,omitempty" json:"no_context,omitempty"`
 	NoTests           bool     `toml:"no_tests,omitempty" json:"no_tests,omitempty"`
 	NoHooks           bool     `toml:"no_hooks,omitempty" json:"no_hooks,omitempty"`
@@ -30,19 +35,53 @@ type Config struct {
 	NoRowsAffected    bool     `toml:"no_rows_affected,omitempty" json:"no_rows_affected,omitempty"`
 	NoDriverTemplates bool     `toml:"no_driver_templates,omitempty" json:"no_driver_templates,omitempty"`
 	NoBackReferencing bool     `toml:"no_back_reference,omitempty" json:"no_back_reference,omitempty"`
+	NullablePointers  bool     `toml:"nullable_pointers,omitempty" json:"nullable_pointers,omitempty"`
 	Wipe              bool     `toml:"wipe,omitempty" json:"wipe,omitempty"`
+	Clean             bool     `toml:"clean,omitempty" json:"clean,omitempty"`
+	DryRun            bool     `toml:"dry_run,omitempty" json:"dry_run,omitempty"`
+	OutputLayout      string   `toml:"output_layout,omitempty" json:"output_layout,omitempty"`
+	OutputFile        string   `toml:"output_file,omitempty" json:"output_file,omitempty"`
+	Header            string   `toml:"header,omitempty" json:"header,omitempty"`
+	BuildTags         string   `toml:"build_tags,omitempty" json:"build_tags,omitempty"`
 	StructTagCasing   string   `toml:"struct_tag_casing,omitempty" json:"struct_tag_casing,omitempty"`
 	RelationTag       string   `toml:"relation_tag,omitempty" json:"relation_tag,omitempty"`
 	TagIgnore         []string `toml:"tag_ignore,omitempty" json:"tag_ignore,omitempty"`
 
 	Imports importers.Collection `toml:"imports,omitempty" json:"imports,omitempty"`
 
-	Aliases      Aliases       `toml:"aliases,omitempty" json:"aliases,omitempty"`
-	TypeReplaces []TypeReplace `toml:"type_replaces,omitempty" json:"type_replaces,omitempty"`
+	Aliases        Aliases             `toml:"aliases,omitempty" json:"aliases,omitempty"`
+	AutoColumns    AutoColumns         `toml:"auto_columns,omitempty" json:"auto_columns,omitempty"`
+	TypeReplaces   []TypeReplace       `toml:"type_replaces,omitempty" json:"type_replaces,omitempty"`
+	OptimisticLock map[string]string   `toml:"optimistic_lock,omitempty" json:"optimistic_lock,omitempty"`
+	PrimaryKeys    map[string][]string `toml:"primary_keys,omitempty" json:"primary_keys,omitempty"`
+	ResultTypes    []ResultType        `toml:"result_types,omitempty" json:"result_types,omitempty"`
+	Relationships  []Relationship      `toml:"relationships,omitempty" json:"relationships,omitempty"`
+	Polymorphic    []Polymorphic       `toml:"polymorphic,omitempty" json:"polymorphic,omitempty"`
+	JSONTypes      map[string]JSONType `toml:"json_types,omitempty" json:"json_types,omitempty"`
+	TagCases       map[string]string   `toml:"struct_tag_cases,omitempty" json:"struct_tag_cases,omitempty"`
+	Inflections    Inflections         `toml:"inflections,omitempty" json:"inflections,omitempty"`
+	Schemas        map[string]string   `toml:"schemas,omitempty" json:"schemas,omitempty"`
 
 	Version string `toml:"version" json:"version"`
 }
 
+// The layouts of the generated files, see Config.OutputLayout
+const (
+	// OutputLayoutTable outputs the models of each table into their own
+	// files, the default
+	OutputLayoutTable = "table"
+	// OutputLayoutSingle outputs the models of all tables into one file
+	OutputLayoutSingle = "single"
+)
+
+// AutoColumns are the names of the columns that the generated code
+// sets automatically, an empty name means the default is used
+type AutoColumns struct {
+	Created string `toml:"created,omitempty" json:"created,omitempty"`
+	Updated string `toml:"updated,omitempty" json:"updated,omitempty"`
+	Deleted string `toml:"deleted,omitempty" json:"deleted,omitempty"`
+}
+
 // TypeReplace replaces a column type with something else
 type TypeReplace struct {
 	Tables  []string       `toml:"tables,omitempty" json:"tables,omitempty"`
@@ -51,6 +90,50 @@ type TypeReplace struct {
 	Imports importers.Set  `toml:"imports,omitempty" json:"imports,omitempty"`
 }
 
+// JSONType is the Go type a json column is marshalled from and unmarshalled
+// into, it is keyed by table.column in Config.JSONTypes
+type JSONType struct {
+	Type    string        `toml:"type,omitempty" json:"type,omitempty"`
+	Imports importers.Set `toml:"imports,omitempty" json:"imports,omitempty"`
+}
+
+// ResultType is a struct generated for the rows of queries that don't map to
+// a single table, like reports, along with the functions that bind them
+type ResultType struct {
+	Name    string         `toml:"name,omitempty" json:"name,omitempty"`
+	Columns []ResultColumn `toml:"columns,omitempty" json:"columns,omitempty"`
+	Imports importers.Set  `toml:"imports,omitempty" json:"imports,omitempty"`
+}
+
+// ResultColumn is a column of a ResultType and the Go type it's bound to
+type ResultColumn struct {
+	Name string `toml:"name,omitempty" json:"name,omitempty"`
+	Type string `toml:"type,omitempty" json:"type,omitempty"`
+}
+
+// Relationship is a relationship that the foreign keys don't describe, like
+// a polymorphic association. The rows of ForeignTable it loads are those that
+// match On, a raw join condition, when joined with the row of Table.
+type Relationship struct {
+	Table        string `toml:"table,omitempty" json:"table,omitempty"`
+	Name         string `toml:"name,omitempty" json:"name,omitempty"`
+	ForeignTable string `toml:"foreign_table,omitempty" json:"foreign_table,omitempty"`
+	On           string `toml:"on,omitempty" json:"on,omitempty"`
+	ToMany       bool   `toml:"to_many,omitempty" json:"to_many,omitempty"`
+}
+
+// Polymorphic is a polymorphic association, where a row of Table belongs to a
+// row of any of the tables in Types. TypeColumn holds the type of the row it
+// belongs to, the value of that table in Types, and IDColumn its primary key.
+// The columns default to Name followed by _type and _id, like in Rails.
+type Polymorphic struct {
+	Table      string            `toml:"table,omitempty" json:"table,omitempty"`
+	Name       string            `toml:"name,omitempty" json:"name,omitempty"`
+	TypeColumn string            `toml:"type_column,omitempty" json:"type_column,omitempty"`
+	IDColumn   string            `toml:"id_column,omitempty" json:"id_column,omitempty"`
+	Types      map[string]string `toml:"types,omitempty" json:"types,omitempty"`
+}
+
 // OutputDirDepth returns depth of output directory
 func (c *Config) OutputDirDepth() int {
 	d := filepath.ToSlash(filepath.Clean(c.OutFolder))
@@ -61,6 +144,66 @@ func (c *Config) OutputDirDepth() int {
 	return strings.Count(d, "/") + 1
 }
 
+// SchemaConfigs returns a config for each of the Schemas, which generates
+// that schema into its output folder using the folder's name as the package
+// name. Without Schemas it returns c itself.
+func (c *Config) SchemaConfigs() []*Config {
+	if len(c.Schemas) == 0 {
+		return []*Config{c}
+	}
+
+	schemas := make([]string, 0, len(c.Schemas))
+	for schema := range c.Schemas {
+		schemas = append(schemas, schema)
+	}
+	sort.Strings(schemas)
+
+	configs := make([]*Config, 0, len(schemas))
+	for _, schema := range schemas {
+		config := *c
+		config.Schemas = nil
+		config.OutFolder = c.Schemas[schema]
+		config.PkgName = filepath.Base(config.OutFolder)
+
+		// Generating a schema changes these, so each needs its own copy
+		config.DriverConfig = make(drivers.Config, len(c.DriverConfig)+1)
+		for k, v := range c.DriverConfig {
+			config.DriverConfig[k] = v
+		}
+		config.DriverConfig[drivers.ConfigSchema] = schema
+		config.Imports = importers.Merge(importers.Collection{}, c.Imports)
+		config.Aliases = copyAliases(c.Aliases)
+
+		configs = append(configs, &config)
+	}
+
+	return configs
+}
+
+func copyAliases(a Aliases) Aliases {
+	if a.Tables == nil {
+		return a
+	}
+
+	tables := make(map[string]TableAlias, len(a.Tables))
+	for name, t := range a.Tables {
+		columns := make(map[string]string, len(t.Columns))
+		for k, v := range t.Columns {
+			columns[k] = v
+		}
+		relationships := make(map[string]RelationshipAlias, len(t.Relationships))
+		for k, v := range t.Relationships {
+			relationships[k] = v
+		}
+
+		t.Columns = columns
+		t.Relationships = relationships
+		tables[name] = t
+	}
+
+	return Aliases{Tables: tables}
+}
+
 // ConvertAliases is necessary because viper
 //
 // It also supports two different syntaxes, because of viper:
@@ -212,6 +355,117 @@ func ConvertTypeReplace(i interface{}) []TypeReplace {
 	return replaces
 }
 
+// ConvertJSONTypes is necessary because viper splits the table.column keys
+// into a table and its columns
+func ConvertJSONTypes(i interface{}) map[string]JSONType {
+	if i == nil {
+		return nil
+	}
+
+	jsonTypes := make(map[string]JSONType)
+	for table, cols := range cast.ToStringMap(i) {
+		for col, typIntf := range cast.ToStringMap(cols) {
+			typ := cast.ToStringMap(typIntf)
+
+			var jsonType JSONType
+			if s := typ["type"]; s != nil {
+				jsonType.Type = s.(string)
+			}
+			if imps := typ["imports"]; imps != nil {
+				var err error
+				jsonType.Imports, err = importers.SetFromInterface(cast.ToStringMap(imps))
+				if err != nil {
+					panic(err)
+				}
+			}
+
+			jsonTypes[table+"."+col] = jsonType
+		}
+	}
+
+	return jsonTypes
+}
+
+// ConvertResultTypes is necessary because viper leaves the list of result
+// types as interfaces
+func ConvertResultTypes(i interface{}) []ResultType {
+	if i == nil {
+		return nil
+	}
+
+	var resultTypes []ResultType
+	for _, intf := range cast.ToSlice(i) {
+		m := cast.ToStringMap(intf)
+
+		var resultType ResultType
+		resultType.Name = cast.ToString(m["name"])
+		for _, colIntf := range cast.ToSlice(m["columns"]) {
+			col := cast.ToStringMap(colIntf)
+			resultType.Columns = append(resultType.Columns, ResultColumn{
+				Name: cast.ToString(col["name"]),
+				Type: cast.ToString(col["type"]),
+			})
+		}
+		if imps := m["imports"]; imps != nil {
+			var err error
+			resultType.Imports, err = importers.SetFromInterface(cast.ToStringMap(imps))
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		resultTypes = append(resultTypes, resultType)
+	}
+
+	return resultTypes
+}
+
+// ConvertRelationships is necessary because viper leaves the list of
+// relationships as interfaces
+func ConvertRelationships(i interface{}) []Relationship {
+	if i == nil {
+		return nil
+	}
+
+	var relationships []Relationship
+	for _, intf := range cast.ToSlice(i) {
+		m := cast.ToStringMap(intf)
+
+		relationships = append(relationships, Relationship{
+			Table:        cast.ToString(m["table"]),
+			Name:         cast.ToString(m["name"]),
+			ForeignTable: cast.ToString(m["foreign_table"]),
+			On:           cast.ToString(m["on"]),
+			ToMany:       cast.ToBool(m["to_many"]),
+		})
+	}
+
+	return relationships
+}
+
+// ConvertPolymorphic is necessary because viper leaves the list of
+// polymorphic associations as interfaces
+func ConvertPolymorphic(i interface{}) []Polymorphic {
+	if i == nil {
+		return nil
+	}
+
+	var polymorphic []Polymorphic
+	for _, intf := range cast.ToSlice(i) {
+		m := cast.ToStringMap(intf)
+
+		polymorphic = append(polymorphic, Polymorphic{
+			Table:      cast.ToString(m["table"]),
+			Name:       cast.ToString(m["name"]),
+			TypeColumn: cast.ToString(m["type_column"]),
+			IDColumn:   cast.ToString(m["id_column"]),
+			Types:      cast.ToStringMapString(m["types"]),
+		})
+	}
+
+	return polymorphic
+}
+
 func tablesOfTypeReplace(i interface{}) []string {
 	tables := []string{}
 
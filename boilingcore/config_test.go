@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/sqlboiler/v4/importers"
 )
 
 func TestConfig_OutputDirDepth(t *testing.T) {
@@ -42,6 +43,72 @@ func TestConfig_OutputDirDepth(t *testing.T) {
 	}
 }
 
+func TestConfig_SchemaConfigs(t *testing.T) {
+	t.Parallel()
+
+	cfg := &Config{OutFolder: "models", PkgName: "models"}
+	if got := cfg.SchemaConfigs(); len(got) != 1 || got[0] != cfg {
+		t.Errorf("without schemas it should return the config itself: %#v", got)
+	}
+
+	cfg = &Config{
+		OutFolder: "models",
+		PkgName:   "models",
+		Schemas: map[string]string{
+			"public": "models/public",
+			"hr":     "models/staff",
+		},
+		DriverConfig: drivers.Config{
+			drivers.ConfigSchema:    "public",
+			drivers.ConfigWhitelist: []string{"users"},
+		},
+		Imports: importers.Collection{
+			All: importers.Set{Standard: importers.List{`"fmt"`}},
+		},
+		Aliases: Aliases{Tables: map[string]TableAlias{
+			"users": {UpSingular: "Person", Columns: map[string]string{"id": "PersonID"}},
+		}},
+	}
+
+	got := cfg.SchemaConfigs()
+	if len(got) != 2 {
+		t.Fatalf("want a config for each schema, got: %d", len(got))
+	}
+
+	for i, want := range []struct{ Schema, OutFolder, PkgName string }{
+		{"hr", "models/staff", "staff"},
+		{"public", "models/public", "public"},
+	} {
+		c := got[i]
+		if c.DriverConfig[drivers.ConfigSchema] != want.Schema || c.OutFolder != want.OutFolder || c.PkgName != want.PkgName {
+			t.Errorf("%d) want %#v, got schema %v, out folder %s, package %s", i, want, c.DriverConfig[drivers.ConfigSchema], c.OutFolder, c.PkgName)
+		}
+		if c.Schemas != nil {
+			t.Errorf("%d) schemas should not be copied", i)
+		}
+		if !reflect.DeepEqual(c.DriverConfig[drivers.ConfigWhitelist], []string{"users"}) {
+			t.Errorf("%d) want the rest of the driver config, got: %#v", i, c.DriverConfig)
+		}
+		if c.Aliases.Tables["users"].Columns["id"] != "PersonID" {
+			t.Errorf("%d) want the aliases, got: %#v", i, c.Aliases)
+		}
+	}
+
+	// Generating mutates these, the schemas must not share them
+	got[0].DriverConfig["extra"] = true
+	got[0].Imports.All.Standard[0] = `"os"`
+	got[0].Aliases.Tables["users"].Columns["name"] = "Name"
+	if _, ok := got[1].DriverConfig["extra"]; ok || cfg.DriverConfig[drivers.ConfigSchema] != "public" {
+		t.Error("driver config is shared")
+	}
+	if got[1].Imports.All.Standard[0] != `"fmt"` || cfg.Imports.All.Standard[0] != `"fmt"` {
+		t.Error("imports are shared")
+	}
+	if _, ok := got[1].Aliases.Tables["users"].Columns["name"]; ok {
+		t.Error("aliases are shared")
+	}
+}
+
 func TestConvertAliases(t *testing.T) {
 	t.Parallel()
 
@@ -254,3 +321,153 @@ func TestConvertTypeReplace(t *testing.T) {
 		t.Error("tables in types.match wrong:", got)
 	}
 }
+
+func TestConvertJSONTypes(t *testing.T) {
+	t.Parallel()
+
+	var intf interface{} = map[string]interface{}{
+		"jets": map[string]interface{}{
+			"manifest": map[string]interface{}{
+				"type": "shipping.Manifest",
+				"imports": map[string]interface{}{
+					"third_party": []interface{}{
+						`"github.com/me/shipping"`,
+					},
+				},
+			},
+		},
+	}
+
+	want := map[string]JSONType{
+		"jets.manifest": {
+			Type:    "shipping.Manifest",
+			Imports: importers.Set{ThirdParty: importers.List{`"github.com/me/shipping"`}},
+		},
+	}
+	if got := ConvertJSONTypes(intf); !reflect.DeepEqual(got, want) {
+		t.Errorf("want: %#v\ngot: %#v", want, got)
+	}
+
+	if got := ConvertJSONTypes(nil); got != nil {
+		t.Errorf("want nil, got: %#v", got)
+	}
+}
+
+func TestConvertResultTypes(t *testing.T) {
+	t.Parallel()
+
+	var intf interface{} = []interface{}{
+		map[string]interface{}{
+			"name": "PilotJetCount",
+			"columns": []interface{}{
+				map[string]interface{}{"name": "pilot_name", "type": "string"},
+				map[string]interface{}{"name": "jets", "type": "int64"},
+			},
+			"imports": map[string]interface{}{
+				"standard": []interface{}{`"time"`},
+			},
+		},
+	}
+
+	want := []ResultType{
+		{
+			Name: "PilotJetCount",
+			Columns: []ResultColumn{
+				{Name: "pilot_name", Type: "string"},
+				{Name: "jets", Type: "int64"},
+			},
+			Imports: importers.Set{Standard: importers.List{`"time"`}},
+		},
+	}
+	if got := ConvertResultTypes(intf); !reflect.DeepEqual(got, want) {
+		t.Errorf("want: %#v\ngot: %#v", want, got)
+	}
+
+	if got := ConvertResultTypes(nil); got != nil {
+		t.Errorf("want nil, got: %#v", got)
+	}
+}
+
+func TestConvertRelationships(t *testing.T) {
+	t.Parallel()
+
+	var intf interface{} = []interface{}{
+		map[string]interface{}{
+			"table":         "pilots",
+			"name":          "AllMessages",
+			"foreign_table": "messages",
+			"on":            "messages.sender_id = pilots.id or messages.recipient_id = pilots.id",
+			"to_many":       true,
+		},
+		map[string]interface{}{
+			"table":         "messages",
+			"name":          "Author",
+			"foreign_table": "pilots",
+			"on":            "pilots.id = messages.sender_id",
+		},
+	}
+
+	want := []Relationship{
+		{
+			Table:        "pilots",
+			Name:         "AllMessages",
+			ForeignTable: "messages",
+			On:           "messages.sender_id = pilots.id or messages.recipient_id = pilots.id",
+			ToMany:       true,
+		},
+		{
+			Table:        "messages",
+			Name:         "Author",
+			ForeignTable: "pilots",
+			On:           "pilots.id = messages.sender_id",
+		},
+	}
+	if got := ConvertRelationships(intf); !reflect.DeepEqual(got, want) {
+		t.Errorf("want: %#v\ngot: %#v", want, got)
+	}
+
+	if got := ConvertRelationships(nil); got != nil {
+		t.Errorf("want nil, got: %#v", got)
+	}
+}
+
+func TestConvertPolymorphic(t *testing.T) {
+	t.Parallel()
+
+	var intf interface{} = []interface{}{
+		map[string]interface{}{
+			"table": "comments",
+			"name":  "commentable",
+			"types": map[string]interface{}{"pilots": "Pilot", "jets": "Jet"},
+		},
+		map[string]interface{}{
+			"table":       "attachments",
+			"name":        "owner",
+			"type_column": "owner_kind",
+			"id_column":   "owner_ref",
+			"types":       map[string]interface{}{"pilots": "pilot"},
+		},
+	}
+
+	want := []Polymorphic{
+		{
+			Table: "comments",
+			Name:  "commentable",
+			Types: map[string]string{"pilots": "Pilot", "jets": "Jet"},
+		},
+		{
+			Table:      "attachments",
+			Name:       "owner",
+			TypeColumn: "owner_kind",
+			IDColumn:   "owner_ref",
+			Types:      map[string]string{"pilots": "pilot"},
+		},
+	}
+	if got := ConvertPolymorphic(intf); !reflect.DeepEqual(got, want) {
+		t.Errorf("want: %#v\ngot: %#v", want, got)
+	}
+
+	if got := ConvertPolymorphic(nil); got != nil {
+		t.Errorf("want nil, got: %#v", got)
+	}
+}
@@ -0,0 +1,41 @@
+package boilingcore
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+// What a dry run would do to a file
+const (
+	dryRunCreate    = "create"
+	dryRunOverwrite = "overwrite"
+	dryRunUnchanged = "unchanged"
+	dryRunRemove    = "remove"
+)
+
+// dryRunOut is where the summary of a dry run is written
+var dryRunOut io.Writer = os.Stdout
+
+type dryRunFile struct {
+	Action string
+	Path   string
+}
+
+// writeDryRunSummary writes each file with what would have been done to it,
+// followed by the number of files for each action
+func writeDryRunSummary(w io.Writer, files []dryRunFile) {
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+
+	counts := make(map[string]int)
+	for _, f := range files {
+		counts[f.Action]++
+		fmt.Fprintf(w, "%-9s %s\n", f.Action, f.Path)
+	}
+
+	fmt.Fprintf(w, "dry run: %d to create, %d to overwrite, %d unchanged, %d to remove\n",
+		counts[dryRunCreate], counts[dryRunOverwrite], counts[dryRunUnchanged], counts[dryRunRemove])
+}
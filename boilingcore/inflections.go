@@ -0,0 +1,121 @@
+package boilingcore
+
+import (
+	"strings"
+
+	"github.com/volatiletech/strmangle"
+)
+
+// Inflections adds words to the rules used to pluralize and singularize
+// table names when naming the generated code, for the words the default
+// rules get wrong.
+type Inflections struct {
+	// Irregular maps the singular form of a word to its plural, ex:
+	// criterion = "criteria"
+	Irregular map[string]string `toml:"irregular,omitempty" json:"irregular,omitempty"`
+	// Uncountable words are the same in their singular and plural form, ex:
+	// sheep or equipment
+	Uncountable []string `toml:"uncountable,omitempty" json:"uncountable,omitempty"`
+	// Initialisms are words written as given instead of title cased when
+	// they make up a whole word of a name, ex: SKU or OAuth. These are in
+	// addition to the ones strmangle knows of, such as ID, API and URL.
+	Initialisms []string `toml:"initialisms,omitempty" json:"initialisms,omitempty"`
+}
+
+// Plural returns the plural form of the last word of a snake_case name
+func (i Inflections) Plural(name string) string {
+	prefix, word := splitLastWord(name)
+
+	if strmangle.SetInclude(word, i.Uncountable) {
+		return name
+	}
+	for singular, plural := range i.Irregular {
+		if word == singular {
+			return prefix + plural
+		}
+		if word == plural {
+			return name
+		}
+	}
+
+	return strmangle.Plural(name)
+}
+
+// Singular returns the singular form of the last word of a snake_case name
+func (i Inflections) Singular(name string) string {
+	prefix, word := splitLastWord(name)
+
+	if strmangle.SetInclude(word, i.Uncountable) {
+		return name
+	}
+	for singular, plural := range i.Irregular {
+		if word == plural {
+			return prefix + singular
+		}
+		if word == singular {
+			return name
+		}
+	}
+
+	return strmangle.Singular(name)
+}
+
+// TitleCase is strmangle.TitleCase, except that words which are one of
+// the Initialisms are written the way they were configured
+func (i Inflections) TitleCase(name string) string {
+	if len(i.Initialisms) == 0 {
+		return strmangle.TitleCase(name)
+	}
+
+	buf := strmangle.GetBuffer()
+	defer strmangle.PutBuffer(buf)
+
+	for _, word := range strings.Split(name, "_") {
+		if len(word) == 0 {
+			continue
+		}
+		if initialism, ok := i.initialism(word); ok {
+			buf.WriteString(initialism)
+		} else {
+			buf.WriteString(strmangle.TitleCase(word))
+		}
+	}
+
+	return buf.String()
+}
+
+// CamelCase is strmangle.CamelCase, except that words after the first which
+// are one of the Initialisms are written the way they were configured
+func (i Inflections) CamelCase(name string) string {
+	if len(i.Initialisms) == 0 {
+		return strmangle.CamelCase(name)
+	}
+
+	name = strings.TrimLeft(name, "_")
+	index := strings.IndexByte(name, '_')
+	if index < 0 {
+		return strmangle.CamelCase(name)
+	}
+
+	return strmangle.CamelCase(name[:index]) + i.TitleCase(name[index+1:])
+}
+
+// initialism returns the configured spelling of word if it is one of
+// the Initialisms, ignoring case and any trailing digits like strmangle
+func (i Inflections) initialism(word string) (string, bool) {
+	trimmed := strings.TrimRight(word, "0123456789")
+	for _, initialism := range i.Initialisms {
+		if strings.EqualFold(trimmed, initialism) {
+			return initialism + word[len(trimmed):], true
+		}
+	}
+
+	return "", false
+}
+
+// splitLastWord splits a snake_case name into its last word and everything
+// before it, since like strmangle only the last word is inflected
+func splitLastWord(name string) (prefix, word string) {
+	i := strings.LastIndexByte(name, '_')
+	return name[:i+1], name[i+1:]
+}
@@ -0,0 +1,89 @@
+package boilingcore
+
+import "testing"
+
+func TestInflections(t *testing.T) {
+	t.Parallel()
+
+	inf := Inflections{
+		Irregular: map[string]string{
+			"criterion": "criteria",
+			"index":     "indexes",
+		},
+		Uncountable: []string{"sheep", "equipment"},
+	}
+
+	tests := []struct {
+		Inflections Inflections
+		Singular    string
+		Plural      string
+	}{
+		// The default rules handle these already
+		{Inflections{}, "matrix", "matrices"},
+		{Inflections{}, "index", "indices"},
+		{Inflections{}, "quiz", "quizzes"},
+		{Inflections{}, "person", "people"},
+		{Inflections{}, "datum", "data"},
+		{inf, "matrix", "matrices"},
+		{inf, "quiz", "quizzes"},
+		{inf, "vertex", "vertices"},
+		// Custom words override them
+		{inf, "criterion", "criteria"},
+		{inf, "index", "indexes"},
+		{inf, "search_index", "search_indexes"},
+		{inf, "sheep", "sheep"},
+		{inf, "farm_equipment", "farm_equipment"},
+	}
+
+	for i, test := range tests {
+		if got := test.Inflections.Plural(test.Singular); got != test.Plural {
+			t.Errorf("%d) plural of %s: want %s, got %s", i, test.Singular, test.Plural, got)
+		}
+		if got := test.Inflections.Plural(test.Plural); got != test.Plural {
+			t.Errorf("%d) plural of %s: want %s, got %s", i, test.Plural, test.Plural, got)
+		}
+		if got := test.Inflections.Singular(test.Plural); got != test.Singular {
+			t.Errorf("%d) singular of %s: want %s, got %s", i, test.Plural, test.Singular, got)
+		}
+		if got := test.Inflections.Singular(test.Singular); got != test.Singular {
+			t.Errorf("%d) singular of %s: want %s, got %s", i, test.Singular, test.Singular, got)
+		}
+	}
+}
+
+func TestInflectionsCasing(t *testing.T) {
+	t.Parallel()
+
+	inf := Inflections{Initialisms: []string{"SKU", "OAuth"}}
+
+	tests := []struct {
+		Inflections Inflections
+		In          string
+		Title       string
+		Camel       string
+	}{
+		// The default initialisms
+		{Inflections{}, "api_url", "APIURL", "apiURL"},
+		{Inflections{}, "user_id", "UserID", "userID"},
+		{Inflections{}, "http_referer", "HTTPReferer", "httpReferer"},
+		{Inflections{}, "sku_code", "SkuCode", "skuCode"},
+		{inf, "api_url", "APIURL", "apiURL"},
+		{inf, "user_id", "UserID", "userID"},
+		// Configured ones are added to them
+		{inf, "sku_code", "SKUCode", "skuCode"},
+		{inf, "product_sku", "ProductSKU", "productSKU"},
+		{inf, "oauth_token", "OAuthToken", "oauthToken"},
+		{inf, "google_oauth2_id", "GoogleOAuth2ID", "googleOAuth2ID"},
+		{inf, "_sku__code", "SKUCode", "skuCode"},
+		{inf, "skus", "Skus", "skus"},
+	}
+
+	for i, test := range tests {
+		if got := test.Inflections.TitleCase(test.In); got != test.Title {
+			t.Errorf("%d) title case of %s: want %s, got %s", i, test.In, test.Title, got)
+		}
+		if got := test.Inflections.CamelCase(test.In); got != test.Camel {
+			t.Errorf("%d) camel case of %s: want %s, got %s", i, test.In, test.Camel, got)
+		}
+	}
+}
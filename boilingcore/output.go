@@ -5,15 +5,18 @@ import (
 	"bytes"
 	"fmt"
 	"go/format"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"text/template"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
 	"github.com/volatiletech/sqlboiler/v4/importers"
 )
 
@@ -23,6 +26,10 @@ var (
 
 `
 	noEditDisclaimer = []byte(fmt.Sprintf(noEditDisclaimerFmt, " "))
+
+	// noEditDisclaimerPrefix starts the disclaimer of any version, it marks
+	// the files that are safe to remove when cleaning
+	noEditDisclaimerPrefix = []byte("// Code generated by SQLBoiler")
 )
 
 var (
@@ -38,8 +45,9 @@ var (
 )
 
 type executeTemplateData struct {
-	state *State
-	data  *templateData
+	state  *State
+	data   *templateData
+	tables []drivers.Table
 
 	templates     *templateList
 	dirExtensions dirExtMap
@@ -51,11 +59,14 @@ type executeTemplateData struct {
 	isTest               bool
 }
 
-// generateOutput builds the file output and sends it to outHandler for saving
-func generateOutput(state *State, dirExts dirExtMap, data *templateData) error {
+// generateOutput builds the file output of tables, which share a single file
+// for each template directory and extension, and sends it to outHandler for
+// saving
+func generateOutput(state *State, dirExts dirExtMap, data *templateData, tables []drivers.Table) error {
 	return executeTemplates(executeTemplateData{
 		state:                state,
 		data:                 data,
+		tables:               tables,
 		templates:            state.Templates,
 		importSet:            state.Config.Imports.All,
 		combineImportsOnType: true,
@@ -63,11 +74,13 @@ func generateOutput(state *State, dirExts dirExtMap, data *templateData) error {
 	})
 }
 
-// generateTestOutput builds the test file output and sends it to outHandler for saving
-func generateTestOutput(state *State, dirExts dirExtMap, data *templateData) error {
+// generateTestOutput builds the test file output of tables and sends it to
+// outHandler for saving
+func generateTestOutput(state *State, dirExts dirExtMap, data *templateData, tables []drivers.Table) error {
 	return executeTemplates(executeTemplateData{
 		state:                state,
 		data:                 data,
+		tables:               tables,
 		templates:            state.TestTemplates,
 		importSet:            state.Config.Imports.Test,
 		combineImportsOnType: false,
@@ -100,7 +113,13 @@ func generateSingletonTestOutput(state *State, data *templateData) error {
 }
 
 func executeTemplates(e executeTemplateData) error {
-	if e.data.Table.IsJoinTable {
+	var tables []drivers.Table
+	for _, t := range e.tables {
+		if !t.IsJoinTable {
+			tables = append(tables, t)
+		}
+	}
+	if len(tables) == 0 {
 		return nil
 	}
 
@@ -108,14 +127,21 @@ func executeTemplates(e executeTemplateData) error {
 	imps.Standard = e.importSet.Standard
 	imps.ThirdParty = e.importSet.ThirdParty
 	if e.combineImportsOnType {
-		colTypes := make([]string, len(e.data.Table.Columns))
-		for i, ct := range e.data.Table.Columns {
-			colTypes[i] = ct.Type
+		var colTypes []string
+		for _, t := range tables {
+			for _, ct := range t.Columns {
+				colTypes = append(colTypes, ct.Type)
+			}
 		}
 
 		imps = importers.AddTypeImports(imps, e.state.Config.Imports.BasedOnType, colTypes)
 	}
 
+	fileName, err := e.state.outputFileName(tables)
+	if err != nil {
+		return err
+	}
+
 	for dir, dirExts := range e.dirExtensions {
 		for ext, tplNames := range dirExts {
 			out := templateByteBuffer
@@ -128,17 +154,24 @@ func executeTemplates(e executeTemplateData) error {
 					pkgName = filepath.Base(dir)
 				}
 				writeFileDisclaimer(out)
+				if err := e.state.writeHeader(out, e.state.outputFileData(tables)); err != nil {
+					return err
+				}
+				e.state.writeBuildConstraint(out)
 				writePackageName(out, pkgName)
 				writeImports(out, imps)
 			}
 
-			for _, tplName := range tplNames {
-				if err := executeTemplate(out, e.templates.Template, tplName, e.data); err != nil {
-					return err
+			for _, table := range tables {
+				e.data.Table = table
+				for _, tplName := range tplNames {
+					if err := executeTemplate(out, e.templates.Template, tplName, e.data); err != nil {
+						return err
+					}
 				}
 			}
 
-			fName := e.data.Table.Name
+			fName := fileName
 			if e.isTest {
 				fName += "_test"
 			}
@@ -147,7 +180,7 @@ func executeTemplates(e executeTemplateData) error {
 				fName = filepath.Join(dir, fName)
 			}
 
-			if err := writeFile(e.state.Config.OutFolder, fName, out, isGo); err != nil {
+			if err := e.state.writeOutput(fName, out, isGo); err != nil {
 				return err
 			}
 		}
@@ -184,15 +217,26 @@ func executeSingletonTemplates(e executeTemplateData) error {
 				pkgName = filepath.Base(dir)
 			}
 			writeFileDisclaimer(out)
+			if err := e.state.writeHeader(out, outputFileData{PkgName: e.state.Config.PkgName}); err != nil {
+				return err
+			}
+			e.state.writeBuildConstraint(out)
 			writePackageName(out, pkgName)
 			writeImports(out, imps)
 		}
 
+		top := out.Len()
 		if err := executeTemplate(out, e.templates.Template, tplName, e.data); err != nil {
 			return err
 		}
 
-		if err := writeFile(e.state.Config.OutFolder, normalized, out, isGo); err != nil {
+		// Templates of features that are turned off render nothing, and
+		// there is no point in a file without a body
+		if len(bytes.TrimSpace(out.Bytes()[top:])) == 0 {
+			continue
+		}
+
+		if err := e.state.writeOutput(normalized, out, isGo); err != nil {
 			return err
 		}
 	}
@@ -206,6 +250,46 @@ func writeFileDisclaimer(out *bytes.Buffer) {
 	_, _ = out.Write(noEditDisclaimer)
 }
 
+// writeHeader writes the Header of the config below the disclaimer. Every
+// line of it must be a // comment so that it can hold build constraints,
+// which have to come before the package name.
+func (s *State) writeHeader(out *bytes.Buffer, data outputFileData) error {
+	if s.header == nil {
+		return nil
+	}
+
+	buf := &bytes.Buffer{}
+	if err := s.header.Execute(buf, data); err != nil {
+		return errors.Wrap(err, "failed to execute header template")
+	}
+
+	header := bytes.TrimRight(buf.Bytes(), " \t\r\n")
+	if len(header) == 0 {
+		return nil
+	}
+	for _, line := range bytes.Split(header, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) != 0 && !bytes.HasPrefix(line, []byte("//")) {
+			return errors.Errorf("header line %q is not a // comment", line)
+		}
+	}
+
+	_, _ = out.Write(header)
+	_, _ = out.WriteString("\n\n")
+	return nil
+}
+
+// writeBuildConstraint writes the build constraint of the BuildTags with
+// the blank line that has to separate it from the package name
+func (s *State) writeBuildConstraint(out *bytes.Buffer) {
+	if len(s.buildConstraint) == 0 {
+		return
+	}
+
+	_, _ = out.WriteString(s.buildConstraint)
+	_, _ = out.WriteString("\n\n")
+}
+
 // writePackageName writes the package name correctly, ignores errors
 // since it's to the concrete buffer type which produces none
 func writePackageName(out *bytes.Buffer, pkgName string) {
@@ -220,6 +304,39 @@ func writeImports(out *bytes.Buffer, imps importers.Set) {
 	}
 }
 
+// writeOutput writes a file to the OutFolder like writeFile, in a dry run
+// it only records how the file would change
+func (s *State) writeOutput(fileName string, input *bytes.Buffer, format bool) error {
+	s.markWritten(fileName)
+
+	if !s.Config.DryRun {
+		return writeFile(s.Config.OutFolder, fileName, input, format)
+	}
+
+	byt := input.Bytes()
+	if format {
+		var err error
+		if byt, err = formatBuffer(input); err != nil {
+			return err
+		}
+	}
+
+	path := filepath.Join(s.Config.OutFolder, fileName)
+	action := dryRunCreate
+	existing, err := ioutil.ReadFile(path)
+	switch {
+	case err == nil && bytes.Equal(existing, byt):
+		action = dryRunUnchanged
+	case err == nil:
+		action = dryRunOverwrite
+	case !os.IsNotExist(err):
+		return errors.Wrapf(err, "failed to read output file %s", path)
+	}
+
+	s.dryRun = append(s.dryRun, dryRunFile{Action: action, Path: path})
+	return nil
+}
+
 // writeFile writes to the given folder and filename, formatting the buffer
 // given.
 func writeFile(outFolder string, fileName string, input *bytes.Buffer, format bool) error {
@@ -242,6 +359,75 @@ func writeFile(outFolder string, fileName string, input *bytes.Buffer, format bo
 	return nil
 }
 
+// removeStaleFiles removes the files generated by sqlboiler in the folders
+// that were written to which were not written this time, for example the
+// files of tables that were dropped. Files not starting with the disclaimer
+// are left alone, as are sub folders since they could hold other packages.
+// In a dry run the files are only listed.
+func removeStaleFiles(outFolder string, written map[string]struct{}, dryRun bool) ([]string, error) {
+	dirs := make(map[string]struct{})
+	for name := range written {
+		dirs[filepath.Dir(name)] = struct{}{}
+	}
+
+	var removed []string
+	for dir := range dirs {
+		infos, err := ioutil.ReadDir(filepath.Join(outFolder, dir))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return removed, errors.Wrap(err, "failed to read output folder")
+		}
+
+		for _, info := range infos {
+			if info.IsDir() {
+				continue
+			}
+			name := filepath.Join(dir, info.Name())
+			if _, ok := written[name]; ok {
+				continue
+			}
+
+			path := filepath.Join(outFolder, name)
+			generated, err := isGeneratedFile(path)
+			if err != nil {
+				return removed, err
+			}
+			if !generated {
+				continue
+			}
+
+			if !dryRun {
+				if err := os.Remove(path); err != nil {
+					return removed, errors.Wrapf(err, "failed to remove stale file %s", path)
+				}
+			}
+			removed = append(removed, path)
+		}
+	}
+
+	sort.Strings(removed)
+	return removed, nil
+}
+
+// isGeneratedFile checks if the file starts with the disclaimer
+func isGeneratedFile(path string) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to open %s", path)
+	}
+	defer f.Close()
+
+	head := make([]byte, len(noEditDisclaimerPrefix))
+	n, err := io.ReadFull(f, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return false, errors.Wrapf(err, "failed to read %s", path)
+	}
+
+	return bytes.Equal(head[:n], noEditDisclaimerPrefix), nil
+}
+
 // executeTemplate takes a template and returns the output of the template
 // execution.
 func executeTemplate(buf *bytes.Buffer, t *template.Template, name string, data *templateData) (err error) {
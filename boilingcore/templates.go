@@ -40,6 +40,9 @@ type templateData struct {
 	AddGlobal         bool
 	AddPanic          bool
 	AddSoftDeletes    bool
+	AddEnumTypes      bool
+	AddValidation     bool
+	AddInterfaces     bool
 	NoContext         bool
 	NoHooks           bool
 	NoAutoTimestamps  bool
@@ -47,6 +50,24 @@ type templateData struct {
 	NoDriverTemplates bool
 	NoBackReferencing bool
 
+	// Names of the columns that are set automatically
+	AutoColumns AutoColumns
+
+	// Version columns used for optimistic locking, keyed by table name
+	OptimisticLock map[string]string
+
+	// Go types of the json columns, keyed by table.column
+	JSONTypes map[string]JSONType
+
+	// Structs of query results that don't map to a single table
+	ResultTypes []ResultType
+
+	// Relationships defined in the config that the foreign keys don't describe
+	Relationships []Relationship
+
+	// Polymorphic associations, with the default names of their columns filled in
+	Polymorphic []Polymorphic
+
 	// Tags control which tags are added to the struct
 	Tags []string
 
@@ -56,6 +77,9 @@ type templateData struct {
 	// Generate struct tags as camelCase or snake_case
 	StructTagCasing string
 
+	// Casing of individual struct tags, overrides StructTagCasing
+	TagCases map[string]string
+
 	// Contains field names that should have tags values set to '-'
 	TagIgnore map[string]struct{}
 
@@ -78,6 +102,38 @@ func (t templateData) SchemaTable(table string) string {
 	return strmangle.SchemaTable(t.LQ, t.RQ, t.Dialect.UseSchema, t.Schema, table)
 }
 
+// tagName converts a column name to the given struct tag casing
+func tagName(casing, name, alias string) string {
+	switch casing {
+	case "camel":
+		return strmangle.CamelCase(name)
+	case "title":
+		return strmangle.TitleCase(name)
+	case "alias":
+		return alias
+	default:
+		return name
+	}
+}
+
+// TagCase returns the casing of the names in the given struct tag. Unless
+// configured otherwise json, toml and yaml use StructTagCasing while other
+// tags use the column name as is, or its alias when the casing is alias.
+func (t templateData) TagCase(tag string) string {
+	if casing, ok := t.TagCases[tag]; ok {
+		return casing
+	}
+
+	switch {
+	case tag == "json" || tag == "toml" || tag == "yaml":
+		return t.StructTagCasing
+	case t.StructTagCasing == "alias":
+		return "alias"
+	default:
+		return "snake"
+	}
+}
+
 type templateList struct {
 	*template.Template
 }
@@ -238,6 +294,33 @@ func (o once) Put(s string) bool {
 	return true
 }
 
+// snakeCase converts a Go styled name like "AirportID" or "airportID"
+// back into "airport_id". Runs of capitals are kept together as one word
+// so "HTTPServer" becomes "http_server".
+func snakeCase(name string) string {
+	buf := strmangle.GetBuffer()
+	defer strmangle.PutBuffer(buf)
+
+	isUpper := func(c byte) bool { return c >= 'A' && c <= 'Z' }
+	isLower := func(c byte) bool { return c >= 'a' && c <= 'z' }
+	isDigit := func(c byte) bool { return c >= '0' && c <= '9' }
+
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if isUpper(c) && i > 0 && name[i-1] != '_' {
+			if isLower(name[i-1]) || isDigit(name[i-1]) || (i+1 < len(name) && isUpper(name[i-1]) && isLower(name[i+1])) {
+				buf.WriteByte('_')
+			}
+		}
+		if isUpper(c) {
+			c += 'a' - 'A'
+		}
+		buf.WriteByte(c)
+	}
+
+	return buf.String()
+}
+
 // templateStringMappers are placed into the data to make it easy to use the
 // stringMap function.
 var templateStringMappers = map[string]func(string) string{
@@ -248,13 +331,17 @@ var templateStringMappers = map[string]func(string) string{
 	// Casing
 	"titleCase": strmangle.TitleCase,
 	"camelCase": strmangle.CamelCase,
+	"snakeCase": snakeCase,
 }
 
-var goVarnameReplacer = strings.NewReplacer("[", "_", "]", "_", ".", "_")
+var goVarnameReplacer = strings.NewReplacer("[", "_", "]", "_", ".", "_", "*", "ptr_")
 
 // templateFunctions is a map of all the functions that get passed into the
 // templates. If you wish to pass a new function into your own template,
 // add a function pointer here.
+//
+// The string helpers under Pluralization and Casing are documented in the
+// README for use in custom templates, their output should not change.
 var templateFunctions = template.FuncMap{
 	// String ops
 	"quoteWrap": func(s string) string { return fmt.Sprintf(`"%s"`, s) },
@@ -268,6 +355,7 @@ var templateFunctions = template.FuncMap{
 	// Casing
 	"titleCase": strmangle.TitleCase,
 	"camelCase": strmangle.CamelCase,
+	"snakeCase": snakeCase,
 	"ignore":    strmangle.Ignore,
 
 	// String Slice ops
@@ -278,6 +366,7 @@ var templateFunctions = template.FuncMap{
 	"containsAny":        strmangle.ContainsAny,
 	"generateTags":       strmangle.GenerateTags,
 	"generateIgnoreTags": strmangle.GenerateIgnoreTags,
+	"tagName":            tagName,
 
 	// Enum ops
 	"parseEnumName":       strmangle.ParseEnumName,
@@ -302,6 +391,7 @@ var templateFunctions = template.FuncMap{
 	"aliasCols":      func(ta TableAlias) func(string) string { return ta.Column },
 	"usesPrimitives": usesPrimitives,
 	"isPrimitive":    isPrimitive,
+	"isPointer":      isPointer,
 	"splitLines": func(a string) []string {
 		if a == "" {
 			return nil
@@ -1,6 +1,7 @@
 package boilingcore
 
 import (
+	"bytes"
 	"sort"
 	"testing"
 	"text/template"
@@ -66,3 +67,123 @@ func TestTemplateList_Templates(t *testing.T) {
 		t.Error("don't want not")
 	}
 }
+
+func TestTemplateDataTagCase(t *testing.T) {
+	t.Parallel()
+
+	data := templateData{
+		StructTagCasing: "camel",
+		TagCases:        map[string]string{"yaml": "title", "db": "alias"},
+	}
+
+	tests := map[string]string{
+		"json": "camel",
+		"toml": "camel",
+		"yaml": "title",
+		"db":   "alias",
+		"xml":  "snake",
+	}
+
+	for tag, want := range tests {
+		if got := data.TagCase(tag); got != want {
+			t.Errorf("%s: want %s, got %s", tag, want, got)
+		}
+	}
+
+	data.StructTagCasing = "alias"
+	if got := data.TagCase("xml"); got != "alias" {
+		t.Errorf("want custom tags to use alias casing, got %s", got)
+	}
+}
+
+func TestTagName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Casing string
+		Want   string
+	}{
+		{"", "airport_id"},
+		{"snake", "airport_id"},
+		{"camel", "airportID"},
+		{"title", "AirportID"},
+		{"alias", "Airport"},
+	}
+
+	for _, test := range tests {
+		if got := tagName(test.Casing, "airport_id", "Airport"); got != test.Want {
+			t.Errorf("%q: want %s, got %s", test.Casing, test.Want, got)
+		}
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In   string
+		Want string
+	}{
+		{"", ""},
+		{"airport_id", "airport_id"},
+		{"Airport", "airport"},
+		{"AirportID", "airport_id"},
+		{"airportID", "airport_id"},
+		{"HTTPServer", "http_server"},
+		{"APIURL", "apiurl"},
+		{"UTF8Name", "utf8_name"},
+		{"Pilot_Languages", "pilot_languages"},
+	}
+
+	for _, test := range tests {
+		if got := snakeCase(test.In); got != test.Want {
+			t.Errorf("%q: want %s, got %s", test.In, test.Want, got)
+		}
+	}
+}
+
+func TestTemplateFunctionsStable(t *testing.T) {
+	t.Parallel()
+
+	// These are documented for use in custom templates, if one of these
+	// needs to change it's a breaking change for template authors.
+	tests := []struct {
+		Tpl  string
+		Want string
+	}{
+		{`{{singular "pilot_languages"}}`, "pilot_language"},
+		{`{{plural "pilot_language"}}`, "pilot_languages"},
+		{`{{plural "person"}}`, "people"},
+		{`{{titleCase "api_url"}}`, "APIURL"},
+		{`{{titleCase "pilot_id"}}`, "PilotID"},
+		{`{{camelCase "pilot_id"}}`, "pilotID"},
+		{`{{camelCase "api_url"}}`, "apiURL"},
+		{`{{snakeCase "PilotID"}}`, "pilot_id"},
+		{`{{snakeCase (titleCase "jet_airport_id")}}`, "jet_airport_id"},
+		{`{{stringMap .StringFuncs.titleCase .Names | join ", "}}`, "PilotID, Name"},
+		{`{{stringMap .StringFuncs.camelCase .Names | join ", "}}`, "pilotID, name"},
+		{`{{stringMap .StringFuncs.snakeCase .Names | join ", "}}`, "pilot_id, name"},
+	}
+
+	data := struct {
+		Names       []string
+		StringFuncs map[string]func(string) string
+	}{
+		Names:       []string{"pilot_id", "name"},
+		StringFuncs: templateStringMappers,
+	}
+
+	for _, test := range tests {
+		tpl, err := template.New("").Funcs(templateFunctions).Parse(test.Tpl)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		b := &bytes.Buffer{}
+		if err := tpl.Execute(b, data); err != nil {
+			t.Errorf("%s: %v", test.Tpl, err)
+		} else if got := b.String(); got != test.Want {
+			t.Errorf("%s: want %s, got %s", test.Tpl, test.Want, got)
+		}
+	}
+}
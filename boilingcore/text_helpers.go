@@ -4,7 +4,6 @@ import (
 	"strings"
 
 	"github.com/volatiletech/sqlboiler/v4/drivers"
-	"github.com/volatiletech/strmangle"
 )
 
 // txtNameToOne creates the local and foreign function names for
@@ -37,31 +36,31 @@ import (
 //
 // fk == table = industry.Industry | industry.Industry
 // fk != table = industry.ParentIndustry | industry.Industry
-func txtNameToOne(fk drivers.ForeignKey) (localFn, foreignFn string) {
-	fkColumnTrimmedSuffixes := strmangle.Singular(trimSuffixes(fk.Column))
-	fkNotTableName := fkColumnTrimmedSuffixes != strmangle.Singular(fk.ForeignTable)
-	singularForeignTable := strmangle.Singular(fk.ForeignTable)
+func txtNameToOne(fk drivers.ForeignKey, inf Inflections) (localFn, foreignFn string) {
+	fkColumnTrimmedSuffixes := inf.Singular(trimSuffixes(fk.Column))
+	fkNotTableName := fkColumnTrimmedSuffixes != inf.Singular(fk.ForeignTable)
+	singularForeignTable := inf.Singular(fk.ForeignTable)
 
 	if fkColumnTrimmedSuffixes == singularForeignTable {
-		foreignFn = strmangle.TitleCase(strmangle.Singular(fk.Table) + "_" + fkColumnTrimmedSuffixes)
+		foreignFn = inf.TitleCase(inf.Singular(fk.Table) + "_" + fkColumnTrimmedSuffixes)
 		if fk.Column != singularForeignTable {
-			foreignFn = strmangle.TitleCase(fkColumnTrimmedSuffixes)
+			foreignFn = inf.TitleCase(fkColumnTrimmedSuffixes)
 		}
 	} else if fkColumnTrimmedSuffixes == fk.Column {
-		foreignFn = strmangle.TitleCase(fkColumnTrimmedSuffixes + "_" + strmangle.Singular(fk.ForeignTable))
+		foreignFn = inf.TitleCase(fkColumnTrimmedSuffixes + "_" + inf.Singular(fk.ForeignTable))
 	} else {
-		foreignFn = strmangle.TitleCase(fkColumnTrimmedSuffixes)
+		foreignFn = inf.TitleCase(fkColumnTrimmedSuffixes)
 	}
 
 	if fkNotTableName {
-		localFn = strmangle.TitleCase(fkColumnTrimmedSuffixes)
+		localFn = inf.TitleCase(fkColumnTrimmedSuffixes)
 	}
 
-	plurality := strmangle.Plural
+	plurality := inf.Plural
 	if fk.Unique {
-		plurality = strmangle.Singular
+		plurality = inf.Singular
 	}
-	localFn += strmangle.TitleCase(plurality(fk.Table))
+	localFn += inf.TitleCase(plurality(fk.Table))
 
 	return localFn, foreignFn
 }
@@ -91,19 +90,19 @@ func txtNameToOne(fk drivers.ForeignKey) (localFn, foreignFn string) {
 // industry_id  mapped_industry_id
 // fk == table = industry.Industries
 // fk != table = industry.MappedIndustryIndustry
-func txtNameToMany(lhs, rhs drivers.ForeignKey) (lhsFn, rhsFn string) {
-	lhsKey := strmangle.Singular(trimSuffixes(lhs.Column))
-	rhsKey := strmangle.Singular(trimSuffixes(rhs.Column))
+func txtNameToMany(lhs, rhs drivers.ForeignKey, inf Inflections) (lhsFn, rhsFn string) {
+	lhsKey := inf.Singular(trimSuffixes(lhs.Column))
+	rhsKey := inf.Singular(trimSuffixes(rhs.Column))
 
-	if lhsKey != strmangle.Singular(lhs.ForeignTable) {
-		lhsFn = strmangle.TitleCase(lhsKey)
+	if lhsKey != inf.Singular(lhs.ForeignTable) {
+		lhsFn = inf.TitleCase(lhsKey)
 	}
-	lhsFn += strmangle.TitleCase(strmangle.Plural(lhs.ForeignTable))
+	lhsFn += inf.TitleCase(inf.Plural(lhs.ForeignTable))
 
-	if rhsKey != strmangle.Singular(rhs.ForeignTable) {
-		rhsFn = strmangle.TitleCase(rhsKey)
+	if rhsKey != inf.Singular(rhs.ForeignTable) {
+		rhsFn = inf.TitleCase(rhsKey)
 	}
-	rhsFn += strmangle.TitleCase(strmangle.Plural(rhs.ForeignTable))
+	rhsFn += inf.TitleCase(inf.Plural(rhs.ForeignTable))
 
 	return lhsFn, rhsFn
 }
@@ -151,3 +150,9 @@ func isPrimitive(typ string) bool {
 
 	return false
 }
+
+// isPointer checks if the type is a pointer, like the nullable columns
+// when generating them as pointers
+func isPointer(typ string) bool {
+	return strings.HasPrefix(typ, "*")
+}
@@ -51,6 +51,9 @@ func TestTxtNameToOne(t *testing.T) {
 		{"industries", "industry_id", true, "industries", "id", true, "Industry", "Industry"},
 		{"industries", "parent_id", true, "industries", "id", true, "ParentIndustry", "Parent"},
 
+		{"employees", "manager_id", false, "employees", "id", true, "ManagerEmployees", "Manager"},
+		{"employees", "manager_id", true, "employees", "id", true, "ManagerEmployee", "Manager"},
+
 		{"race_result_scratchings", "results_id", false, "race_results", "id", true, "ResultRaceResultScratchings", "Result"},
 	}
 
@@ -60,7 +63,7 @@ func TestTxtNameToOne(t *testing.T) {
 			ForeignTable: test.ForeignTable, ForeignColumn: test.ForeignColumn, ForeignColumnUnique: test.ForeignColumnUnique,
 		}
 
-		local, foreign := txtNameToOne(fk)
+		local, foreign := txtNameToOne(fk, Inflections{})
 		if local != test.LocalFn {
 			t.Error(i, "local wrong:", local, "want:", test.LocalFn)
 		}
@@ -104,7 +107,7 @@ func TestTxtNameToMany(t *testing.T) {
 			Column:       test.RHSColumn,
 		}
 
-		lhs, rhs := txtNameToMany(lhsFk, rhsFk)
+		lhs, rhs := txtNameToMany(lhsFk, rhsFk, Inflections{})
 		if lhs != test.LHSFn {
 			t.Error(i, "local wrong:", lhs, "want:", test.LHSFn)
 		}
@@ -1,6 +1,8 @@
 package drivers
 
 import (
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/volatiletech/strmangle"
@@ -40,6 +42,43 @@ type Column struct {
 	AutoGenerated bool `json:"auto_generated" toml:"auto_generated"`
 }
 
+var (
+	rgxTypeLength = regexp.MustCompile(`\((\d+)\)$`)
+	rgxSet        = regexp.MustCompile(`^set\('.*'\)$`)
+)
+
+// MaxLength returns the maximum number of characters a character column can
+// hold as declared by its full database type, ex: varchar(10). It returns 0
+// for other columns and for character columns without a limit.
+func (c Column) MaxLength() int {
+	if !strings.Contains(strings.ToLower(c.DBType), "char") {
+		return 0
+	}
+
+	match := rgxTypeLength.FindStringSubmatch(c.FullDBType)
+	if match == nil {
+		return 0
+	}
+
+	length, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0
+	}
+
+	return length
+}
+
+// SetValues returns the members a MySQL SET column may contain, the driver
+// reports these columns with a db type like set('a','b'). It returns nil for
+// other columns.
+func (c Column) SetValues() []string {
+	if !rgxSet.MatchString(c.DBType) {
+		return nil
+	}
+
+	return strings.Split(c.DBType[len("set('"):len(c.DBType)-len("')")], "','")
+}
+
 // ColumnNames of the columns.
 func ColumnNames(cols []Column) []string {
 	names := make([]string, len(cols))
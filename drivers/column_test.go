@@ -1,6 +1,7 @@
 package drivers
 
 import (
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -20,6 +21,51 @@ func TestColumnNames(t *testing.T) {
 	}
 }
 
+func TestColumnMaxLength(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Column Column
+		Want   int
+	}{
+		{Column{DBType: "character varying", FullDBType: "character varying(10)"}, 10},
+		{Column{DBType: "varchar", FullDBType: "varchar(255)"}, 255},
+		{Column{DBType: "nchar", FullDBType: "nchar(3)"}, 3},
+		{Column{DBType: "nvarchar", FullDBType: "nvarchar(-1)"}, 0},
+		{Column{DBType: "text", FullDBType: "text"}, 0},
+		{Column{DBType: "character varying", FullDBType: "varchar"}, 0},
+		{Column{DBType: "tinyint", FullDBType: "tinyint(1)"}, 0},
+	}
+
+	for i, test := range tests {
+		if got := test.Column.MaxLength(); got != test.Want {
+			t.Errorf("%d) want %d, got %d", i, test.Want, got)
+		}
+	}
+}
+
+func TestColumnSetValues(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		DBType string
+		Want   []string
+	}{
+		{"set('red','green','blue')", []string{"red", "green", "blue"}},
+		{"set('one')", []string{"one"}},
+		{"set", nil},
+		{"enum('red','green')", nil},
+		{"varchar", nil},
+	}
+
+	for i, test := range tests {
+		got := Column{DBType: test.DBType}.SetValues()
+		if !reflect.DeepEqual(got, test.Want) {
+			t.Errorf("%d) want %v, got %v", i, test.Want, got)
+		}
+	}
+}
+
 func TestColumnDBTypes(t *testing.T) {
 	cols := []Column{
 		{Name: "test_one", DBType: "integer"},
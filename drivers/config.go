@@ -5,6 +5,7 @@ package drivers
 
 import (
 	"os"
+	"path"
 	"strconv"
 	"strings"
 
@@ -171,7 +172,9 @@ func DefaultEnv(key, def string) string {
 }
 
 // TablesFromList takes a whitelist or blacklist and returns
-// the table names.
+// the table names. Entries may be glob patterns as understood by path.Match,
+// ex: "audit_*". Patterns can't be handed to the database so nil is returned
+// if the list has any, drivers.Tables filters the tables with them instead.
 func TablesFromList(list []string) []string {
 	if len(list) == 0 {
 		return nil
@@ -182,6 +185,9 @@ func TablesFromList(list []string) []string {
 		splits := strings.Split(i, ".")
 
 		if len(splits) == 1 {
+			if isPattern(splits[0]) {
+				return nil
+			}
 			tables = append(tables, splits[0])
 		}
 	}
@@ -190,7 +196,9 @@ func TablesFromList(list []string) []string {
 }
 
 // ColumnsFromList takes a whitelist or blacklist and returns
-// the columns for a given table.
+// the columns for a given table. The table and column of an entry may be glob
+// patterns, ex: "*.password". Like TablesFromList nil is returned if a column
+// pattern applies to the table, drivers.Tables filters the columns with it.
 func ColumnsFromList(list []string, tablename string) []string {
 	if len(list) == 0 {
 		return nil
@@ -204,10 +212,85 @@ func ColumnsFromList(list []string, tablename string) []string {
 			continue
 		}
 
-		if splits[0] == tablename {
+		if matchName(splits[0], tablename) {
+			if isPattern(splits[1]) {
+				return nil
+			}
 			columns = append(columns, splits[1])
 		}
 	}
 
 	return columns
 }
+
+// isPattern reports whether a whitelist or blacklist entry is a glob pattern
+func isPattern(s string) bool {
+	return strings.ContainsAny(s, "*?[")
+}
+
+// matchName reports whether name matches the whitelist or blacklist entry,
+// which is either a glob pattern or a literal name
+func matchName(entry, name string) bool {
+	if !isPattern(entry) {
+		return entry == name
+	}
+
+	matched, err := path.Match(entry, name)
+	return err == nil && matched
+}
+
+// listIncludes reports whether any of the table entries in list match table
+func listIncludes(list []string, table string) bool {
+	for _, entry := range list {
+		if !strings.ContainsRune(entry, '.') && matchName(entry, table) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// tableIncluded reports whether the whitelist and blacklist allow the table.
+// Like the drivers do the blacklist is ignored when there is a whitelist, and
+// a whitelist without table entries allows every table.
+func tableIncluded(table string, whitelist, blacklist []string) bool {
+	if len(whitelist) == 0 {
+		return !listIncludes(blacklist, table)
+	}
+
+	for _, entry := range whitelist {
+		if !strings.ContainsRune(entry, '.') {
+			return listIncludes(whitelist, table)
+		}
+	}
+
+	return true
+}
+
+// columnIncluded reports whether the whitelist and blacklist allow the column
+// of the table, with the same rules as tableIncluded.
+func columnIncluded(table, column string, whitelist, blacklist []string) bool {
+	list := blacklist
+	if len(whitelist) > 0 {
+		list = whitelist
+	}
+
+	listed, matched := false, false
+	for _, entry := range list {
+		splits := strings.Split(entry, ".")
+		if len(splits) != 2 || !matchName(splits[0], table) {
+			continue
+		}
+
+		listed = true
+		if matchName(splits[1], column) {
+			matched = true
+			break
+		}
+	}
+
+	if len(whitelist) > 0 {
+		return !listed || matched
+	}
+	return !matched
+}
@@ -255,6 +255,9 @@ func TestTablesFromList(t *testing.T) {
 	if got := TablesFromList([]string{"a.b", "b", "c.d"}); !reflect.DeepEqual(got, []string{"b"}) {
 		t.Error("list was wrong:", got)
 	}
+	if got := TablesFromList([]string{"b", "audit_*"}); got != nil {
+		t.Error("patterns can't be filtered by the database:", got)
+	}
 }
 
 func TestColumnsFromList(t *testing.T) {
@@ -270,4 +273,72 @@ func TestColumnsFromList(t *testing.T) {
 	if got := ColumnsFromList([]string{"a.b", "b", "c.d", "c.a"}, "b"); len(got) != 0 {
 		t.Error("list was wrong:", got)
 	}
+	if got := ColumnsFromList([]string{"*.password", "c.d"}, "c"); !reflect.DeepEqual(got, []string{"password", "d"}) {
+		t.Error("list was wrong:", got)
+	}
+	if got := ColumnsFromList([]string{"c.pass*", "c.d"}, "c"); got != nil {
+		t.Error("patterns can't be filtered by the database:", got)
+	}
+	if got := ColumnsFromList([]string{"c.pass*", "a.d"}, "a"); !reflect.DeepEqual(got, []string{"d"}) {
+		t.Error("list was wrong:", got)
+	}
+}
+
+func TestTableIncluded(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Table     string
+		Whitelist []string
+		Blacklist []string
+		Included  bool
+	}{
+		{"users", nil, nil, true},
+		{"users", []string{"users"}, nil, true},
+		{"videos", []string{"users"}, nil, false},
+		{"audit_users", []string{"audit_*"}, nil, true},
+		{"users", []string{"audit_*"}, nil, false},
+		{"users", []string{"users.name"}, nil, true},
+		{"users", nil, []string{"users"}, false},
+		{"audit_users", nil, []string{"audit_*", "migrations"}, false},
+		{"users", nil, []string{"audit_*", "migrations"}, true},
+		{"users", nil, []string{"*.password"}, true},
+		// The blacklist is ignored with a whitelist
+		{"users", []string{"user?"}, []string{"users"}, true},
+	}
+
+	for i, test := range tests {
+		if got := tableIncluded(test.Table, test.Whitelist, test.Blacklist); got != test.Included {
+			t.Errorf("%d) want %t, got %t", i, test.Included, got)
+		}
+	}
+}
+
+func TestColumnIncluded(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Column    string
+		Whitelist []string
+		Blacklist []string
+		Included  bool
+	}{
+		{"name", nil, nil, true},
+		{"name", []string{"users.name"}, nil, true},
+		{"email", []string{"users.name"}, nil, false},
+		{"email", []string{"videos.name"}, nil, true},
+		{"email", []string{"users"}, nil, true},
+		{"email", []string{"*.e*"}, nil, true},
+		{"name", []string{"*.e*"}, nil, false},
+		{"password", nil, []string{"*.password"}, false},
+		{"password_hash", nil, []string{"users.password*"}, false},
+		{"password_hash", nil, []string{"videos.password*"}, true},
+		{"name", nil, []string{"users.password*"}, true},
+	}
+
+	for i, test := range tests {
+		if got := columnIncluded("users", test.Column, test.Whitelist, test.Blacklist); got != test.Included {
+			t.Errorf("%d) want %t, got %t", i, test.Included, got)
+		}
+	}
 }
@@ -3,6 +3,7 @@
 package drivers
 
 import (
+	"regexp"
 	"sort"
 
 	"github.com/friendsofgo/errors"
@@ -24,6 +25,8 @@ const (
 	ConfigSSLMode = "sslmode"
 )
 
+var rgxIdentifier = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_$]*`)
+
 // Interface abstracts either a side-effect imported driver or a binary
 // that is called in order to produce the data required for generation.
 type Interface interface {
@@ -56,12 +59,21 @@ type Dialect struct {
 	UseLastInsertID      bool `json:"use_last_insert_id"`
 	UseSchema            bool `json:"use_schema"`
 	UseDefaultKeyword    bool `json:"use_default_keyword"`
+	UseNullsOrdering     bool `json:"use_nulls_ordering"`
+	UseRandFunction      bool `json:"use_rand_function"`
+	UseMatchAgainst      bool `json:"use_match_against"`
+	UseBoundLimitOffset  bool `json:"use_bound_limit_offset"`
+	UseReturningClause   bool `json:"use_returning_clause"`
+	UseOnConflictClause  bool `json:"use_on_conflict_clause"`
 
 	// The following is mostly for T-SQL/MSSQL, what a show
 	UseAutoColumns          bool `json:"use_auto_columns"`
 	UseTopClause            bool `json:"use_top_clause"`
 	UseOutputClause         bool `json:"use_output_clause"`
 	UseCaseWhenExistsClause bool `json:"use_case_when_exists_clause"`
+
+	// The following depend on the version of the database server
+	UseMergeClause bool `json:"use_merge_clause"`
 }
 
 // Constructor breaks down the functionality required to implement a driver
@@ -77,7 +89,22 @@ type Constructor interface {
 	TranslateColumnType(Column) Column
 }
 
-// Tables returns the metadata for all tables, minus the tables
+// CheckConstraintInfoer is implemented by Constructors that can introspect
+// the check constraints of a table. It is optional so that drivers without
+// support for it keep working, drivers.Tables leaves Table.Checks empty for
+// those.
+type CheckConstraintInfoer interface {
+	CheckConstraintInfo(schema, tableName string) ([]CheckConstraint, error)
+}
+
+// ViewConstructor is implemented by Constructors that can introspect views.
+// It is optional, drivers.Tables only returns views for drivers that
+// implement it. The columns of views are fetched with Constructor.Columns.
+type ViewConstructor interface {
+	ViewNames(schema string, whitelist, blacklist []string) ([]string, error)
+}
+
+// Tables returns the metadata for all tables and views, minus the tables
 // specified in the blacklist.
 func Tables(c Constructor, schema string, whitelist, blacklist []string) ([]Table, error) {
 	var err error
@@ -87,6 +114,20 @@ func Tables(c Constructor, schema string, whitelist, blacklist []string) ([]Tabl
 		return nil, errors.Wrap(err, "unable to get table names")
 	}
 
+	views := make(map[string]struct{})
+	if vc, ok := c.(ViewConstructor); ok {
+		viewNames, err := vc.ViewNames(schema, whitelist, blacklist)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get view names")
+		}
+
+		for _, name := range viewNames {
+			views[name] = struct{}{}
+		}
+		names = append(names, viewNames...)
+	}
+
+	names = filterTableNames(names, whitelist, blacklist)
 	sort.Strings(names)
 
 	var tables []Table
@@ -94,15 +135,23 @@ func Tables(c Constructor, schema string, whitelist, blacklist []string) ([]Tabl
 		t := Table{
 			Name: name,
 		}
+		_, t.IsView = views[name]
 
 		if t.Columns, err = c.Columns(schema, name, whitelist, blacklist); err != nil {
 			return nil, errors.Wrapf(err, "unable to fetch table column info (%s)", name)
 		}
 
+		filterColumns(&t, whitelist, blacklist)
 		for i, col := range t.Columns {
 			t.Columns[i] = c.TranslateColumnType(col)
 		}
 
+		// Views have no keys or constraints of their own
+		if t.IsView {
+			tables = append(tables, t)
+			continue
+		}
+
 		if t.PKey, err = c.PrimaryKeyInfo(schema, name); err != nil {
 			return nil, errors.Wrapf(err, "unable to fetch table pkey info (%s)", name)
 		}
@@ -111,6 +160,13 @@ func Tables(c Constructor, schema string, whitelist, blacklist []string) ([]Tabl
 			return nil, errors.Wrapf(err, "unable to fetch table fkey info (%s)", name)
 		}
 
+		if checker, ok := c.(CheckConstraintInfoer); ok {
+			if t.Checks, err = checker.CheckConstraintInfo(schema, name); err != nil {
+				return nil, errors.Wrapf(err, "unable to fetch table check constraint info (%s)", name)
+			}
+		}
+
+		setCheckColumns(&t)
 		filterForeignKeys(&t, whitelist, blacklist)
 
 		setIsJoinTable(&t)
@@ -131,18 +187,60 @@ func Tables(c Constructor, schema string, whitelist, blacklist []string) ([]Tabl
 	return tables, nil
 }
 
+// filterTableNames removes the names the whitelist and blacklist exclude.
+// Drivers already filter by literal names, this is needed for patterns.
+func filterTableNames(names []string, whitelist, blacklist []string) []string {
+	var filtered []string
+	for _, name := range names {
+		if tableIncluded(name, whitelist, blacklist) {
+			filtered = append(filtered, name)
+		}
+	}
+	return filtered
+}
+
+// filterColumns removes the columns the whitelist and blacklist exclude.
+// Drivers already filter by literal names, this is needed for patterns.
+func filterColumns(t *Table, whitelist, blacklist []string) {
+	var columns []Column
+	for _, c := range t.Columns {
+		if columnIncluded(t.Name, c.Name, whitelist, blacklist) {
+			columns = append(columns, c)
+		}
+	}
+	t.Columns = columns
+}
+
 // filterForeignKeys filter FK whose ForeignTable is not in whitelist or in blacklist
 func filterForeignKeys(t *Table, whitelist, blacklist []string) {
 	var fkeys []ForeignKey
 	for _, fkey := range t.FKeys {
-		if (len(whitelist) == 0 || strmangle.SetInclude(fkey.ForeignTable, whitelist)) &&
-			(len(blacklist) == 0 || !strmangle.SetInclude(fkey.ForeignTable, blacklist)) {
+		if (len(whitelist) == 0 || listIncludes(whitelist, fkey.ForeignTable)) &&
+			(len(blacklist) == 0 || !listIncludes(blacklist, fkey.ForeignTable)) {
 			fkeys = append(fkeys, fkey)
 		}
 	}
 	t.FKeys = fkeys
 }
 
+// setCheckColumns fills in the columns of check constraints whose driver
+// could not report them by looking for the table's column names in the
+// constraint expression.
+func setCheckColumns(t *Table) {
+	for i, check := range t.Checks {
+		if len(check.Columns) != 0 {
+			continue
+		}
+
+		idents := rgxIdentifier.FindAllString(check.Expression, -1)
+		for _, c := range t.Columns {
+			if strmangle.SetInclude(c.Name, idents) {
+				t.Checks[i].Columns = append(t.Checks[i].Columns, c.Name)
+			}
+		}
+	}
+}
+
 // setIsJoinTable if there are:
 // A composite primary key involving two columns
 // Both primary key columns are also foreign keys
@@ -1,6 +1,7 @@
 package drivers
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/volatiletech/strmangle"
@@ -97,6 +98,15 @@ func (m testMockDriver) PrimaryKeyInfo(schema, tableName string) (*PrimaryKey, e
 	}[tableName], nil
 }
 
+// CheckConstraintInfo returns mock check constraints for the passed in table name
+func (m testMockDriver) CheckConstraintInfo(schema, tableName string) ([]CheckConstraint, error) {
+	return map[string][]CheckConstraint{
+		"pilots": {
+			{Name: "pilots_id_check", Columns: []string{"id"}, Expression: "(id >= 0)"},
+		},
+	}[tableName], nil
+}
+
 // RightQuote is the quoting character for the right side of the identifier
 func (m testMockDriver) RightQuote() byte {
 	return '"'
@@ -136,6 +146,9 @@ func TestTables(t *testing.T) {
 	if len(pilots.Columns) != 2 {
 		t.Error()
 	}
+	if len(pilots.Checks) != 1 || pilots.Checks[0].Expression != "(id >= 0)" {
+		t.Error("want the check constraint on pilots")
+	}
 	if pilots.ToOneRelationships[0].ForeignTable != "jets" {
 		t.Error("want a to many to jets")
 	}
@@ -165,6 +178,88 @@ func TestTables(t *testing.T) {
 	}
 }
 
+func TestTablesPatterns(t *testing.T) {
+	t.Parallel()
+
+	tables, err := Tables(testMockDriver{}, "public", nil, []string{"pilot_*", "*.uuid"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, table := range tables {
+		if table.Name == "pilot_languages" {
+			t.Error("pilot_languages is blacklisted")
+		}
+		for _, c := range table.Columns {
+			if c.Name == "uuid" {
+				t.Errorf("%s.uuid is blacklisted", table.Name)
+			}
+		}
+	}
+
+	if len(tables) != 6 {
+		t.Errorf("Expected len 6, got: %d\n", len(tables))
+	}
+	if jets := GetTable(tables, "jets"); len(jets.Columns) != 8 {
+		t.Errorf("Expected 8 jets columns, got: %d\n", len(jets.Columns))
+	}
+}
+
+type testMockViewDriver struct {
+	testMockDriver
+}
+
+func (m testMockViewDriver) ViewNames(schema string, whitelist, blacklist []string) ([]string, error) {
+	return []string{"pilot_stats"}, nil
+}
+
+func (m testMockViewDriver) Columns(schema, tableName string, whitelist, blacklist []string) ([]Column, error) {
+	if tableName == "pilot_stats" {
+		return []Column{
+			{Name: "pilot_id", Type: "int", DBType: "integer"},
+			{Name: "jets", Type: "int", DBType: "integer"},
+		}, nil
+	}
+
+	return m.testMockDriver.Columns(schema, tableName, whitelist, blacklist)
+}
+
+func TestTablesViews(t *testing.T) {
+	t.Parallel()
+
+	tables, err := Tables(testMockViewDriver{}, "public", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tables) != 8 {
+		t.Errorf("Expected len 8, got: %d\n", len(tables))
+	}
+
+	prev := ""
+	for i := range tables {
+		if prev >= tables[i].Name {
+			t.Error("tables are not sorted")
+		}
+		prev = tables[i].Name
+	}
+
+	view := GetTable(tables, "pilot_stats")
+	if !view.IsView {
+		t.Error("pilot_stats is a view")
+	}
+	if len(view.Columns) != 2 {
+		t.Error("want the view's columns")
+	}
+	if view.PKey != nil || len(view.FKeys) != 0 || len(view.ToOneRelationships) != 0 || len(view.ToManyRelationships) != 0 {
+		t.Error("views have no keys or relationships")
+	}
+
+	if pilots := GetTable(tables, "pilots"); pilots.IsView {
+		t.Error("pilots is not a view")
+	}
+}
+
 func TestFilterForeignKeys(t *testing.T) {
 	t.Parallel()
 
@@ -223,6 +318,33 @@ func TestFilterForeignKeys(t *testing.T) {
 	}
 }
 
+func TestSetCheckColumns(t *testing.T) {
+	t.Parallel()
+
+	table := Table{
+		Columns: []Column{
+			{Name: "id"},
+			{Name: "age"},
+			{Name: "max_age"},
+		},
+		Checks: []CheckConstraint{
+			{Name: "age_check", Expression: "(`age` >= 0)"},
+			{Name: "range_check", Expression: "([age]<=[max_age])"},
+			{Name: "reported_check", Columns: []string{"id"}, Expression: "(age > id)"},
+			{Name: "table_check", Expression: "(true)"},
+		},
+	}
+
+	setCheckColumns(&table)
+
+	want := [][]string{{"age"}, {"age", "max_age"}, {"id"}, nil}
+	for i, check := range table.Checks {
+		if !reflect.DeepEqual(check.Columns, want[i]) {
+			t.Errorf("%d) want columns %v, got %v", i, want[i], check.Columns)
+		}
+	}
+}
+
 func TestSetIsJoinTable(t *testing.T) {
 	t.Parallel()
 
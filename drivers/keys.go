@@ -22,6 +22,16 @@ type ForeignKey struct {
 	ForeignColumnUnique   bool   `json:"foreign_column_unique"`
 }
 
+// CheckConstraint represents a check constraint in a database. Expression is
+// the boolean expression of the constraint as the database reports it, ex:
+// (age >= 0). Columns are the columns the constraint refers to, table level
+// constraints may refer to several of them.
+type CheckConstraint struct {
+	Name       string   `json:"name"`
+	Columns    []string `json:"columns"`
+	Expression string   `json:"expression"`
+}
+
 // SQLColumnDef formats a column name and type like an SQL column definition.
 type SQLColumnDef struct {
 	Name string
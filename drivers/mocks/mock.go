@@ -38,6 +38,13 @@ func (m *MockDriver) Imports() (importers.Collection, error) {
 			"time.Time": {
 				Standard: importers.List{`"time"`},
 			},
+
+			"types.Int64Array": {
+				ThirdParty: importers.List{`"github.com/volatiletech/sqlboiler/v4/types"`},
+			},
+			"types.StringArray": {
+				ThirdParty: importers.List{`"github.com/volatiletech/sqlboiler/v4/types"`},
+			},
 		},
 	}, nil
 }
@@ -74,13 +81,21 @@ func (m *MockDriver) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo, er
 	return dbinfo, err
 }
 
-// TableNames returns a list of mock table names
+// TableNames returns a list of mock table names, flight_logs has no primary
+// key and is only returned when it's whitelisted, like comments which belong
+// to other tables through a polymorphic association
 func (m *MockDriver) TableNames(schema string, whitelist, blacklist []string) ([]string, error) {
-	if len(whitelist) > 0 {
-		return whitelist, nil
+	if tables := drivers.TablesFromList(whitelist); len(tables) > 0 {
+		return tables, nil
 	}
-	tables := []string{"pilots", "jets", "airports", "licenses", "hangars", "languages", "pilot_languages"}
-	return strmangle.SetComplement(tables, blacklist), nil
+	tables := []string{"pilots", "jets", "airports", "licenses", "hangars", "languages", "pilot_languages", "jet_seats", "employees", "messages"}
+	return strmangle.SetComplement(tables, drivers.TablesFromList(blacklist)), nil
+}
+
+// ViewNames returns a list of mock view names, they are filtered by
+// drivers.Tables
+func (m *MockDriver) ViewNames(schema string, whitelist, blacklist []string) ([]string, error) {
+	return []string{"pilot_stats"}, nil
 }
 
 // Columns returns a list of mock columns
@@ -89,25 +104,34 @@ func (m *MockDriver) Columns(schema, tableName string, whitelist, blacklist []st
 		"pilots": {
 			{Name: "id", Type: "int", DBType: "integer"},
 			{Name: "name", Type: "string", DBType: "character"},
+			{Name: "email", Type: "string", DBType: "character", Nullable: false, Unique: true},
+			{Name: "mood", Type: "string", DBType: "enum.mood('happy','sad','neutral')", Nullable: false},
+			{Name: "ratings", Type: "types.Int64Array", DBType: "ARRAYinteger", Nullable: false},
+			{Name: "tags", Type: "types.StringArray", DBType: "ARRAYtext", Nullable: true},
 		},
 		"airports": {
 			{Name: "id", Type: "int", DBType: "integer"},
-			{Name: "size", Type: "null.Int", DBType: "integer", Nullable: true},
+			{Name: "size", Type: "null.Int", DBType: "integer", Nullable: true, Default: "0"},
+			{Name: "created_at", Type: "time.Time", DBType: "timestamp with time zone"},
+			{Name: "updated_at", Type: "null.Time", DBType: "timestamp with time zone", Nullable: true},
+			{Name: "lock_version", Type: "int", DBType: "integer"},
 		},
 		"jets": {
 			{Name: "id", Type: "int", DBType: "integer"},
 			{Name: "pilot_id", Type: "int", DBType: "integer", Nullable: true, Unique: true},
 			{Name: "airport_id", Type: "int", DBType: "integer"},
-			{Name: "name", Type: "string", DBType: "character", Nullable: false},
-			{Name: "color", Type: "null.String", DBType: "character", Nullable: true},
+			{Name: "name", Type: "string", DBType: "character varying", FullDBType: "character varying(10)", Nullable: false},
+			{Name: "color", Type: "null.String", DBType: "character varying", FullDBType: "character varying(20)", Nullable: true},
 			{Name: "uuid", Type: "string", DBType: "uuid", Nullable: true},
 			{Name: "identifier", Type: "string", DBType: "uuid", Nullable: false},
 			{Name: "cargo", Type: "[]byte", DBType: "bytea", Nullable: false},
 			{Name: "manifest", Type: "[]byte", DBType: "bytea", Nullable: true, Unique: true},
+			{Name: "paint", Type: "null.String", DBType: "set('red','green','blue')", Nullable: true},
 		},
 		"licenses": {
 			{Name: "id", Type: "int", DBType: "integer"},
 			{Name: "pilot_id", Type: "int", DBType: "integer"},
+			{Name: "deleted_at", Type: "null.Time", DBType: "timestamp with time zone", Nullable: true},
 		},
 		"hangars": {
 			{Name: "id", Type: "int", DBType: "integer"},
@@ -121,6 +145,38 @@ func (m *MockDriver) Columns(schema, tableName string, whitelist, blacklist []st
 			{Name: "pilot_id", Type: "int", DBType: "integer"},
 			{Name: "language_id", Type: "int", DBType: "integer"},
 		},
+		"pilot_stats": {
+			{Name: "pilot_id", Type: "int", DBType: "integer"},
+			{Name: "jets", Type: "int", DBType: "integer"},
+			{Name: "last_flight", Type: "null.Time", DBType: "timestamp with time zone", Nullable: true},
+		},
+		"jet_seats": {
+			{Name: "jet_id", Type: "int", DBType: "integer"},
+			{Name: "seat", Type: "string", DBType: "character"},
+			{Name: "class", Type: "string", DBType: "character", Nullable: true},
+		},
+		"employees": {
+			{Name: "id", Type: "int", DBType: "integer"},
+			{Name: "name", Type: "string", DBType: "character"},
+			{Name: "manager_id", Type: "null.Int", DBType: "integer", Nullable: true},
+		},
+		"messages": {
+			{Name: "id", Type: "int", DBType: "integer"},
+			{Name: "sender_id", Type: "int", DBType: "integer"},
+			{Name: "recipient_id", Type: "int", DBType: "integer"},
+			{Name: "body", Type: "string", DBType: "character"},
+		},
+		"comments": {
+			{Name: "id", Type: "int", DBType: "integer"},
+			{Name: "commentable_type", Type: "string", DBType: "character"},
+			{Name: "commentable_id", Type: "int", DBType: "integer"},
+			{Name: "body", Type: "string", DBType: "character"},
+		},
+		"flight_logs": {
+			{Name: "id", Type: "int", DBType: "integer"},
+			{Name: "entry", Type: "string", DBType: "character"},
+			{Name: "remark", Type: "null.String", DBType: "character", Nullable: true},
+		},
 	}[tableName], nil
 }
 
@@ -138,6 +194,16 @@ func (m *MockDriver) ForeignKeyInfo(schema, tableName string) ([]drivers.Foreign
 			{Table: "pilot_languages", Name: "pilot_id_fk", Column: "pilot_id", ForeignTable: "pilots", ForeignColumn: "id"},
 			{Table: "pilot_languages", Name: "jet_id_fk", Column: "language_id", ForeignTable: "languages", ForeignColumn: "id"},
 		},
+		"jet_seats": {
+			{Table: "jet_seats", Name: "jet_seats_jet_id_fk", Column: "jet_id", ForeignTable: "jets", ForeignColumn: "id"},
+		},
+		"employees": {
+			{Table: "employees", Name: "employees_manager_id_fk", Column: "manager_id", ForeignTable: "employees", ForeignColumn: "id"},
+		},
+		"messages": {
+			{Table: "messages", Name: "messages_sender_id_fk", Column: "sender_id", ForeignTable: "pilots", ForeignColumn: "id"},
+			{Table: "messages", Name: "messages_recipient_id_fk", Column: "recipient_id", ForeignTable: "pilots", ForeignColumn: "id"},
+		},
 	}[tableName], nil
 }
 
@@ -159,6 +225,10 @@ func (m *MockDriver) TranslateColumnType(c drivers.Column) drivers.Column {
 			c.Type = "null.Bytes"
 		case "boolean":
 			c.Type = "null.Bool"
+		case "ARRAYinteger":
+			c.Type = "types.Int64Array"
+		case "ARRAYtext":
+			c.Type = "types.StringArray"
 		case "date", "time", "timestamp without time zone", "timestamp with time zone":
 			c.Type = "null.Time"
 		default:
@@ -180,6 +250,10 @@ func (m *MockDriver) TranslateColumnType(c drivers.Column) drivers.Column {
 			c.Type = "[]byte"
 		case "boolean":
 			c.Type = "bool"
+		case "ARRAYinteger":
+			c.Type = "types.Int64Array"
+		case "ARRAYtext":
+			c.Type = "types.StringArray"
 		case "date", "time", "timestamp without time zone", "timestamp with time zone":
 			c.Type = "time.Time"
 		default:
@@ -221,6 +295,22 @@ func (m *MockDriver) PrimaryKeyInfo(schema, tableName string) (*drivers.PrimaryK
 			Name:    "pilot_languages_pkey",
 			Columns: []string{"pilot_id", "language_id"},
 		},
+		"jet_seats": {
+			Name:    "jet_seats_pkey",
+			Columns: []string{"jet_id", "seat"},
+		},
+		"employees": {
+			Name:    "employee_id_pkey",
+			Columns: []string{"id"},
+		},
+		"messages": {
+			Name:    "message_id_pkey",
+			Columns: []string{"id"},
+		},
+		"comments": {
+			Name:    "comment_id_pkey",
+			Columns: []string{"id"},
+		},
 	}[tableName], nil
 }
 
@@ -1,10 +1,10 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
-// override/templates/17_upsert.go.tpl (5.921kB)
+// override/templates/17_upsert.go.tpl (6.15kB)
 // override/templates/singleton/mssql_upsert.go.tpl (1.385kB)
 // override/templates_test/singleton/mssql_main_test.go.tpl (3.945kB)
-// override/templates_test/singleton/mssql_suites_test.go.tpl (255B)
-// override/templates_test/upsert.go.tpl (1.723kB)
+// override/templates_test/singleton/mssql_suites_test.go.tpl (272B)
+// override/templates_test/upsert.go.tpl (1.721kB)
 
 package driver
 
@@ -73,7 +73,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templates17_upsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x5f\x6f\xdb\xc8\x11\x7f\x26\x3f\xc5\xc4\x28\x2e\x64\xab\xd0\xed\xab\x0b\x3d\xd8\x49\x2e\x35\xee\xec\xea\xa2\x4b\x03\xd4\x30\x8c\x15\x39\x94\x16\x5e\xed\x32\xcb\xa5\x64\x95\xe5\x77\x2f\x66\x77\x29\x92\xb2\x64\xcb\xb9\x73\x71\x0f\x81\x45\xee\x70\xe6\x37\xbf\xf9\xb7\x93\xba\x7e\x07\x7f\x62\x82\xb3\x12\xce\xc6\x90\x9c\xd3\x2f\x2c\x93\x5f\xd9\x4c\x20\xb8\x3f\xc9\x35\x5b\x62\xd3\x84\x56\xb4\x4c\x17\xb8\x64\xee\x98\x3e\xe8\x24\xe0\xbf\x90\x4c\xbb\x53\xfb\x01\xcf\x21\x39\xcf\xb2\x4f\x42\xcd\x98\x80\x77\x4d\x13\x9e\x9e\xc2\x97\xa2\x44\x6d\x3e\x01\x33\x06\x97\x85\x29\x81\x49\xe0\x92\xde\x8d\x80\xc9\x0c\x32\x85\xf6\x5d\x55\x64\xcc\x20\x28\x0d\x7c\x2e\x95\x46\x50\x12\x52\x25\x73\xc1\x53\x93\x84\x79\x25\x53\x88\x14\xfc\xb9\xae\x1d\xfe\xe4\x4b\x31\xe5\x72\x5e\x09\xa6\x9b\x26\x6e\xad\x44\x16\x84\x54\x06\x92\x6b\xf5\x5e\x49\x83\x0f\xa6\x69\x52\xf3\x40\xaa\xe8\x21\xf1\x2f\x47\x50\xd7\x28\x33\x02\xe9\x2d\xbf\x57\xa2\x5a\xca\x72\xe4\xc1\xf9\x47\x98\x29\x2e\x12\xff\x10\x03\x6a\xad\x34\xd4\x61\xa0\xd1\x54\x5a\x82\x4a\x9c\x61\x67\xb7\x6f\xd3\x7e\xf7\x09\xcd\x87\x8b\x28\xae\x6b\x14\x25\x5a\x1c\x23\x68\x0f\xbc\xa4\x3f\x97\x59\xd3\x8c\x9e\x44\x12\x87\x4d\x18\x6e\x41\x87\x8e\x6e\x22\xb0\x47\x39\xfd\x9c\x30\xc9\xd3\x1d\xf2\x27\xbf\x8d\x7d\xb0\x3a\x4b\x7a\x67\x09\x38\x3a\x1c\x93\xd7\x8e\x47\x1d\x06\x3c\x27\x50\x94\x9d\xff\xcf\x60\xfc\xdd\x1a\x7d\x33\x06\xc9\x05\xa1\x08\x0a\xa2\x28\xb2\xfa\xbe\x6a\x56\x7c\xd4\x3a\x42\xad\xe3\x38\x0c\x9a\x7d\x81\x3b\x10\xa9\x7d\x81\x82\xaa\xe4\x72\x4e\xcf\xf8\x80\x69\x65\x94\x7e\x49\xe1\xf4\x54\x17\xdf\x17\xc5\xc9\x63\x3e\x09\x88\xe3\xee\xa3\x87\xd4\x63\xf5\x71\x68\x3b\x71\xff\xaa\xf7\xd5\xf3\x5c\x1f\x1f\xf2\x3d\x79\xd6\xcf\x2b\x82\xf1\x7a\x61\xdd\x12\xfd\xbb\x87\xf0\xb8\x30\xfd\xb1\xa2\xb4\x6d\x94\x3c\x07\x05\xe3\x8e\x50\xdf\x38\xed\x79\x99\x5c\xe3\x3a\x3a\xa9\xeb\x64\x72\x3f\x77\x63\xe7\x0c\xa4\x82\xba\x1e\x8c\x22\x28\xb4\x5a\xf1\x0c\x33\xc8\x95\x86\xca\x7a\x7b\x62\x23\x10\x06\x34\xa5\x88\x6d\x41\xfc\x9d\x18\xbe\xc4\xd2\xb0\x65\x71\xe7\xa4\xee\x16\x28\x0a\xd4\x27\x90\x40\xe3\xa4\xbb\x2c\xf9\x87\x52\xf7\xa5\x0d\xdd\x20\x9f\x32\x75\x81\xb9\xd2\xe8\x48\xb5\x42\x47\x27\xd7\xe3\xf4\xe9\xbc\x25\xb8\x16\xad\xe5\x32\x0c\x03\xf9\x9f\x0f\x98\xb3\x4a\x18\x3b\x8a\xbf\x55\xa8\x39\x96\xc9\xb5\x92\xff\x46\xad\xfc\xd1\x14\x29\xac\x3e\xe8\x1f\xd4\x5a\x76\x61\xf7\x4c\x7f\xe5\x66\xe1\x85\x47\xa0\xe2\x30\x0c\x4e\x4f\xe1\xa2\xe2\x22\x83\x94\xa5\x0b\x84\x7b\xdc\x00\x97\xef\x04\x97\x08\xd5\x5c\x70\xb1\x81\x77\xb0\xdc\x94\xdf\x04\xac\x4a\x28\xe8\x6f\xa1\xd5\x4c\xe0\xb2\x0c\x83\x59\x95\x13\x98\xd2\xe8\x25\x93\x73\x81\xd4\x1a\x2f\xaa\x3c\x47\x1d\xc5\xf6\x34\xf9\xaa\xb9\xc1\xa9\xd1\x5c\xce\xa3\xd2\xe8\x54\xc9\x55\x72\x69\x14\x8b\x06\xb9\x91\xfc\xc4\x65\x46\x45\x42\x01\xbb\x1b\x41\x4a\x5a\x35\x93\x73\x1c\xe6\x10\xe5\x4b\x69\x89\xda\xd5\x9d\xda\xf8\x76\xaf\x2f\x36\x06\xa3\xb7\xc9\xdb\xe7\x60\x0c\x72\xf2\x09\x18\x43\xb9\xef\x81\xf1\x58\x67\x2f\xa2\x4f\xe8\xa2\x80\x9c\x8d\x81\x4e\xfd\x41\x1c\x06\x1d\xe3\x93\xaa\x65\x7c\x56\xe5\xb1\xcd\xd9\xbd\xf1\x77\xf9\xf9\x9e\x62\x7c\x55\x99\xe4\xf3\xcf\x2a\xbd\x27\x4d\x36\xea\x23\x17\xfc\x8c\x0c\x3d\xff\xfd\xcd\x3d\x6e\x6e\x8f\x36\xf4\x45\x0a\x67\x2a\x0c\x56\x4c\xdb\x84\xb7\xc5\x1c\xda\x3a\x7a\xe3\x0d\x13\x01\xed\x3d\x43\xa3\x21\x20\x43\xca\x2f\x7b\x4f\x94\xe6\x61\x10\x1c\x42\x70\x2e\x44\xdb\x73\x9e\x90\xda\x53\x10\xc7\x49\xab\xca\xf4\x3f\xe8\xa2\x48\x8f\xf1\xd6\x0f\xe8\xd7\xc5\x94\xae\x0c\xcb\x42\xe0\x12\xa5\x89\x5a\x47\x9f\xb7\x75\x5e\x19\x45\x2a\x29\x79\xf8\x08\x56\xbb\x09\x69\x79\x23\x1e\x3b\x53\xd4\x70\x18\x97\xe5\xb9\xdc\x1c\xea\x05\x13\xcd\x97\x4c\x6f\x7e\xc2\xcd\xb6\x37\xaf\x62\xf8\xe1\x87\x97\x69\xd9\xd7\x51\x56\xb1\x43\xd4\x71\xc0\x8a\x02\x65\xe6\x5d\xbe\x39\xe3\xb7\xed\x1c\xb8\xe1\x7f\xf9\xdb\xd9\x6d\x92\x24\xe4\x1f\x25\xba\xfd\xc7\x73\x10\x28\xbd\x78\x4c\x83\xe0\xaf\x4e\xe3\xb3\x73\xa0\x92\x76\xeb\x30\xca\x77\xfc\xdd\xa9\x30\x82\x54\x55\x22\xb3\x7d\x79\x66\x1b\x9e\xc7\x98\x5a\x3f\x40\xf0\xd2\x4e\x09\x3b\x26\xc8\xdc\x6e\x00\xaf\x50\xcf\x31\xd2\xf8\xa2\xc0\xfd\x56\x3d\x9e\x59\xaa\x9e\xc0\x4f\xfd\xb3\xf1\x4e\x53\xfc\xd2\x7b\xfa\x5d\x4a\xe3\x71\x7e\xf8\xcc\xf6\x08\x0e\x67\xb6\x13\x38\x9e\x20\x17\xf1\x37\x43\x7f\x2e\xcb\x6b\x25\x31\xb2\x19\x49\xc9\xe0\x4e\x5f\x39\x19\xbc\x6b\x7b\x93\xc1\xf6\xa8\x84\x46\xee\x06\xc6\x4e\xde\x75\xb9\x5f\xe8\xd5\xd5\x74\xfa\xcb\xcf\x51\xc6\x99\xc0\xd4\x8c\xe0\xa4\xae\xfb\x6b\x70\xd3\x9c\x1c\xa4\x63\x4f\x1d\xb6\x04\xf6\x7a\xa1\x65\x69\xbd\xe0\x06\x09\x15\x85\x7f\xc9\xee\x31\xba\xb9\x2d\xed\x38\x18\x59\x8e\x8e\xb5\x40\xd3\x2d\x48\x55\xb1\x89\xb6\x1a\x8f\x87\x17\x0f\x80\x6c\x6b\xbb\xa7\xc9\xc1\xf7\x45\xfd\xb4\xa8\xf3\xd0\x8a\x6e\x29\x5e\x31\x51\xe1\x15\x2b\x0a\xeb\x17\x8d\x8a\xee\xa6\x73\xc1\x65\xe6\x8f\x0e\x79\xfb\xeb\xa6\x38\x9c\x7b\x5b\xb5\x5b\x0c\xb1\xcb\xbe\x9d\x2b\xd8\xe0\x0e\xd6\xef\x49\x14\x0a\x12\xf4\x39\xe8\x10\x6b\x34\xaf\x8d\xd7\xa6\x40\xb0\x17\xea\x10\x6b\xdb\x44\x1b\x3b\x6a\x45\x65\x5b\x85\xc6\x9c\xf2\x32\xb9\x94\x19\xd7\x98\x9a\xa8\x7d\xf1\x2f\x92\xf8\x67\x1e\x29\x4a\x89\x15\x13\x83\x6b\xa5\x3d\x2c\x7f\xd4\x6a\xd9\xba\x60\x15\xfa\x7b\xc2\x20\x4e\xb1\x9b\xeb\x0e\x49\x09\x37\xb7\x5c\x1a\xd4\x39\x4b\xb1\x76\x57\x65\xe2\x6e\x97\xac\x1e\x91\xed\x87\x9d\xf1\x89\xd1\x87\x4d\xf7\x74\xb4\x37\xfa\xc1\x1a\xb3\xbd\xa1\xdb\xfd\xe2\x03\xce\xaa\xf9\x95\xca\xd0\x9a\xca\x97\x26\xf9\xb1\xd0\x5c\x1a\x21\xa3\xee\xdc\x5e\xba\x74\x6b\xc0\x96\x79\xfc\xbc\x34\x51\x16\xfb\x5b\x3a\x6d\x49\x43\xc3\x97\xa5\x15\x8e\x52\xf3\xe0\x46\xe2\xda\x7e\x66\x6f\x72\x3b\xaa\xc8\x55\x2b\xb7\x6b\x73\x7d\x04\xae\xf5\x3e\x34\xed\x8a\x79\x04\xfb\x7b\xd9\x0b\x5c\x26\xd3\x8a\x92\xd8\x16\xf7\x59\xad\xa3\x1e\x0a\x67\x8e\x4a\x37\x99\xa6\xcc\x56\x06\x85\xd0\x97\x7d\x9f\x8e\x7d\x9a\xbc\xa9\xc8\x2e\x44\x2f\xd1\xea\xdd\xda\x56\xc2\x78\x0c\xe5\x37\x91\x7c\xd4\xfa\x5a\x7d\x56\x6b\x77\x8b\xf6\x16\xa9\x44\x4e\x4f\xc1\xf6\x66\xbb\x36\xcb\xb7\xc6\xe7\x28\x30\xb9\x31\x0b\xda\xaf\xd7\x0b\x94\x60\x16\xa8\xf1\x6d\x49\x7b\xa4\xeb\x5e\xbe\x88\xc0\x7a\x71\x98\xa3\xbb\xb6\xe0\xad\x73\xb4\xfb\xee\xa7\x68\x97\x91\xc7\xdf\x3d\x4f\xc8\xd0\xff\x6e\x03\xdd\xbb\x39\xd2\x48\xfc\xaa\x59\x11\xa1\xd6\x76\x1c\xbd\x64\x30\xb6\x7b\xf2\xce\xd5\xfc\xb8\xbb\x7e\xbb\x53\x1c\x21\x6e\x77\x08\x18\x3b\x77\x8f\x36\xb0\xdd\x25\x82\x27\xb6\xf3\xed\x7f\xb5\x66\xea\x3c\x37\xa8\xbf\x6b\x33\xf7\xbb\xf7\x36\x6c\x5e\xa9\xe4\xa2\xbf\x95\x37\xe1\xff\x02\x00\x00\xff\xff\x8d\x76\x7d\x11\x21\x17\x00\x00")
+var _templates17_upsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x51\x6f\xdc\xb8\x11\x7e\x96\x7e\xc5\xc4\x28\x2e\x52\xaa\xc8\xed\xab\x0b\x3f\xd8\x49\x2e\x35\xee\xec\xfa\xb2\x97\x0b\x50\xc3\x08\xb8\xd2\x68\x97\x30\x45\x2a\x14\xb5\x6b\x55\xd5\x7f\x2f\x86\xa2\x56\xd2\x7a\xd7\x5e\x27\x75\xd1\x07\xc3\x2b\x72\x38\x33\xfc\xe6\x9b\x19\x8d\x9a\xe6\x2d\xf0\x0c\xa4\x32\x10\xff\xce\xe6\x02\xe3\x8b\xf2\x0f\x8e\x6b\x78\xdb\xb6\x3e\x6d\xfe\x89\x09\xce\x4a\x38\x39\x85\xf8\x8c\x7e\x61\xd9\xc9\xf5\xe2\x57\x2c\xc7\x5e\xb4\x4c\x96\x98\x33\xbb\x6e\x0f\x0c\x12\xf0\x6f\x88\x67\xc3\xae\x3d\xc0\x33\x88\xcf\xd2\xf4\xa3\x50\x73\x26\xac\xbd\xe3\x63\xf8\x5c\x94\xa8\xcd\x47\x60\xc6\x60\x5e\x98\x12\x98\x04\x2e\x69\x2d\x02\x26\x53\x48\x15\xda\xb5\xaa\x48\x99\x41\x50\x1a\xf8\x42\x2a\x8d\xa0\x24\x24\x4a\x66\x82\x27\x26\xf6\xb3\x4a\x26\x10\x28\x78\xd3\x34\x9d\xff\xf1\xe7\x62\xc6\xe5\xa2\x12\x4c\xb7\x6d\xd8\x5b\x09\x9a\xa6\xbf\xfb\x95\x7a\xa7\xa4\xc1\x7b\xd3\xb6\x89\xb9\x27\x55\xf4\x10\xbb\xc5\x08\x9a\x06\x65\x4a\x4e\x3a\xcb\xef\x94\xa8\x72\x59\x46\xce\x39\xf7\x08\x73\xc5\x45\xec\x1e\x42\x40\xad\x95\x86\xc6\xf7\x34\x9a\x4a\x4b\x50\x71\x67\xb8\xb3\x3b\xb6\x69\xcf\x7d\x44\xf3\xfe\x3c\x08\x9b\x06\x45\x89\xd6\x8f\x08\xfa\x0d\x27\xe9\xf6\x65\xda\xb6\xd1\xa3\x9e\x84\x7e\xeb\xfb\x1b\xa7\xe9\x27\xcf\x2c\x80\x23\xc8\xe9\xe7\x35\x93\x3c\xd9\x02\xff\xfa\xc7\xd0\x07\xab\xb3\xa4\x88\x58\x00\x0e\x0e\xc7\xf5\x4b\xc7\xa3\xf1\x3d\x9e\x51\x54\x88\x9d\xff\xcb\x60\xfc\xcd\x1a\x7d\x75\x0a\x92\x0b\xe2\x83\x57\x10\x44\x81\x35\xf4\x45\xb3\xe2\x83\xd6\x01\x6a\x1d\x86\xbe\xd7\xee\x0a\xdc\x9e\x48\xed\x0a\x14\x54\x25\x97\x0b\x7a\xc6\x7b\x4c\x2a\xa3\xf4\x73\x12\x67\xa4\xba\xf8\xbe\x28\x5e\x3f\xc4\x93\x1c\xe9\xb0\xfb\xe0\x5c\x1a\xa1\xfa\x30\xb4\x83\xb8\x5b\x1a\x9d\x7a\x1a\xeb\xc3\x43\xbe\x83\x67\x63\x5e\x91\x1b\x2f\x17\xd6\x0d\xd0\x2f\x11\xc2\x0b\x99\xa1\xd6\x98\xf6\x92\x89\x83\x46\x20\x5b\x21\xa8\xca\x80\x59\x22\x14\x9a\xe7\x4c\xd7\x70\x87\xb5\x55\x4f\x6b\x89\x46\x66\x30\x05\x66\xdc\xa1\x08\x16\x1a\x6b\xc1\x4b\x7b\x26\x07\xa3\x7a\xa5\xf6\x91\xc9\x7a\xcd\xea\x43\xb9\xf1\xff\x45\x8d\x4d\x75\xe6\x19\x28\x38\x1d\xa2\xe8\xaa\xb5\xdd\x2f\xe3\x2b\x5c\x07\x47\x4d\x13\x5f\xdf\x2d\xa8\x93\xb5\xed\x09\x48\x05\x4d\x33\xe9\x7f\x50\x68\xb5\xe2\x29\xa6\x90\x29\x0d\x95\x8d\xec\x91\xcd\x66\xdf\xa3\x2e\x4a\x59\x2a\x08\xb5\x23\xc3\x73\x2c\x0d\xcb\x8b\xaf\x9d\xd4\xd7\x25\x8a\x02\xf5\x11\xc4\x40\xbc\xf0\xc6\xd4\xfc\xbb\x52\x77\xa5\xe5\xcb\x84\xc4\xa9\x3a\xc7\x4c\x69\xec\x40\xb5\x42\x07\x33\xfa\x21\x67\x87\xdb\x92\xbb\xd6\x5b\x8b\xa5\xef\x7b\xf2\x5f\xef\x31\x63\x95\x30\xb6\xff\x7f\xab\x50\x73\x2c\xe3\x2b\x25\xff\x89\x5a\xb9\xad\x19\x9a\x60\x13\xf4\xf7\x6a\x2d\x87\xb0\x3b\xa4\xbf\x70\xb3\x74\xc2\x11\xa8\xd0\xf7\xbd\xe3\x63\x38\xaf\xb8\x48\x21\x61\xc9\x12\x2d\x01\xb9\x7c\x2b\xb8\x44\xa8\x16\x82\x8b\x1a\xde\x42\x5e\x97\xdf\x04\xac\x4a\x28\xe8\x7f\xa1\xd5\x5c\x60\x5e\xfa\xde\xbc\xca\xc8\x99\xd2\xe8\x9c\xc9\x85\x40\x2a\xd4\xe7\x55\x96\xa1\x0e\x42\xbb\x1b\x7f\xd1\xdc\xe0\xcc\x68\x2e\x17\x41\x69\x74\xa2\xe4\x2a\xbe\x30\x8a\x05\x13\x6e\xc4\xbf\x70\x99\x52\x66\x52\xc0\xbe\x46\x90\x90\x56\xcd\xe4\x02\xa7\x1c\x22\xbe\x94\x54\x46\x1e\xe8\x4e\x6c\x7c\x87\xe5\xf3\xda\x60\xf0\x3a\x7e\xfd\x94\x1b\x13\x4e\x3e\xe2\xc6\x54\xee\x7b\xdc\x78\xa8\x73\x14\xd1\x47\x74\x51\x40\x4e\x4e\x81\x76\xdd\x46\xe8\x7b\x03\xe2\xd7\x55\x8f\xf8\xbc\xca\x28\x9e\x7b\xe2\xdf\xf1\xf3\x1d\xc5\xf8\xb2\x32\xf1\xa7\x5f\x55\x72\x47\x41\xb2\x51\x8f\xba\xe0\xa7\xe4\xdb\xd3\xe7\x6f\xee\xb0\xbe\x3d\xd8\xd0\x67\x29\x3a\x53\xbe\xb7\x62\x9a\xa8\x4d\x7f\x4a\xfb\xb6\x19\xbc\x72\x86\x09\x80\xfe\xe5\x46\xa3\x21\x47\xa6\x90\x5f\x8c\x9e\x88\xe6\xbe\xe7\xed\xf3\xe0\x4c\x08\x77\x2a\x7a\x44\x6a\x47\x42\x1c\x26\xad\x2a\x33\x3e\x30\x44\x91\xac\x85\x9b\x7b\xc0\x38\x2f\x66\x68\xde\xa9\xbc\x10\x98\xa3\x34\x8e\x74\x11\x3c\x6d\xeb\xac\x32\x8a\x54\x12\x79\x78\x04\xab\x6d\x42\x5a\x12\x12\x8e\x83\x29\xaa\xcf\x8c\xcb\xf2\x4c\xd6\xfb\x6a\xc1\x75\xd7\x6c\x7e\xc1\xda\x99\x8a\x60\x15\xc2\x4f\x3f\x3d\x4f\xcb\xc8\xcd\x1e\x0f\x52\x63\x3d\x1a\x30\x60\x45\x81\x32\x75\x57\xbe\x39\xe1\xb7\x7d\x1f\xb8\xe1\x7f\xfe\xeb\xc9\x6d\x1c\xc7\x74\x3f\x4a\x1a\xfb\xc7\x33\x10\x28\x9d\x78\x48\x8d\xe0\x2f\xdd\x1d\x9f\xec\x03\x95\xa4\x16\xd0\xf5\x44\xd2\xbf\xdd\x15\x22\x48\x54\x25\x52\x5b\xce\xe7\xb6\xe0\x39\x1f\xbb\xde\x0a\xd4\x56\xa9\x4b\xd8\x36\x41\x43\xc2\x76\x00\x2f\x51\x2f\x30\xd0\xf8\xac\xc0\xfd\xa8\x1e\x87\x2c\x65\x8f\xe7\x7a\xfd\xc9\xe9\x56\x51\xec\x52\xfb\xf3\x68\xed\xbf\x92\x20\x0f\x59\xf2\x88\xf0\x45\x9e\x57\x86\x02\x30\x92\x0d\x07\x9f\xf7\xe7\x42\x27\x70\x38\xa4\xbe\xa5\xfb\xab\x29\x02\x17\xe5\x95\x92\x18\x58\x0e\x13\x7d\xba\xdd\x17\xa6\x8f\xbb\xda\x4e\xfa\xd8\xaa\x16\x53\x93\xae\x81\x6a\x37\x17\x69\x17\xa5\xdf\x68\xe9\x72\x36\xfb\xed\xd7\x20\xe5\x4c\x60\x62\x22\x38\x6a\x9a\xf1\xb4\xde\xb6\x47\x11\x1c\x1c\x13\xc7\x85\x3e\xab\x6c\xf5\xb4\x28\xad\x97\xdc\x20\x91\x9a\x6a\x46\xce\xee\x30\xb8\xb9\x2d\x6d\x03\x89\x6c\x8a\x1d\x6a\x81\xda\xb2\x97\xa8\xa2\x0e\x36\x1a\x0f\x77\x2f\x9c\x38\xb2\xa9\x06\x23\x4d\x9d\xfb\xae\x0c\x3c\x2e\xda\xdd\xd0\x8a\x6e\x20\x5e\x31\x51\xe1\x25\x2b\x0a\x7b\x2f\x6a\x2e\xc3\xbb\xd1\x39\x97\xa9\xdb\xda\x57\xc3\x7e\xaf\x8b\xfd\xdc\xdb\xa8\xdd\xf8\x40\xd7\xe1\xd9\xf6\x4b\xdb\x88\x5c\xd3\x2a\x46\xa1\x80\x57\x1b\x0e\x76\xa4\xd0\x68\x5e\xda\x5f\xb2\xeb\x7b\x3b\x5d\x9d\xfa\xda\x97\x5d\xe2\xac\x45\x92\xb8\xa2\x31\x23\x5e\xc6\x17\x32\xe5\x1a\x13\x13\xf4\x0b\x7f\x90\xc4\x3f\xb2\x40\x11\x25\x56\x4c\x4c\x5e\x44\xed\x66\xf9\xb3\x56\x79\x7f\x05\xab\xd0\xbd\x59\x4c\xe2\x64\x4f\x6b\x22\x6a\xa5\x65\x09\x37\xb7\x5c\x1a\xd4\x19\x4b\xb0\x69\xfd\x1e\xbb\x6d\xb0\x46\x40\xf6\x07\x07\xe3\xd7\x46\xef\x37\x3d\xd2\xd1\xcf\x00\x93\xc1\x67\xf3\x4e\x6f\x27\x92\xf7\x38\xaf\x16\x97\x2a\x45\x6b\x2a\xcb\x4d\xfc\x73\xa1\xb9\x34\x42\x06\xc3\xbe\x7d\x4d\xd3\xbd\x01\xf2\xa2\x0e\x9f\x96\x26\xc8\x42\xf7\x5e\x4f\x73\xd5\xd4\xf0\x45\x69\x85\x83\xc4\xdc\x87\xd6\xf6\xda\x1e\x23\x8c\xb7\x55\xd1\x55\xad\xdc\xb6\xcd\xf5\x01\x7e\xad\x77\x79\xd3\x4f\xc2\x07\xa0\xbf\x13\x3d\xaf\xcb\x3c\x9a\x20\x63\x5b\xe2\x3e\xa9\xb5\x53\x62\xbd\xe8\xcc\x51\xea\xc6\xb3\x84\xd9\xcc\xa0\xd8\xbb\xb4\x1f\xc3\xb1\x4b\x93\x33\x45\x57\x8e\xe0\x39\x5a\xdd\xb5\x36\x99\x70\x7a\x0a\xe5\x37\x11\x7f\xd0\xfa\x4a\x7d\x52\xeb\x6e\x94\x70\x16\x29\x45\x8e\x8f\xc1\xd6\x66\x3b\xdd\xcb\xd7\xc6\x71\x14\x98\xac\xcd\x92\x3e\x03\xac\x97\x28\x69\xf6\xd6\xf8\xba\xa4\xc9\xb3\xab\x5e\x2e\x89\xc0\xde\x62\x3f\x46\x5f\xfb\x84\xb7\x30\xd1\xb4\xbc\x1b\xa2\x6d\x44\x1e\x9e\x7b\x1a\x90\xe9\xfd\x5b\x7f\x47\x2d\x18\x2a\x01\xb5\x44\xfa\xf2\x45\xdf\x47\x22\x78\x66\x63\xec\x27\xeb\xad\x97\xf9\xc3\xa6\x83\x7e\x0a\x39\x40\xdc\x4e\x1d\x70\xda\x5d\xf7\x60\x03\x9b\xe9\xc3\x7b\x64\x9e\x77\x48\xa8\x38\x55\x67\x99\x41\xfd\x5d\xb3\xbc\x9b\xd6\x37\x61\x73\x4a\x25\x17\xe3\x39\xbe\x1d\xbe\x3b\x35\xcd\xf1\x9b\xfe\x73\xbf\xfb\xce\xff\xe6\xb8\x6d\xfd\xff\x0c\x00\x9e\x58\x2c\xaf\x06\x18\x00\x00")
 
 func templates17_upsertGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -89,11 +89,11 @@ func templates17_upsertGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates/17_upsert.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2b, 0x5a, 0x66, 0xe6, 0xd9, 0x6b, 0x35, 0xfd, 0x39, 0xa6, 0x4b, 0x6, 0x12, 0x46, 0xd4, 0x7a, 0x27, 0xf9, 0xeb, 0x17, 0x59, 0x9b, 0x95, 0xa5, 0xe6, 0xf2, 0xcd, 0x3f, 0xa2, 0x5d, 0xbb, 0x6a}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe9, 0x45, 0xa8, 0xe4, 0xf3, 0x38, 0x80, 0xd0, 0xf0, 0xd2, 0x30, 0x2a, 0x43, 0xa5, 0xec, 0x87, 0x7, 0xaa, 0x9d, 0x31, 0x3a, 0xaf, 0xe3, 0xc, 0xa1, 0xa1, 0xc6, 0x49, 0xe6, 0xd1, 0x9d, 0xe0}}
 	return a, nil
 }
 
-var _templatesSingletonMssql_upsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\x4d\x6f\xda\x40\x14\x3c\x7b\x7f\xc5\xab\xa5\x48\x5e\x65\xe5\x34\xd7\x46\x54\xa2\xc1\x4d\xa8\x88\x81\xd8\xb4\x07\xc2\x61\xc1\xcf\x64\x25\xb3\xa0\xfd\x40\x8d\xaa\xfc\xf7\xea\xd9\xa6\x40\xf0\xa9\x17\xf0\xbe\x8f\xd1\xec\xcc\xd8\x37\x37\xb0\xf4\xaa\x2a\x66\x3b\x8b\xc6\x4d\x3d\x9a\xb7\xa7\x2c\x9b\x8e\x9a\xaa\x05\x09\x74\xb0\x4e\x3a\xdc\xa0\x76\x60\x9d\x51\x7a\x0d\xde\xd2\xaf\x7b\x45\xf0\xf5\xe2\x40\x3a\x09\x3b\xb3\xdd\xab\x02\x8b\x98\x95\x5e\xaf\xba\x71\xa3\x42\x49\x28\x8c\xda\xa3\xb1\xf1\x40\xc9\x0a\x57\x4e\x80\x93\xcb\x0a\x53\xb9\xc1\x16\x5f\xc0\xce\xa8\x8d\x34\x6f\x02\xfc\xae\x90\x0e\x05\x28\x4d\x40\x30\x5f\x1c\x26\xb6\xde\xed\xfc\xb1\xc0\x0f\xd4\xfe\xb0\xa0\x9d\xed\x51\x69\x23\xf5\xba\xc2\x78\x58\xa0\x76\x53\xbf\x75\x98\x55\x6a\x85\x44\x23\x1e\x4d\x05\xd0\xff\xf3\xf4\x00\xcf\x19\x0b\x96\xbe\x84\x2f\xa7\xab\x0f\xe8\xbe\xf9\xb2\x44\x13\x71\x16\x14\x58\xa2\x39\x69\x4e\xfc\xa1\xb9\xf4\x25\xad\x5b\x27\x8d\x1b\xea\x02\x7f\x13\xca\x2d\x63\x41\xb9\x71\xf1\xf7\x9d\x51\xda\x95\x34\x24\x20\x7c\x4a\x9e\x1f\x12\x18\xa6\xf9\x18\xae\x2c\x48\x0b\x73\xb7\x78\xd1\xe1\x89\x0e\xbc\x6b\x6d\x96\x0d\xd3\x07\x88\xb2\x64\x94\xdc\xe7\x70\x65\x79\xbd\x6a\x17\x10\xcd\xaf\xec\x82\x13\x02\x0b\x82\x13\x6e\x95\x5c\xe1\xeb\xb6\x2a\xd0\xd8\xfa\xc2\x33\x8b\x35\xb3\xd3\x86\x80\x0a\x75\xd4\xca\xcd\x05\x1c\xf9\x0b\xb8\xe5\x2d\xa0\xd2\x6b\x1b\xff\xd8\xaa\x7f\x83\xa2\x55\x3b\x6a\xf4\xe3\xd7\xa1\x08\xaf\x4f\x4a\xa3\x29\xe7\x67\x77\x68\xaf\x30\x4e\x21\x0a\xa9\xb1\x35\xa0\x04\xec\x49\x23\x23\xf5\x1a\x0f\x86\x93\x7d\x81\x2a\x41\xc1\xa7\x1e\x7c\xae\x4f\x97\x28\xd0\x4f\x07\x40\x30\xc1\x3b\x0b\x3a\x84\x9a\xdb\x45\x4c\x92\x40\x8f\x94\xad\x1f\x43\x01\x7b\x01\x7b\xce\x68\xe5\x02\x90\xb4\xfb\x60\xde\x75\xef\x4c\x18\xc6\x88\x15\x55\x9a\x40\x72\xf8\xda\xd2\xbb\x00\xfb\xf5\x98\xa4\xf0\xd4\xcf\xef\x1f\x93\x01\xe4\x74\xa8\xa9\x76\xf8\x39\x19\xf4\xf3\x04\xb2\x84\xcc\xac\xfd\x3f\x7a\x97\xa1\x9b\x48\x23\x37\x14\x06\x1b\x9d\x2b\xfb\x51\xfc\x73\xd3\x5a\x7e\xc4\xb8\xe3\x3e\x6d\x97\x64\xe8\xd0\xa1\xa6\x9e\x8e\xf3\x4b\xfa\x97\xec\x87\x69\x96\x3c\xe7\x10\x51\x0e\x7f\xf6\x47\xb3\x24\xab\x9f\xc3\x8b\xc8\x34\xaf\x96\x80\x90\x84\xfe\xef\x84\xb6\x2f\xe8\xc7\x80\x9e\x18\xd3\x7c\x10\xba\x8c\x39\x50\x7e\xd1\xe3\x59\x3e\x99\xe5\xd0\x70\x4f\x06\x75\x34\xee\xc2\x83\xa0\x2d\xe1\x06\x48\x40\xb8\x10\xc7\xc1\x90\xf2\xfc\x0e\x58\x59\xec\xb6\xfd\x2e\x6c\x55\x35\xe8\xbc\xd1\xb0\xf4\x65\x9c\x35\x3e\x71\xf6\xce\xfe\x06\x00\x00\xff\xff\x96\xf9\x01\xfb\x69\x05\x00\x00")
+var _templatesSingletonMssql_upsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\xcb\x6e\xe2\x4a\x10\x5d\xbb\xbf\xa2\xae\xa5\x48\x6e\xa5\xe5\xdc\x6c\x6f\xc4\x95\x98\xe0\x49\x18\x11\xf3\xb0\x99\x59\x10\x16\x0d\x2e\x93\x96\x4c\x83\xfa\x81\x26\x8a\xf2\xef\xa3\x32\x26\x31\xc1\xab\xd9\x40\x77\x3d\x8e\x4e\x9d\x53\xed\x9b\x1b\x58\x79\x55\x15\xf3\xbd\x45\xe3\xa6\x1e\xcd\xeb\x53\x96\x4d\x47\xc7\xa8\x05\x09\x74\xb1\x4e\x3a\xdc\xa2\x76\x60\x9d\x51\x7a\x03\xde\xd2\xaf\x7b\x41\xf0\x75\xe3\x40\x3a\x09\x7b\xb3\x3b\xa8\x02\x8b\x98\x95\x5e\xaf\xbb\x71\xa3\x42\x49\x28\x8c\x3a\xa0\xb1\xf1\x40\xc9\x0a\xd7\x4e\x80\x93\xab\x0a\x53\xb9\xc5\x06\x5f\xc0\xde\xa8\xad\x34\xaf\x02\xfc\xbe\x90\x0e\x05\x28\x4d\x40\xb0\x58\x9e\x2a\x76\xde\xed\xfd\x67\x80\x9f\xa8\xbd\xb1\xa0\xa9\xed\x51\x68\x2b\xf5\xa6\xc2\x78\x58\xa0\x76\x53\xbf\x73\x98\x55\x6a\x8d\x44\x23\x1e\x4d\x05\xd0\xff\x6c\x7a\x82\xe7\x8c\x05\x2b\x5f\xc2\x7f\xed\xd6\x07\x74\xdf\x7c\x59\xa2\x89\x38\x0b\x0a\x2c\xd1\xb4\x92\x13\x7f\x4a\xae\x7c\x49\xed\xd6\x49\xe3\x86\xba\xc0\xdf\x84\x72\xcb\x58\x50\x6e\x5d\xfc\x7d\x6f\x94\x76\x65\xb4\xf2\xa5\x80\xf0\x29\x99\x3d\x24\x30\x4c\xf3\x31\x5c\x59\x90\x16\x16\x6e\xf9\xac\xc3\x96\x0e\xbc\xab\x6d\x9e\x0d\xd3\x07\x88\xb2\x64\x94\xdc\xe7\x70\x65\x79\xdd\x6a\x97\x10\x2d\xae\xec\x92\x13\x02\x0b\x82\x16\xb7\x4a\xae\xf1\x65\x57\x15\x68\x6c\x3d\xf0\xdc\x62\xcd\xac\x9d\x10\x50\xa1\x8e\x1a\xb9\xb9\x80\x4f\xfe\x02\x6e\x79\x03\xa8\xf4\xc6\xc6\x3f\x76\xea\xa3\x50\x34\x6a\xd7\xb0\xb3\x29\xbf\x0e\x45\x78\xdd\x0a\x8d\xa6\x9c\x9f\xcd\xd0\x8c\x30\x4e\x21\x0a\x29\xb1\x33\xa0\x04\x1c\x48\x23\x23\xf5\x06\x4f\x86\xc3\x1b\x0b\x02\x55\x82\x82\x7f\x7a\xf0\x6f\x7d\xbb\x44\x81\x7e\x3a\x00\x82\x09\xde\x59\xd0\x21\xd4\xc2\x2e\x63\x92\x04\x7a\xa4\x6c\x7d\x0c\x05\x1c\x04\x1c\x38\xa3\x96\x0b\x40\xd2\xee\x8b\x79\xd7\xbd\x33\x61\x18\x23\x56\x14\x39\x2e\x24\x87\xff\x1b\x7a\x17\x60\xbf\x1e\x93\x14\x9e\xfa\xf9\xfd\x63\x32\x80\x9c\x2e\x21\x3f\xab\xfb\xf0\x73\x32\xe8\xe7\x09\x64\x09\x99\x49\xee\xb5\xf6\x2a\x43\x37\x91\x46\x6e\xe9\x51\xd8\xe8\x5c\xd9\xaf\xe2\x9f\x9b\xd6\xf0\xa3\x71\x3a\xe6\x69\xb2\x24\x43\x87\x0e\x35\xf5\x74\x9c\x5f\xd2\xbf\x64\x3f\x4c\xb3\x64\x96\x43\x44\x7b\xf8\xb3\x3f\x9a\x27\x59\x7d\x0e\x2f\x56\xe6\xf8\xb4\x04\x84\x24\xf4\x5f\x6f\x68\xf3\x40\xbf\x2e\x68\xcb\x98\xe3\x07\xa1\xcb\x98\x13\xe5\x67\x3d\x9e\xe7\x93\x79\x0e\x47\xee\xc9\xa0\x5e\x8d\xbb\x50\xc0\x19\xe1\x23\x90\x80\x70\x29\x3e\x0b\x43\xda\xe7\x77\xc0\xca\x62\xb7\xed\x77\x24\x13\xa9\x6a\xd0\x79\xa3\x61\xe5\xcb\x38\x73\x46\xe9\x4d\xc4\xd9\x3b\xfb\x33\x00\x96\xf9\x01\xfb\x69\x05\x00\x00")
 
 func templatesSingletonMssql_upsertGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -113,7 +113,7 @@ func templatesSingletonMssql_upsertGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _templates_testSingletonMssql_main_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\xed\x53\xdb\x36\x18\xff\x6c\xff\x15\x4f\x73\xd7\xd6\x66\x9e\x68\xd7\xdd\x3e\xd0\xcb\xf5\xf2\x62\x5a\xae\x24\x81\x38\x5b\xb7\xa3\x0c\x94\x58\x06\x1d\xb6\x64\x24\x19\x9a\x31\xfe\xf7\x9d\x24\xdb\xb1\xd3\x24\x85\x4f\xe3\x4b\xd0\xa3\xe7\xf5\xf7\xbc\xc9\x77\x58\x80\xb8\xfa\x36\x8a\xa2\xd3\xe3\x1b\xb2\x84\x2e\x08\x72\x45\xbe\xe5\x68\x54\x48\x35\xe0\x59\x4e\x53\xe2\x5d\x7a\x1f\x32\xff\xef\xde\xf1\x2c\x9c\xc2\xac\xd7\x3f\x0e\x01\xed\xf5\x86\xc3\xaf\xf2\xa7\xc1\x64\x1c\xcd\xa6\xbd\xa3\xf1\x0c\xd0\x1e\x1c\x4e\xa6\xe1\xd1\xc7\x31\x7c\x0e\xff\x42\x7b\x1f\xd0\xde\x57\xf6\x61\x1a\x1e\x86\xd3\x70\x3c\x08\x23\xb4\x77\xe9\xbb\xae\x5a\xe6\x04\x32\x29\x6f\xd3\x19\x91\x8a\x08\x90\x4a\x14\x0b\x05\x0f\xae\x13\xcf\x07\x9c\x31\xd0\x7f\x7b\xf2\x36\x45\xc3\xbe\xa6\x8d\x71\x46\x0c\x4d\x2a\x41\xd9\x95\xeb\x5c\x73\xa9\x00\x5a\xa4\x42\x12\xb1\x46\xca\xb1\x94\x6b\x24\x29\xd3\x8c\xc7\xa4\xc5\xc5\x45\xa5\x8b\x32\xe5\x3a\x8a\x48\x35\xec\x1b\x93\xb5\xd4\x0d\xcd\xa3\xd3\xe3\x41\x16\xc3\x9c\xf3\xd4\x7d\x74\xdd\xa4\x60\x0b\xa0\x8c\x2a\xcf\xb7\x7e\x8f\x30\x65\xd0\x85\x57\x8d\xb8\x1e\x1e\x6b\x4e\x2f\x83\xbd\xc6\x8d\x0f\x92\xa8\x22\xf7\x7c\x20\x42\x70\xa1\x35\xe8\x24\x10\x21\x2c\xc1\x75\x9d\x3b\x9a\x13\x81\x22\xa2\x86\x24\xc1\x45\xaa\xbc\x8e\x91\x47\x72\x71\x4d\x32\xdc\x09\xa0\x13\xcf\x79\xc7\xdf\xc1\x68\x43\xd5\x9c\x4a\x14\x64\x17\xab\x86\xa0\x13\xc0\xdb\x5f\xdf\xbd\xf3\x5d\xd7\xc9\x50\x09\x79\x17\xac\xc4\x47\xa2\x22\x03\x45\x25\x10\xcf\x19\xce\x8c\xca\x0c\x99\x5c\x6c\xe5\xd4\xb7\x96\xcf\x24\x68\x2b\x9f\xbe\xb5\x7c\x26\x6b\x5b\xf9\xf4\x6d\xc9\xa7\xf3\xd6\xe0\x3b\x62\xed\x78\x0c\x53\x95\xef\xad\xfa\x2a\x94\x0c\x77\x23\xf5\x5b\x05\x34\x4f\x33\xfc\x46\x6d\x34\x64\xfa\x9c\xa7\xb5\x89\x1b\x9a\xcb\xdb\x74\x91\xc5\x1d\x8d\xae\x4e\x72\x17\xee\x70\x8a\x51\x9f\x5c\x51\xf6\x07\x4e\x69\x8c\x15\xe5\xcc\xf3\x51\x79\x20\x9e\xeb\x38\x86\xc5\x1a\x1f\x73\x15\x66\xb9\x5a\x7a\x3b\xd1\x0b\xa0\x7d\x7c\x9e\x0e\x9b\xa9\x5a\x47\x79\xac\x74\x8c\xb9\xf2\xcc\x3f\xe1\x6d\x81\x53\xe9\x6d\x87\x3d\x80\x37\xb5\x12\x4b\x79\xae\x27\x15\xbc\xb5\x9a\x9a\xf0\x3c\x3d\x75\x6e\x6b\x45\x2b\x8a\xeb\xf8\x68\x70\x4d\x16\x37\x9e\xce\x09\x4d\x4c\xef\xbd\xe8\x02\xa3\xa9\xee\x46\x47\x10\x55\x08\xa6\xa9\xae\xf3\xe8\xba\xce\xfe\x3e\x0c\x04\xc1\x8a\x00\x06\x81\x59\xcc\x33\xfa\x0f\x89\x21\x9e\x83\x76\x0d\x19\x15\x29\x61\x5e\xb3\x88\x7c\xe8\x76\xe1\x8d\x51\xb7\x56\x5b\xb5\x06\x14\x29\x3c\x4f\x89\xbd\xf0\xaa\xc6\xf3\xad\x4d\x9a\xc0\x8b\x56\x81\x69\x4d\xa5\xab\x5d\xc8\x50\x2c\x78\x3e\x33\x6a\x3d\xff\xfd\x7a\x00\xad\x08\x9c\xc7\xb6\xe4\xc2\x84\xf2\x64\x59\xd7\x71\xac\x84\x76\xe2\xa0\x0b\xe4\x1b\x59\xa0\x01\xcf\x32\xcc\x62\xaf\x53\xd6\x76\x00\x9d\x9f\xa3\x4e\x00\x76\x22\xe8\xd3\xef\xe6\xa4\x8b\x51\x9f\x4e\xcc\x49\xf7\xaf\x3e\xc5\xe6\xd4\xc0\x4a\x1b\x49\x02\xe3\xc9\x41\x17\xb8\x44\x93\x9c\x30\xaf\x63\xe0\x91\x17\x76\xea\x21\x79\x9b\xea\xae\xdb\x90\xaf\x86\xcb\x5c\x48\xf4\x45\xe0\xdc\x23\x42\x1b\x4e\x30\x4d\x49\x0c\x8a\x03\xcf\x09\x83\xef\x14\x42\x42\x53\xd3\xcb\x36\xd0\x98\x24\x44\x80\x1e\xda\x7a\xb2\xc3\x05\x74\x21\x41\x83\x94\x4b\xe2\xf9\xf0\x68\xaa\xc5\x91\x2a\x2e\xfd\x7c\x35\x5f\x2a\x22\x51\xbf\x48\x12\x33\xef\x1b\x40\xa1\x48\xc5\x66\x25\x30\x72\x7f\xf8\x99\x2c\x87\x44\x2a\xc1\x97\x44\x78\x8d\x5d\x1b\x40\xe2\xaf\x0b\xd9\x24\x59\x1b\x6e\x33\x6f\x4d\x2e\x2c\xd4\xee\xc4\x6d\x45\x41\x6a\x59\xb0\x49\x83\x85\x4d\xe2\x2a\xfc\x0d\xc6\xbe\x60\xba\xd1\x56\x92\x29\x74\x22\x28\x53\x29\xd3\x46\xfc\x75\x9a\x8d\xa0\xec\x55\xcf\xf7\x9f\xe8\xdf\x3d\xa6\x0a\x12\x2e\x36\xbb\x68\xbc\x2c\xb5\x30\x9a\xee\x58\xb0\x32\x1d\xf1\x98\x78\x66\xfc\xdb\x45\xee\x97\xbf\xda\x7d\x79\x4f\xd5\xe2\x1a\xcc\xed\x83\xeb\x2c\xb0\x24\xe5\x9e\x3c\x58\x75\xbf\x25\x54\xb7\x09\x4e\x65\xfb\xda\x52\x5c\x5d\x33\x7a\x9d\x36\xaf\x62\x2a\x75\xa1\x75\xb4\xc3\x5b\x7d\x6c\xb7\xe1\xea\x2d\xa0\xab\xf2\xa0\x0b\x1a\xcc\x28\xd7\x68\x26\xde\xa5\xeb\x0c\xa6\x61\x6f\x16\xc2\xb0\x37\xeb\xf5\x7b\x51\x08\x2f\xe5\x7b\xd7\xf9\x38\x71\x1d\xfb\x28\x5b\xd1\xcf\xde\x9e\x4b\xd7\x89\xc2\x19\x4c\xc3\xde\xf0\x62\x30\x19\x8d\x8e\x66\xb3\x70\x78\x11\x8d\x7b\x27\xd1\xa7\xc9\x0c\x26\x63\x23\x7a\xb9\xde\x83\x95\xfb\x19\x12\x05\x1b\x64\xb1\x27\x6f\xd3\x00\x9e\xdf\xe1\xfe\xf6\x98\x9b\x43\x6b\x15\xf1\xfe\x3e\x44\x94\x2d\x08\x8c\x22\x88\x4e\x8f\xe1\x97\x37\x6f\x7f\x03\xaa\x60\x81\x19\xcc\x09\xc4\x9c\x11\xb8\xa7\xea\xda\x70\x0e\xa7\x93\x93\x55\xb8\x67\x70\x74\x08\xe1\x9f\x47\xd1\x2c\x82\x73\x78\x80\x18\x2b\x3c\xc7\x92\x5c\xe8\xc1\x0c\xff\xae\xce\x92\xe1\x5c\x5e\x73\x65\x2f\x1e\xe1\x0c\x02\x84\x10\x83\x73\x38\x7b\x7f\xbe\x0d\xf4\x5a\xb7\x17\x85\xc7\xe1\x60\x66\xc6\x3d\x1c\x4e\x27\x23\x90\x4b\x89\x2a\xe5\x12\x5c\xc7\xf9\xf2\x29\x9c\x86\x96\xa1\x0b\xaf\x5f\xca\xd7\xba\x64\xdb\xce\xbe\x94\x1b\x70\xff\x1f\xb2\xa0\x08\x16\x31\xbf\x67\xcd\x1c\xd0\x44\xef\x14\xfb\x00\x6f\xf4\x79\x45\xab\x86\xe0\x8f\x77\xd3\xc1\xf3\x97\xd3\x53\xbb\xba\x02\x44\x8f\xd6\xa0\x1a\x0d\x65\x5b\x07\x80\xc5\x95\x04\x84\x50\xd5\xee\x75\x68\x8b\x0d\x7b\xab\x14\xb6\x52\x08\x21\xdf\xb0\xd5\x53\xdb\xea\x90\x68\x4c\xee\xa7\x04\xc7\x44\x58\xa3\x7a\xfe\x4b\x15\xf3\x42\x6d\x1c\xff\x3b\x36\x43\xa9\x5c\x4b\x9a\xe9\xce\x0b\x55\x13\x5b\x23\xbf\x01\xa3\xbe\x9e\x16\x6c\x03\x82\xcd\x41\x5b\x0d\x4f\x51\x30\x46\xd9\xd5\x41\xa7\x46\xc6\x06\xe7\xbb\xdf\x0d\x66\x5e\xa8\xd6\x60\xfe\xc1\xdc\x5e\x7f\x0d\x3d\x25\x55\x0b\xce\x74\x79\x79\xe5\x77\x5c\x60\xb3\xe1\xef\xa8\xb4\xba\xec\xed\x55\x60\xf4\x1b\x7b\xed\x8f\x23\x67\xc5\x51\x02\x77\x9b\x96\xcf\x05\xe3\x41\x27\x80\x58\xd0\x3b\x22\x90\x59\xb3\xfd\x82\xa6\xf1\x69\x41\xc4\xb2\x0c\xa9\xea\x95\xea\x35\xb2\xde\x8b\xb6\xaf\xec\x17\x86\xfe\x2d\x5f\x8d\x1a\x89\xad\x0f\x45\x46\xd3\xe0\x3b\x7c\xda\x91\x3c\xba\xff\x05\x00\x00\xff\xff\x68\x86\x33\x94\x69\x0f\x00\x00")
+var _templates_testSingletonMssql_main_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\xdd\x53\xdb\xb8\x16\x7f\xb6\xfe\x8a\xd3\xcc\xb4\xb5\xb9\xbe\xa2\xbd\xbd\xb3\x0f\x74\x32\x9d\x7c\x98\x96\x29\x49\x20\xce\x6e\x77\x87\xb2\xa0\xc4\x32\x68\xb0\x25\x23\xc9\xd0\x2c\x9b\xff\x7d\x47\x92\x9d\x38\x21\xa1\xf0\xb4\x0f\x99\x8c\xce\x97\xce\xf9\x9d\x2f\xf9\x8e\x48\x90\x57\x3f\x06\x71\x7c\x7a\x7c\x43\xe7\xd0\x06\x49\xaf\xe8\x8f\x02\x0f\x4a\xa5\x7b\x22\x2f\x58\x46\xfd\x4b\xff\x53\x1e\xfc\xd9\x39\x9e\x44\x63\x98\x74\xba\xc7\x11\xe0\xbd\x4e\xbf\xff\x5d\xfd\xa7\x37\x1a\xc6\x93\x71\xe7\x68\x38\x01\xbc\x07\x87\xa3\x71\x74\xf4\x79\x08\x5f\xa3\x3f\xf0\xde\x27\xbc\xf7\x9d\x7f\x1a\x47\x87\xd1\x38\x1a\xf6\xa2\x18\xef\x5d\x06\x08\xe9\x79\x41\x21\x57\xea\x36\x9b\x50\xa5\xa9\x04\xa5\x65\x39\xd3\xf0\x80\xbc\x64\xda\x13\x9c\x03\x00\xc0\x9e\xba\xcd\x70\xbf\x8b\xbc\x64\x3a\x24\x39\xb5\x34\xa5\x25\xe3\x57\xc8\xbb\x16\x4a\x9b\x73\x83\x54\x2a\x2a\x37\x48\x05\x51\x6a\x83\xa4\x54\x96\x8b\x84\x36\x49\x85\x90\xb5\x2d\xc6\x35\xf2\x34\x55\xba\xdf\xb5\x57\x2e\xb5\x6e\x58\x11\x9f\x1e\xf7\xf2\x04\xa6\x42\x64\x68\x81\x50\x5a\xf2\x19\x30\xce\xb4\x1f\x38\xbf\x07\x84\x71\x68\xc3\x9b\x46\x5c\x0f\x8b\xa5\xa4\x9f\xc3\x5e\x83\x13\x80\xa2\xba\x2c\xfc\x00\xa8\x94\x42\x1a\x0b\x26\x09\x54\xda\x9f\x90\x08\x79\x77\xac\xa0\x12\xc7\x54\xf7\x69\x4a\xca\x4c\xfb\x2d\xab\x8f\xd5\xec\x9a\xe6\xa4\x15\x42\x2b\x99\x8a\x56\xf0\x84\xa0\x0b\xd5\x48\x6a\x59\xd2\xa7\x44\x0d\x04\xad\x10\xde\xff\xff\xc3\x87\x00\x21\x2f\xc7\x15\xe4\x6d\x70\x1a\x9f\xa9\x8e\x2d\x14\xb5\x42\x32\xe5\x24\xb7\x26\x73\x6c\x73\xb1\x53\xd2\x70\x9d\x9c\x4d\xd0\x4e\x39\xc3\x75\x72\x36\x6b\x3b\xe5\x0c\xb7\x92\x33\x79\x6b\xc8\x1d\xf1\xf5\x78\xac\x50\x9d\xef\x9d\xf6\x2a\x01\x67\xb2\x91\xfa\x9d\x0a\x46\xa6\x19\x7e\xa3\x36\x1a\x3a\x5d\x21\xb2\xe5\x15\x37\xac\x50\xb7\xd9\x2c\x4f\x5a\x06\x5d\x93\xe4\x36\xdc\x91\x8c\xe0\x2e\xbd\x62\xfc\x37\x92\xb1\x84\x68\x26\xb8\x1f\xe0\xea\x40\x7d\xe4\x79\x56\xc4\xe1\x3e\x14\x3a\xca\x0b\x3d\xf7\x77\x38\xe5\xd0\x0b\x61\xfd\xf8\x32\x1b\x2e\x53\x21\xac\x1f\x6b\x1b\x43\xa1\x7d\xeb\x50\x74\x5b\x92\x4c\xf9\xbb\x61\x0f\xe1\xdd\xd2\x88\x2d\xac\xe0\xa5\x9e\xd4\xf0\x86\xb0\x49\x78\x99\x9d\x65\x6e\x43\x78\x44\x41\x5e\x80\x7b\xd7\x74\x76\xe3\x9b\x9c\xb0\xd4\xf4\x1d\xbc\x6a\x03\x67\x99\xe9\x46\x4f\x52\x5d\x4a\x6e\xa8\xc8\x5b\x20\xe4\xed\xef\x43\x4f\x52\xa2\x29\x10\x90\x84\x27\x22\x67\x7f\xd1\x04\x92\x29\x18\x5f\xb1\x35\x91\x51\xee\x37\x8b\x28\x80\x76\x1b\xde\x59\x73\x1b\xb5\xb5\xb4\x80\x63\x4d\xa6\x19\x75\x0c\xbf\x6e\xbc\xc0\xdd\xc9\x52\x78\xb5\x56\x60\xc6\x52\xe5\x6a\x1b\x72\x9c\x48\x51\x98\x09\xda\xef\xfa\xc1\xc7\xcd\x00\xd6\x22\xf0\x16\xeb\x9a\x33\x1b\xca\xb3\x75\x91\xe7\x39\x0d\x53\xe5\x07\x6d\xa0\x3f\xe8\x0c\xf7\x44\x9e\x13\x9e\xf8\xad\xaa\xb6\x43\x68\xfd\x37\x6e\x85\xe0\x26\x82\x39\xfd\x6a\x4f\xa6\x36\xcd\xe9\xc4\x9e\x4c\xff\x9a\x53\x62\x4f\x0d\xac\x10\xf2\xbc\x34\x34\x57\xc2\x41\x1b\x84\xc2\xa3\x82\x72\xbf\x65\xe1\x51\x17\x6e\xea\x61\x75\x9b\xb5\x82\x55\x28\xdb\x5d\x16\x52\xe1\x6f\x92\x14\x3e\x95\x32\x84\x56\x4a\x58\x46\x13\xd0\x02\x44\x41\x39\x3c\x32\x08\x29\xcb\xec\x28\x73\x81\x26\x34\xa5\x12\xcc\xd0\x36\x93\x1d\x2e\xa0\x0d\x29\xee\x65\x42\x51\x3f\x80\x85\xad\x16\x4f\xe9\xa4\xf2\xf3\xcd\x74\xae\xa9\xc2\xdd\x32\x4d\xa9\x7c\x58\x34\x81\xc2\xb1\x4e\xec\x4a\xe0\xf4\xfe\xf0\x2b\x9d\xf7\xa9\xd2\x52\xcc\xa9\xf4\x1b\xbb\x36\x84\x34\xd8\x54\x32\xa6\xdb\xe0\xee\x40\xcd\xbc\x35\xa5\x88\xd4\x4f\x27\x6e\x27\x0a\x4a\x13\xa9\xc1\x25\x0d\x66\x2e\x89\xab\xf0\xb7\x5c\xf6\x8d\xb0\xad\x77\xa5\xb9\xc6\x27\x92\x71\x9d\x71\x73\x49\xb0\x49\x73\x11\x54\xbd\xea\x07\xc1\x33\xfd\xbb\x27\x4c\x43\x2a\xe4\x76\x17\xad\x97\x95\x15\xce\xb2\x27\x16\xac\xca\x06\x22\xa1\xbe\xe9\xf7\x6a\x91\x07\xd5\xbf\x71\x5f\xdd\x33\x3d\xbb\x06\xcb\x7d\x40\xde\x8c\x28\x5a\xed\xc9\x83\x55\xf7\x3b\x42\xcd\x4d\x49\xa6\xd6\xd9\x8e\x82\xbc\xc4\xad\xd3\x26\x2b\x61\xca\x14\x5a\x0b\x79\x4f\x3c\x02\xd6\xdb\x70\xf5\x16\x30\x55\x79\xd0\x06\x03\x70\x5c\x18\x34\x53\xff\x12\x79\xbd\x71\xd4\x99\x44\xd0\xef\x4c\x3a\xdd\x4e\x1c\xc1\x6b\xf5\x11\x79\x9f\x47\xc8\x73\x8f\xb2\x15\xfd\xec\xfd\xb9\x42\x5e\x1c\x4d\x60\x1c\x75\xfa\x17\xbd\xd1\x60\x70\x34\x99\x44\xfd\x8b\x78\xd8\x39\x89\xbf\x8c\x26\x30\x1a\x5a\xd5\xcb\xcd\x1e\xac\xdd\xcf\xb1\x2c\x79\x2f\x4f\x7c\x75\x9b\x85\xf0\xf2\x0e\x0f\x76\xc7\xdc\x1c\x5a\xab\x88\xf7\xf7\x21\x66\x7c\x46\x61\x10\x43\x7c\x7a\x0c\xff\x7b\xf7\xfe\x17\x60\x1a\x66\x84\xc3\x94\x42\x22\x38\x85\x7b\xa6\xaf\xad\x64\x7f\x3c\x3a\x59\x85\x7b\x06\x47\x87\x10\xfd\x7e\x14\x4f\x62\x38\x87\x07\x48\x88\x26\x53\xa2\xe8\x85\x19\xcc\xf0\xf7\xea\xac\x38\x29\xd4\xb5\xd0\x8e\xb1\x80\x33\x08\x31\xc6\x1c\xce\xe1\xec\xe3\xf9\x2e\xd0\x97\xb6\xfd\x38\x3a\x8e\x7a\x13\x3b\xee\xe1\x70\x3c\x1a\x80\x9a\x2b\x5c\x1b\x57\x80\x3c\xef\xdb\x97\x68\x1c\x39\x81\x36\xbc\x7d\xad\xde\x9a\x92\x5d\x77\xf6\xb5\xda\x82\xfb\xbf\x90\x05\x4d\x89\x4c\xc4\x3d\x6f\xe6\x80\xa5\x66\xa7\xb8\x07\x78\xa3\xcf\x6b\x5a\x3d\x04\x7f\xbe\x9b\x0e\x5e\xbe\x9c\x9e\xdb\xd5\x35\x20\x66\xb4\x86\xf5\x68\xa8\xda\x3a\x04\x22\xaf\x14\x60\x8c\xeb\x76\x5f\x86\x36\xdb\xb2\xb7\x2a\x65\xa7\x85\x31\x0e\x90\x37\x6b\x4c\x6d\x67\x43\xe1\x21\xbd\x1f\x53\x92\x50\xe9\x2e\x35\xf3\x5f\xe9\x44\x94\x7a\xeb\xf8\x7f\x62\x33\x54\xc6\x8d\xa6\x9d\xee\xa2\xd4\x4b\xe2\xda\xc8\x6f\xc0\x68\xd8\xe3\x92\x6f\x41\xb0\x39\x68\xeb\xe1\x29\x4b\xce\x19\xbf\x3a\x68\x2d\x91\x71\xc1\x05\x1b\xf2\xee\xf2\xb5\xc1\xfc\x93\xb9\xdd\x4c\xd7\x73\x53\x35\x13\xdc\x94\x97\x5f\x7d\xc7\xd9\xd5\x2e\x64\xf0\x44\xa5\x55\x56\x6b\x56\x68\xed\xdb\x6a\x5b\xff\x38\xf2\x56\x12\x15\x70\xb7\x59\xf5\x5c\xb0\x45\xde\x0a\x21\x91\xec\x8e\x4a\x6c\x3f\x69\xbb\x25\xcb\x92\xd3\x92\xca\x79\x15\x52\xdd\x2b\xf5\x6b\x64\xb3\x17\x5d\x5f\xb9\x2f\x0c\xf3\x5f\xbd\x1a\x83\x60\x99\x9a\xc7\x4e\x73\x96\x85\x8f\xf0\x59\x8f\x64\x81\xfe\x19\x00\x68\x86\x33\x94\x69\x0f\x00\x00")
 
 func templates_testSingletonMssql_main_testGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -133,7 +133,7 @@ func templates_testSingletonMssql_main_testGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _templates_testSingletonMssql_suites_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8f\xc1\x0a\xc2\x30\x10\x44\xef\xfd\x8a\xa5\xe4\xd0\x4a\x9b\x0f\x10\x3c\x78\xd4\x83\x88\xb4\x1f\x10\xed\xb6\x04\xe2\x5a\xba\x5b\x10\x42\xfe\x5d\xd2\x46\xe9\xc1\xdb\x0c\x6f\x32\x99\xed\x67\x7a\x40\x83\x2c\xed\xc8\x38\x49\x21\xb0\x13\x64\xb1\x34\xe8\xa6\x04\x9f\x01\x78\x5f\xc3\x64\x68\x40\x50\x96\x3a\x7c\x57\xa0\xc4\xdc\x1d\xc2\xfe\x00\xba\x89\x8a\x43\x48\x39\xdb\x27\xa8\x4f\x7c\x7e\x59\x5a\x30\xd4\x3f\x8e\x8e\xb7\x56\x19\x67\x0d\xc7\x22\xa5\x8f\x51\x22\xaf\x8d\xdf\x96\x8b\x79\xe2\x92\x16\x7d\x9b\xa9\xc8\xbd\x5f\x9f\xe8\x76\xbc\xba\x79\x32\x2e\x84\xbc\x82\x38\xf8\x0f\x59\x2f\x2a\x97\xbf\x90\xba\xed\x8c\xe4\x42\xf6\x09\x00\x00\xff\xff\x11\x5d\x4c\xce\xff\x00\x00\x00")
+var _templates_testSingletonMssql_suites_testGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x4f\xcd\x0a\x82\x40\x10\xbe\xfb\x14\x83\xec\x41\x43\xf7\x01\x82\x0e\x1d\xeb\x10\x11\xda\x7d\xcb\x51\x16\xb6\x51\x76\x56\x0a\x96\x7d\xf7\x58\x95\x32\xe8\xf6\xcd\x7c\x3f\x33\x5f\x3b\xd2\x1d\x2a\x64\x57\x0f\x8c\xd6\x65\x0e\x36\x0e\xd9\x69\xea\x64\x95\x83\x4f\x00\xbc\x2f\xc1\x2a\xea\x10\x84\xa6\x06\x5f\x05\x08\xa7\x6e\x06\x61\xbb\x03\x59\x45\xc4\x21\x2c\x3a\xdd\x42\x6f\x17\x5e\x1e\xf8\xd8\x6b\x9a\x14\xdf\xd5\x55\xe3\x13\xca\x8f\x01\x0d\xe3\x6a\x14\xca\x68\xc5\x31\x59\xc8\x7d\x84\xc8\xf2\x27\xe0\xa4\x1e\x38\xa9\x9d\xbc\x8c\x94\xa5\xde\xcf\x16\x59\x0f\x67\x33\x5a\x65\x42\x48\x0b\x88\x0d\xfe\x30\x73\xc5\x7c\xba\x85\xd4\xac\xdf\xa0\x06\xca\x10\x92\x90\xbc\x07\x00\x2e\xdd\xe8\xdc\x10\x01\x00\x00")
 
 func templates_testSingletonMssql_suites_testGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -149,11 +149,11 @@ func templates_testSingletonMssql_suites_testGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/singleton/mssql_suites_test.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x10, 0xc4, 0x71, 0xaf, 0xd9, 0x16, 0x41, 0x8b, 0x4b, 0xfc, 0xe8, 0xba, 0xfd, 0xfa, 0x4d, 0x2c, 0x1, 0xd1, 0x0, 0xe1, 0xb0, 0x78, 0xee, 0x7f, 0xd0, 0x65, 0xf3, 0xa1, 0x43, 0xba, 0x3c, 0xe7}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa8, 0x9b, 0x31, 0x28, 0x31, 0x43, 0x3f, 0xa0, 0x34, 0xad, 0x26, 0x75, 0x7c, 0x27, 0x22, 0x4b, 0xad, 0x4c, 0xf1, 0x7b, 0x2e, 0x1a, 0x89, 0xa3, 0x15, 0x74, 0xc5, 0xda, 0x21, 0xf6, 0x8f, 0xa7}}
 	return a, nil
 }
 
-var _templates_testUpsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x54\x4d\x6f\xdb\x30\x0c\x3d\x5b\xbf\x82\x0b\xb6\x41\x1e\x5c\x15\xbb\x76\xc8\x21\xfd\x38\x14\xc3\x82\xa0\x71\xce\x83\x6a\xd3\xa9\x10\x45\x32\x24\x7a\x49\x66\xe8\xbf\x0f\x92\xd3\x36\x6d\xda\xa1\x87\xed\xd0\x43\x62\x4b\x78\x7c\x8f\x7c\x24\xdd\xf7\x27\xf0\x51\x6a\x25\x3d\x9c\x8d\x41\x4c\xe2\x1b\x7a\x51\xca\x5b\x8d\x30\x3c\xc4\x54\xae\x31\x04\xd6\x74\xa6\x02\x42\x4f\x7d\x3f\x44\x88\x45\x3b\xd3\x9d\x93\x3a\x84\x45\xeb\xd1\x11\x27\xf8\x12\x01\xca\x2c\x45\x99\x43\xcf\x32\x12\x33\xe9\xa4\xd6\xa8\x79\xce\x58\xa6\x1a\xd0\x68\xf8\x03\xc1\xa5\xdd\x98\xb9\x32\xcb\x4e\x4b\x17\xc2\x44\xeb\x0b\xab\xbb\xb5\xf1\x39\x8c\xc7\x7f\x43\xce\x9c\x5a\x4b\xb7\xfb\x8e\xbb\x87\x80\x9e\x65\x19\x89\xf9\x4a\xb5\x7c\x14\xff\x5b\x65\x96\x40\xa9\x8c\x8d\xa2\x3b\xb0\x46\xef\xa0\x1d\xe2\x60\x85\x3b\xa8\x86\xc8\x51\xce\xb2\xc0\x58\xe6\x11\xeb\x68\x81\x93\xa6\xb6\x6b\xf5\x1b\xc5\x14\x37\x73\xc4\x9a\xe7\x2c\xfb\x25\x1d\xa0\x4b\x3f\xeb\x58\x76\x7a\x0a\x13\x22\x5c\xb7\x04\x74\x87\x70\x3d\x9d\x5f\xdd\x94\xe0\x55\x8d\x60\x1b\x90\x06\x16\xb3\x78\xc3\x32\x1b\x19\x0f\xec\x7a\xac\xa0\x0f\xc9\x8d\x48\x7a\xa8\x39\x27\xd7\x55\xc4\x63\x32\x05\x7c\xb6\x05\xbc\x62\xc0\xe5\x79\xb9\x6b\xd1\x17\x40\xae\xc3\xfc\x5b\xe2\xf9\x30\x06\xa3\xf4\xde\x88\xab\x98\x69\xc3\x47\x0b\x93\x2c\x20\xfb\x28\xf2\x72\x42\xe0\x93\xf4\x19\x7c\xf2\xa3\x22\xf2\xed\x7d\xe9\x7b\xd5\x80\xb1\x04\x62\x6a\x2f\xac\x21\xdc\x52\x08\x15\x6d\x63\x65\xd5\x70\x16\xe7\xb2\x5a\x2d\x9d\xed\x4c\xcd\xf3\xbe\x47\x53\x87\xc0\xb2\x01\xf2\xa3\xf3\x54\x6e\x79\x62\x39\x64\x38\xba\xb8\xb5\x4a\x8b\x73\x5c\x2a\x93\x38\xb4\xc7\xc3\xbb\x72\xcb\x2b\xda\x16\xb1\xc0\x7b\x85\x37\x81\x72\x96\xd5\xd8\xa0\x83\x38\xbc\x3c\x87\x1e\x7e\xc2\x18\x68\x2b\x6e\xac\xd6\xb7\xb2\x5a\xf1\x1c\x42\xec\xf0\x43\x2f\xac\xd8\xcf\xf2\x6b\x85\xc7\x9e\xa0\xa9\xe1\x24\x04\x88\xa7\xa4\x7f\x6d\x1a\x74\x3c\x7f\x7a\x7a\x5b\x5f\xba\x24\xf7\x72\x53\x8e\xba\x51\xd9\xce\x50\xba\x78\x36\x59\xf7\x8b\xc8\x73\x71\x11\x31\x6f\x4c\xff\xb1\xf2\xe3\x2c\xf9\xbd\x6c\x84\x24\xe1\x08\xfa\xfa\x04\x32\xda\x48\x43\x60\x0d\x82\xc3\xca\xba\xba\x80\xa5\xa5\xb3\x51\x31\xe0\xf7\x49\x3f\x5b\x97\xc5\xec\x72\x52\x5e\xbd\xb4\x2e\xff\x62\x21\x1a\xa9\x3d\xbe\x0a\x3b\xfa\x70\x08\x21\xfe\xeb\xfa\xbc\xbf\xb9\x7a\x27\x63\x15\xd8\x9f\x00\x00\x00\xff\xff\xf6\x71\x76\xb4\xbb\x06\x00\x00")
+var _templates_testUpsertGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x54\xcb\x6e\xdb\x30\x10\x3c\x8b\x5f\xb1\x35\xda\x82\x2c\x14\x06\xbd\xa6\xf0\xc1\x79\x1c\x82\xa2\x86\x11\xcb\xe7\x82\x91\x56\x0e\x61\x9a\x14\xc8\x55\x6d\x57\xe0\xbf\x17\x94\xf2\x70\x12\xa7\xf0\xa1\x3d\xe4\x60\x4b\x24\x66\x77\x66\xf6\xa1\xae\x3b\x81\x8f\xca\x68\x15\xe0\x6c\x0c\x72\x92\xde\x30\xc8\x42\xdd\x1a\x84\xe1\x21\xa7\x6a\x8d\x31\xb2\xba\xb5\x25\x10\x06\xea\xba\x21\x42\x2e\x9a\x99\x69\xbd\x32\x31\x2e\x9a\x80\x9e\x38\xc1\x97\x04\xd0\x76\x29\x0b\x01\x1d\xcb\x48\xce\x94\x57\xc6\xa0\xe1\x82\xb1\x4c\xd7\x60\xd0\xf2\xc7\x04\x97\x6e\x63\xe7\xda\x2e\x5b\xa3\x7c\x8c\x13\x63\x2e\x9c\x69\xd7\x36\x08\x18\x8f\xff\x86\x9c\x79\xbd\x56\x7e\xf7\x1d\x77\x8f\x01\x1d\xcb\x32\x92\xf3\x95\x6e\xf8\x28\xfd\x37\xda\x2e\x81\x92\x7e\xd8\x68\xba\x03\x67\xcd\x0e\x9a\x21\x0e\x56\xb8\x83\x72\x88\x1c\x09\x96\x45\xc6\xb2\x80\x58\xa5\x12\x78\x65\x2b\xb7\xd6\xbf\x51\x4e\x71\x33\x47\xac\xb8\x60\xd9\x2f\xe5\x01\x7d\xff\x73\x9e\x65\xa7\xa7\x30\x21\xc2\x75\x43\x40\x77\x08\xd7\xd3\xf9\xd5\x4d\x01\x41\x57\x08\xae\x06\x65\x61\x31\x4b\x37\x2c\x73\x29\xe3\xa3\x87\x45\xf3\xe4\xa0\x8b\x7d\x35\x52\xd2\x3d\xce\x39\xf9\xb6\x24\x9e\xb4\xe4\xf0\xd9\xe5\xf0\x86\xff\xcb\xf3\x62\xd7\x60\xc8\x81\x7c\x8b\xe2\x5b\xd2\x05\x1f\xc6\x60\xb5\x49\x45\xcf\x48\x5e\x79\xef\x7c\xcd\x47\x0b\xdb\x57\x80\xdc\x13\xc7\x61\x3d\x10\x7a\xea\x33\xf8\x14\x46\x79\xca\x77\x5f\x96\xae\xd3\x35\x58\x47\x20\xa7\xee\xc2\x59\xc2\x2d\xc5\x58\xd2\x36\x19\x2b\x87\xb3\x3c\x57\xe5\x6a\xe9\x5d\x6b\x2b\x2e\xba\x0e\x6d\x15\x23\xcb\x06\xc8\x8f\x36\x50\xb1\xe5\x7d\x96\xfd\x0c\xaf\x2e\x6e\x9d\x36\xf2\x1c\x97\xda\xf6\x39\x4c\xc0\xfd\xbb\x62\xcb\x4b\xda\xe6\xc9\xe0\x03\xc3\x51\x20\xc1\xb2\x0a\x6b\xf4\x90\x66\x97\x0b\xe8\xe0\x27\x8c\x81\xb6\xf2\xc6\x19\x73\xab\xca\x15\x17\x10\xb9\xd8\x6b\x85\x93\xf7\xa3\xfc\x96\xf1\xd4\x13\xb4\x15\x9c\xc4\x08\xe9\xd4\xf3\x5f\xdb\x1a\x3d\x17\xcf\x4f\xc7\xf5\xa5\xed\xe9\x0e\x37\xe5\x55\x37\x4a\xd7\x5a\xea\xdb\xf3\x62\xb0\x1e\xf6\x90\x0b\x79\x91\x30\x47\xca\x7f\x72\xfe\x5a\x25\x7f\xa0\x4d\x90\x9e\x38\x59\xf9\xfa\x0c\x32\xda\x28\x4b\xe0\x2c\x82\xc7\xd2\xf9\x2a\x87\xa5\xa3\xb3\x51\x3e\xe0\xef\x45\xbf\xd8\x96\xc5\xec\x72\x52\x5c\x1d\xda\x96\x7f\xb0\x0f\xb5\x32\x01\x73\x38\xf6\xb3\x21\xa5\xfc\xaf\xdb\xf3\xfe\xc6\xea\x9d\x4c\x55\x64\x7f\x06\x00\x92\x07\x13\x41\xb9\x06\x00\x00")
 
 func templates_testUpsertGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -169,7 +169,7 @@ func templates_testUpsertGoTpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "templates_test/upsert.go.tpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xea, 0xe3, 0xe8, 0x1e, 0xc3, 0xef, 0x74, 0x5d, 0xf, 0xf2, 0x30, 0xa1, 0x6, 0x82, 0x8f, 0x70, 0xe4, 0xb, 0xca, 0x51, 0x16, 0xa2, 0x3d, 0x8f, 0x40, 0x59, 0xec, 0xfe, 0x96, 0x39, 0x2, 0x36}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x97, 0xfb, 0xa1, 0xb8, 0x6, 0xe9, 0xd4, 0xba, 0x6c, 0x96, 0x76, 0x4d, 0xa4, 0x6c, 0x86, 0xcc, 0xd4, 0x20, 0x39, 0x11, 0xaf, 0xf6, 0x3d, 0x55, 0xf0, 0xb6, 0x46, 0x86, 0x69, 0x38, 0xbb, 0x10}}
 	return a, nil
 }
 
@@ -271,6 +271,9 @@ var _bindata = map[string]func() (*asset, error){
 	"templates_test/upsert.go.tpl":                      templates_testUpsertGoTpl,
 }
 
+// AssetDebug is true if the assets were built with the debug flag enabled.
+const AssetDebug = false
+
 // AssetDir returns the file names below a certain
 // directory embedded in the file by go-bindata.
 // For example if you run go-bindata on data/... and data contains the
@@ -135,14 +135,26 @@ func MSSQLBuildQueryString(user, pass, dbname, host string, port int, sslmode st
 // retrieves all table names from the information_schema where the
 // table schema is schema. It uses a whitelist and blacklist.
 func (m *MSSQLDriver) TableNames(schema string, whitelist, blacklist []string) ([]string, error) {
+	return m.tableNames(schema, "BASE TABLE", whitelist, blacklist)
+}
+
+// ViewNames retrieves all view names from the information_schema where the
+// table schema is schema. It uses a whitelist and blacklist.
+func (m *MSSQLDriver) ViewNames(schema string, whitelist, blacklist []string) ([]string, error) {
+	return m.tableNames(schema, "VIEW", whitelist, blacklist)
+}
+
+// tableNames retrieves the names of the relations of tableType, as found in
+// information_schema.tables, in schema.
+func (m *MSSQLDriver) tableNames(schema, tableType string, whitelist, blacklist []string) ([]string, error) {
 	var names []string
 
 	query := `
 		SELECT table_name
 		FROM   information_schema.tables
-		WHERE  table_schema = ? AND table_type = 'BASE TABLE'`
+		WHERE  table_schema = ? AND table_type = ?`
 
-	args := []interface{}{schema}
+	args := []interface{}{schema, tableType}
 	if len(whitelist) > 0 {
 		tables := drivers.TablesFromList(whitelist)
 		if len(tables) > 0 {
@@ -373,6 +385,54 @@ func (m *MSSQLDriver) ForeignKeyInfo(schema, tableName string) ([]drivers.Foreig
 	return fkeys, nil
 }
 
+// CheckConstraintInfo retrieves the check constraints for a given table name.
+// Only the column of column level constraints is reported, the columns of
+// table level constraints are filled in by drivers.Tables.
+func (m *MSSQLDriver) CheckConstraintInfo(schema, tableName string) ([]drivers.CheckConstraint, error) {
+	var checks []drivers.CheckConstraint
+
+	query := `
+	SELECT cc.name,
+		cc.definition,
+		COALESCE(c.name, '')
+	FROM sys.check_constraints cc
+	INNER JOIN sys.tables t ON t.object_id = cc.parent_object_id
+	INNER JOIN sys.schemas s ON s.schema_id = t.schema_id
+	LEFT JOIN sys.columns c ON c.object_id = cc.parent_object_id AND c.column_id = cc.parent_column_id
+	WHERE s.name = ?
+	  AND t.name = ?
+	ORDER BY cc.name
+	`
+
+	var rows *sql.Rows
+	var err error
+	if rows, err = m.conn.Query(query, schema, tableName); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var check drivers.CheckConstraint
+		var column string
+
+		if err = rows.Scan(&check.Name, &check.Expression, &column); err != nil {
+			return nil, err
+		}
+
+		if len(column) != 0 {
+			check.Columns = []string{column}
+		}
+
+		checks = append(checks, check)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return checks, nil
+}
+
 // TranslateColumnType converts postgres database types to Go types, for example
 // "varchar" to "string" and "bigint" to "int64". It returns this parsed data
 // as a Column object.
@@ -1,3 +1,4 @@
+{{- if not .Table.IsView -}}
 {{- $alias := .Aliases.Table .Table.Name}}
 {{- $schemaTable := .Table.Name | .SchemaTable}}
 {{if .AddGlobal -}}
@@ -30,6 +31,7 @@ func (o *{{$alias.UpSingular}}) UpsertP({{if .NoContext}}exec boil.Executor{{els
 {{end -}}
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// Inferred update columns leave out the primary key and the created at column, greylist them to update them anyway.
 func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, updateColumns, insertColumns boil.Columns) error {
 	if o == nil {
 		return errors.New("{{.PkgName}}: no {{.Table.Name}} provided for upsert")
@@ -89,9 +91,10 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 		ret = strmangle.SetMerge(ret, {{$alias.DownSingular}}ColumnsWithAuto)
 		ret = strmangle.SetMerge(ret, {{$alias.DownSingular}}ColumnsWithDefault)
 
-		update := updateColumns.UpdateColumnSet(
+		update := updateColumns.UpsertUpdateColumnSet(
 			{{$alias.DownSingular}}AllColumns,
 			{{$alias.DownSingular}}PrimaryKeyColumns,
+			{{$alias.DownSingular}}ImmutableColumns,
 		)
 		update = strmangle.SetComplement(update, {{$alias.DownSingular}}ColumnsWithAuto)
 
@@ -170,3 +173,4 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 	return nil
 	{{- end}}
 }
+{{end -}}{{/* if not IsView */}}
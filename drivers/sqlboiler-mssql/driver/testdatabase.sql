@@ -1,4 +1,5 @@
 -- Don't forget to maintain order here, foreign keys!
+drop view if exists user_videos;
 drop table if exists video_tags;
 drop table if exists tags;
 drop table if exists videos;
@@ -11,7 +12,8 @@ drop table if exists type_monsters;
 -- meaning that the driver result varies every time it's run.
 
 create table users (
-	id int identity (1,1) primary key not null
+	id int identity (1,1) primary key not null,
+	age int null constraint users_age_check check (age >= 0)
 );
 
 create table sponsors (
@@ -146,3 +148,9 @@ create table type_monsters (
 	varchar100_null  varchar(100) null,
 	varchar100_nnull varchar(100) not null
 );
+
+-- Views must be the only statement in their batch
+GO
+
+create view user_videos as select id, user_id from videos;
+GO
@@ -96,8 +96,11 @@ func (m *MySQLDriver) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo, e
 			LQ: '`',
 			RQ: '`',
 
-			UseLastInsertID: true,
-			UseSchema:       false,
+			UseLastInsertID:     true,
+			UseSchema:           false,
+			UseRandFunction:     true,
+			UseMatchAgainst:     true,
+			UseBoundLimitOffset: true,
 		},
 	}
 
@@ -137,10 +140,22 @@ func MySQLBuildQueryString(user, pass, dbname, host string, port int, sslmode st
 // retrieves all table names from the information_schema where the
 // table schema is public.
 func (m *MySQLDriver) TableNames(schema string, whitelist, blacklist []string) ([]string, error) {
+	return m.tableNames(schema, "BASE TABLE", whitelist, blacklist)
+}
+
+// ViewNames retrieves all view names from the information_schema where the
+// table schema is schema. It uses a whitelist and blacklist.
+func (m *MySQLDriver) ViewNames(schema string, whitelist, blacklist []string) ([]string, error) {
+	return m.tableNames(schema, "VIEW", whitelist, blacklist)
+}
+
+// tableNames retrieves the names of the relations of tableType, as found in
+// information_schema.tables, in schema.
+func (m *MySQLDriver) tableNames(schema, tableType string, whitelist, blacklist []string) ([]string, error) {
 	var names []string
 
-	query := fmt.Sprintf(`select table_name from information_schema.tables where table_schema = ? and table_type = 'BASE TABLE'`)
-	args := []interface{}{schema}
+	query := `select table_name from information_schema.tables where table_schema = ? and table_type = ?`
+	args := []interface{}{schema, tableType}
 	if len(whitelist) > 0 {
 		tables := drivers.TablesFromList(whitelist)
 		if len(tables) > 0 {
@@ -191,7 +206,7 @@ func (m *MySQLDriver) Columns(schema, tableName string, whitelist, blacklist []s
 	select
 	c.column_name,
 	c.column_type,
-	if(c.data_type = 'enum', c.column_type, c.data_type),
+	if(c.data_type in ('enum', 'set'), c.column_type, c.data_type),
 	if(extra = 'auto_increment','auto_increment',
 		if(version() like '%MariaDB%' and c.column_default = 'NULL', '',
 		if(version() like '%MariaDB%' and c.data_type in ('varchar','char','binary','date','datetime','time'),
@@ -352,6 +367,55 @@ func (m *MySQLDriver) ForeignKeyInfo(schema, tableName string) ([]drivers.Foreig
 	return fkeys, nil
 }
 
+// CheckConstraintInfo retrieves the check constraints for a given table name.
+// MySQL only reports check constraints since 8.0.16, nothing is returned for
+// older servers. The columns of the constraints are not reported and are
+// filled in by drivers.Tables.
+func (m *MySQLDriver) CheckConstraintInfo(schema, tableName string) ([]drivers.CheckConstraint, error) {
+	var checks []drivers.CheckConstraint
+
+	var supported bool
+	row := m.conn.QueryRow(`
+	select count(*) > 0
+	from information_schema.tables
+	where table_schema = 'information_schema' and table_name = 'CHECK_CONSTRAINTS'`)
+	if err := row.Scan(&supported); err != nil || !supported {
+		return nil, err
+	}
+
+	query := `
+	select cc.constraint_name, cc.check_clause
+	from information_schema.table_constraints tc
+		inner join information_schema.check_constraints cc
+			on cc.constraint_schema = tc.constraint_schema and cc.constraint_name = tc.constraint_name
+	where tc.table_schema = ? and tc.table_name = ? and tc.constraint_type = 'CHECK'
+	order by cc.constraint_name
+	`
+
+	var rows *sql.Rows
+	var err error
+	if rows, err = m.conn.Query(query, schema, tableName); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var check drivers.CheckConstraint
+
+		if err = rows.Scan(&check.Name, &check.Expression); err != nil {
+			return nil, err
+		}
+
+		checks = append(checks, check)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return checks, nil
+}
+
 // TranslateColumnType converts mysql database types to Go types, for example
 // "varchar" to "string" and "bigint" to "int64". It returns this parsed data
 // as a Column object.
@@ -1,3 +1,4 @@
+{{- if not .Table.IsView -}}
 {{- $alias := .Aliases.Table .Table.Name}}
 {{- $schemaTable := .Table.Name | .SchemaTable}}
 {{if .AddGlobal -}}
@@ -38,7 +39,9 @@ var mySQL{{$alias.UpSingular}}UniqueColumns = []string{
 }
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// MySQL uses ON DUPLICATE KEY UPDATE, so any of the table's unique keys act as the conflict target.
 // See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+// Inferred update columns leave out the primary key and the created at column, greylist them to update them anyway.
 func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, updateColumns, insertColumns boil.Columns) error {
 	if o == nil {
 		return errors.New("{{.PkgName}}: no {{.Table.Name}} provided for upsert")
@@ -94,9 +97,10 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 			{{$alias.DownSingular}}ColumnsWithoutDefault,
 			nzDefaults,
 		)
-		update := updateColumns.UpdateColumnSet(
+		update := updateColumns.UpsertUpdateColumnSet(
 			{{$alias.DownSingular}}AllColumns,
 			{{$alias.DownSingular}}PrimaryKeyColumns,
+			{{$alias.DownSingular}}ImmutableColumns,
 		)
 
 		if !updateColumns.IsNone() && len(update) == 0 {
@@ -227,3 +231,4 @@ CacheNoHooks:
 	return nil
 	{{- end}}
 }
+{{end -}}{{/* if not IsView */}}
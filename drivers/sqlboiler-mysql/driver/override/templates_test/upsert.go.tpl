@@ -13,7 +13,7 @@ func test{{$alias.UpPlural}}Upsert(t *testing.T) {
 	var err error
 	// Attempt the INSERT side of an UPSERT
 	o := {{$alias.UpSingular}}{}
-	if err = randomize.Struct(seed, &o, {{$alias.DownSingular}}DBTypes, false); err != nil {
+	if err = randomizeStruct(seed, &o, {{$alias.DownSingular}}DBTypes, false); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
@@ -33,7 +33,7 @@ func test{{$alias.UpPlural}}Upsert(t *testing.T) {
 	}
 
 	// Attempt the UPDATE side of an UPSERT
-	if err = randomize.Struct(seed, &o, {{$alias.DownSingular}}DBTypes, false, {{$alias.DownSingular}}PrimaryKeyColumns...); err != nil {
+	if err = randomizeStruct(seed, &o, {{$alias.DownSingular}}DBTypes, false, {{$alias.DownSingular}}PrimaryKeyColumns...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
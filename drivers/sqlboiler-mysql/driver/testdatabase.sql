@@ -1,4 +1,5 @@
 -- Don't forget to maintain order here, foreign keys!
+drop view if exists user_videos;
 drop table if exists video_tags;
 drop table if exists tags;
 drop table if exists videos;
@@ -7,7 +8,9 @@ drop table if exists users;
 drop table if exists type_monsters;
 
 create table users (
-	id int primary key not null auto_increment
+	id int primary key not null auto_increment,
+	age int null,
+	constraint users_age_check check (age >= 0)
 );
 
 create table sponsors (
@@ -41,6 +44,8 @@ create table type_monsters (
 	id int primary key not null auto_increment,
 
 	enum_use    enum('monday', 'tuesday', 'wednesday', 'thursday', 'friday') not null,
+	set_use     set('red', 'green', 'blue') not null,
+	set_null    set('red', 'green', 'blue') null,
 
 	id_two     int not null,
 	id_three   int,
@@ -169,3 +174,5 @@ create table type_monsters (
 	text_null     text null,
 	text_nnull    text not null
 );
+
+create view user_videos as select id, user_id from videos;
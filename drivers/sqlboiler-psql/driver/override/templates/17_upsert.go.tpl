@@ -1,3 +1,4 @@
+{{- if not .Table.IsView -}}
 {{- $alias := .Aliases.Table .Table.Name}}
 {{- $schemaTable := .Table.Name | .SchemaTable}}
 {{if .AddGlobal -}}
@@ -30,7 +31,9 @@ func (o *{{$alias.UpSingular}}) UpsertP({{if .NoContext}}exec boil.Executor{{els
 {{end -}}
 
 // Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// When conflictColumns is empty the primary key is used as the conflict target.
 // See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+// Inferred update columns leave out the primary key and the created at column, greylist them to update them anyway.
 func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
 	if o == nil {
 		return errors.New("{{.PkgName}}: no {{.Table.Name}} provided for upsert")
@@ -87,9 +90,10 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 			{{$alias.DownSingular}}ColumnsWithoutDefault,
 			nzDefaults,
 		)
-		update := updateColumns.UpdateColumnSet(
+		update := updateColumns.UpsertUpdateColumnSet(
 			{{$alias.DownSingular}}AllColumns,
 			{{$alias.DownSingular}}PrimaryKeyColumns,
+			{{$alias.DownSingular}}ImmutableColumns,
 		)
 
 		if updateOnConflict && len(update) == 0 {
@@ -167,3 +171,4 @@ func (o *{{$alias.UpSingular}}) Upsert({{if .NoContext}}exec boil.Executor{{else
 	return nil
 	{{- end}}
 }
+{{end -}}{{/* if not IsView */}}
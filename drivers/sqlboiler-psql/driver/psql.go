@@ -90,6 +90,11 @@ func (p *PostgresDriver) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo
 		}
 	}()
 
+	version, err := p.serverVersion()
+	if err != nil {
+		return nil, err
+	}
+
 	dbinfo = &drivers.DBInfo{
 		Schema: schema,
 		Dialect: drivers.Dialect{
@@ -99,6 +104,13 @@ func (p *PostgresDriver) Assemble(config drivers.Config) (dbinfo *drivers.DBInfo
 			UseIndexPlaceholders: true,
 			UseSchema:            useSchema,
 			UseDefaultKeyword:    true,
+			UseNullsOrdering:     true,
+			UseBoundLimitOffset:  true,
+			UseReturningClause:   true,
+			UseOnConflictClause:  true,
+
+			// MERGE INTO was added in postgres 15
+			UseMergeClause: version >= 150000,
 		},
 	}
 	dbinfo.Tables, err = drivers.Tables(p, schema, whitelist, blacklist)
@@ -134,18 +146,41 @@ func PSQLBuildQueryString(user, pass, dbname, host string, port int, sslmode str
 	return strings.Join(parts, " ")
 }
 
+// serverVersion returns the server_version_num of the connected database,
+// for example 90624 for 9.6.24 or 150002 for 15.2
+func (p *PostgresDriver) serverVersion() (int, error) {
+	var version int
+	if err := p.conn.QueryRow("show server_version_num").Scan(&version); err != nil {
+		return 0, errors.Wrap(err, "sqlboiler-psql failed to get server version")
+	}
+
+	return version, nil
+}
+
 // TableNames connects to the postgres database and
 // retrieves all table names from the information_schema where the
 // table schema is schema. It uses a whitelist and blacklist.
 func (p *PostgresDriver) TableNames(schema string, whitelist, blacklist []string) ([]string, error) {
+	return p.tableNames(schema, "BASE TABLE", whitelist, blacklist)
+}
+
+// ViewNames retrieves all view names from the information_schema where the
+// table schema is schema. It uses a whitelist and blacklist.
+func (p *PostgresDriver) ViewNames(schema string, whitelist, blacklist []string) ([]string, error) {
+	return p.tableNames(schema, "VIEW", whitelist, blacklist)
+}
+
+// tableNames retrieves the names of the relations of tableType, as found in
+// information_schema.tables, in schema.
+func (p *PostgresDriver) tableNames(schema, tableType string, whitelist, blacklist []string) ([]string, error) {
 	var names []string
 
-	query := fmt.Sprintf(`select table_name from information_schema.tables where table_schema = $1 and table_type = 'BASE TABLE'`)
-	args := []interface{}{schema}
+	query := `select table_name from information_schema.tables where table_schema = $1 and table_type = $2`
+	args := []interface{}{schema, tableType}
 	if len(whitelist) > 0 {
 		tables := drivers.TablesFromList(whitelist)
 		if len(tables) > 0 {
-			query += fmt.Sprintf(" and table_name in (%s)", strmangle.Placeholders(true, len(tables), 2, 1))
+			query += fmt.Sprintf(" and table_name in (%s)", strmangle.Placeholders(true, len(tables), 3, 1))
 			for _, w := range tables {
 				args = append(args, w)
 			}
@@ -153,7 +188,7 @@ func (p *PostgresDriver) TableNames(schema string, whitelist, blacklist []string
 	} else if len(blacklist) > 0 {
 		tables := drivers.TablesFromList(blacklist)
 		if len(tables) > 0 {
-			query += fmt.Sprintf(" and table_name not in (%s)", strmangle.Placeholders(true, len(tables), 2, 1))
+			query += fmt.Sprintf(" and table_name not in (%s)", strmangle.Placeholders(true, len(tables), 3, 1))
 			for _, b := range tables {
 				args = append(args, b)
 			}
@@ -423,6 +458,69 @@ func (p *PostgresDriver) ForeignKeyInfo(schema, tableName string) ([]drivers.For
 	return fkeys, nil
 }
 
+// CheckConstraintInfo retrieves the check constraints for a given table name.
+func (p *PostgresDriver) CheckConstraintInfo(schema, tableName string) ([]drivers.CheckConstraint, error) {
+	var checks []drivers.CheckConstraint
+
+	query := `
+	select
+		pgcon.conname,
+		pg_get_constraintdef(pgcon.oid),
+		array_to_string(array(
+			select pga.attname
+			from unnest(pgcon.conkey) with ordinality as k(attnum, n)
+				inner join pg_attribute pga on pga.attrelid = pgcon.conrelid and pga.attnum = k.attnum
+			order by k.n
+		), ',')
+	from pg_namespace pgn
+		inner join pg_class pgc on pgn.oid = pgc.relnamespace
+		inner join pg_constraint pgcon on pgc.oid = pgcon.conrelid
+	where pgn.nspname = $2 and pgc.relname = $1 and pgcon.contype = 'c'
+	order by pgcon.conname`
+
+	var rows *sql.Rows
+	var err error
+	if rows, err = p.conn.Query(query, tableName, schema); err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var check drivers.CheckConstraint
+		var def, columns string
+
+		if err = rows.Scan(&check.Name, &def, &columns); err != nil {
+			return nil, err
+		}
+
+		check.Expression = checkExpression(def)
+		if len(columns) != 0 {
+			check.Columns = strings.Split(columns, ",")
+		}
+
+		checks = append(checks, check)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return checks, nil
+}
+
+// checkExpression extracts the expression from a check constraint
+// definition as returned by pg_get_constraintdef, ex:
+// CHECK ((age >= 0)) NOT VALID becomes (age >= 0).
+func checkExpression(def string) string {
+	def = strings.TrimSuffix(def, " NOT VALID")
+	def = strings.TrimSuffix(def, " NO INHERIT")
+	if strings.HasPrefix(def, "CHECK (") && strings.HasSuffix(def, ")") {
+		return def[len("CHECK (") : len(def)-1]
+	}
+
+	return def
+}
+
 // TranslateColumnType converts postgres database types to Go types, for example
 // "varchar" to "string" and "bigint" to "int64". It returns this parsed data
 // as a Column object.
@@ -582,11 +680,11 @@ func getArrayType(c drivers.Column) (string, string) {
 		}
 	} else {
 		switch c.UDTName {
-		case "_int4", "_int8":
+		case "_int2", "_int4", "_int8":
 			return "types.Int64Array", c.UDTName
 		case "_bytea":
 			return "types.BytesArray", c.UDTName
-		case "_bit", "_interval", "_varbit", "_char", "_money", "_varchar", "_cidr", "_inet", "_macaddr", "_citext", "_text", "_uuid", "_xml":
+		case "_bit", "_interval", "_varbit", "_char", "_bpchar", "_money", "_varchar", "_cidr", "_inet", "_macaddr", "_citext", "_text", "_uuid", "_xml":
 			return "types.StringArray", c.UDTName
 		case "_bool":
 			return "types.BoolArray", c.UDTName
@@ -88,3 +88,57 @@ func TestAssemble(t *testing.T) {
 		t.Errorf("want:\n%s\ngot:\n%s\n", want, got)
 	}
 }
+
+func TestTranslateColumnTypeArrays(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+
+	tests := []struct {
+		Column drivers.Column
+		Type   string
+		DBType string
+	}{
+		{drivers.Column{DBType: "ARRAY", ArrType: str("integer"), UDTName: "_int4"}, "types.Int64Array", "ARRAYinteger"},
+		{drivers.Column{DBType: "ARRAY", ArrType: str("text"), UDTName: "_text", Nullable: true}, "types.StringArray", "ARRAYtext"},
+		{drivers.Column{DBType: "ARRAY", ArrType: str("boolean"), UDTName: "_bool"}, "types.BoolArray", "ARRAYboolean"},
+		{drivers.Column{DBType: "ARRAY", ArrType: str("numeric"), UDTName: "_numeric"}, "types.DecimalArray", "ARRAYnumeric"},
+		// Domains over arrays only have the udt name
+		{drivers.Column{DBType: "ARRAY", UDTName: "_int2"}, "types.Int64Array", "ARRAY_int2"},
+		{drivers.Column{DBType: "ARRAY", UDTName: "_bpchar"}, "types.StringArray", "ARRAY_bpchar"},
+		// Unknown element types fall back to strings
+		{drivers.Column{DBType: "ARRAY", ArrType: str("tsvector"), UDTName: "_tsvector"}, "types.StringArray", "ARRAYtsvector"},
+	}
+
+	p := &PostgresDriver{}
+	for i, test := range tests {
+		c := p.TranslateColumnType(test.Column)
+		if c.Type != test.Type {
+			t.Errorf("%d) want type %s, got %s", i, test.Type, c.Type)
+		}
+		if c.DBType != test.DBType {
+			t.Errorf("%d) want db type %s, got %s", i, test.DBType, c.DBType)
+		}
+	}
+}
+
+func TestCheckExpression(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Def  string
+		Want string
+	}{
+		{"CHECK ((age >= 0))", "(age >= 0)"},
+		{"CHECK (((age >= 0) AND (age < 150)))", "((age >= 0) AND (age < 150))"},
+		{"CHECK ((age >= 0)) NOT VALID", "(age >= 0)"},
+		{"CHECK (false) NO INHERIT", "false"},
+		{"UNIQUE (age)", "UNIQUE (age)"},
+	}
+
+	for i, test := range tests {
+		if got := checkExpression(test.Def); got != test.Want {
+			t.Errorf("%d) want %q, got %q", i, test.Want, got)
+		}
+	}
+}
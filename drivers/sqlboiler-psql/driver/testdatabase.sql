@@ -1,4 +1,5 @@
 -- Don't forget to maintain order here, foreign keys!
+drop view if exists user_videos;
 drop table if exists video_tags;
 drop table if exists tags;
 drop table if exists videos;
@@ -12,10 +13,14 @@ create type workday as enum('monday', 'tuesday', 'wednesday', 'thursday', 'frida
 drop domain if exists uint3;
 create domain uint3 as numeric check(value >= 0 and value < power(2::numeric, 3::numeric));
 
+drop domain if exists positive_int;
+create domain positive_int as integer check(value > 0);
+
 create table users (
 	id serial primary key not null,
 	email_validated  bool null default false,
-	primary_email    varchar(100) unique null
+	primary_email    varchar(100) unique null,
+	age              int null constraint users_age_check check (age >= 0)
 );
 
 comment on column users.email_validated is 'Has the email address been tested?';
@@ -241,5 +246,10 @@ create table type_monsters (
 	customarr_null   my_int_array null,
 	customarr_nnull  my_int_array not null,
 
-	domainuint3_nnull uint3 not null
+	domainuint3_nnull uint3 not null,
+
+	domainpositiveint_null  positive_int null,
+	domainpositiveint_nnull positive_int not null
 );
+
+create view user_videos as select id, user_id from videos;
@@ -10,10 +10,13 @@ type Table struct {
 	SchemaName string   `json:"schema_name"`
 	Columns    []Column `json:"columns"`
 
-	PKey  *PrimaryKey  `json:"p_key"`
-	FKeys []ForeignKey `json:"f_keys"`
+	PKey   *PrimaryKey       `json:"p_key"`
+	FKeys  []ForeignKey      `json:"f_keys"`
+	Checks []CheckConstraint `json:"checks"`
 
 	IsJoinTable bool `json:"is_join_table"`
+	// IsView is true for views, they have no keys and are read-only.
+	IsView bool `json:"is_view"`
 
 	ToOneRelationships  []ToOneRelationship  `json:"to_one_relationships"`
 	ToManyRelationships []ToManyRelationship `json:"to_many_relationships"`
@@ -41,6 +44,21 @@ func (t Table) GetColumn(name string) (col Column) {
 	panic(fmt.Sprintf("could not find column name: %s", name))
 }
 
+// ColumnChecks returns the check constraints that refer to the column.
+func (t Table) ColumnChecks(name string) []CheckConstraint {
+	var checks []CheckConstraint
+	for _, check := range t.Checks {
+		for _, c := range check.Columns {
+			if c == name {
+				checks = append(checks, check)
+				break
+			}
+		}
+	}
+
+	return checks
+}
+
 // CanLastInsertID checks the following:
 // 1. Is there only one primary key?
 // 2. Does the primary key column have a default value?
@@ -65,9 +83,22 @@ func (t Table) CanLastInsertID() bool {
 	return true
 }
 
+// CanSoftDelete checks if the table has a nullable time column named
+// deleted_at that can be used to mark rows as deleted.
 func (t Table) CanSoftDelete() bool {
+	return t.CanSoftDeleteColumn("deleted_at")
+}
+
+// CanSoftDeleteColumn checks if the table has a nullable time column named
+// deleteColumn that can be used to mark rows as deleted. An empty
+// deleteColumn means "deleted_at".
+func (t Table) CanSoftDeleteColumn(deleteColumn string) bool {
+	if len(deleteColumn) == 0 {
+		deleteColumn = "deleted_at"
+	}
+
 	for _, column := range t.Columns {
-		if column.Name == "deleted_at" && column.Type == "null.Time" {
+		if column.Name == deleteColumn && (column.Type == "null.Time" || column.Type == "*time.Time") {
 			return true
 		}
 	}
@@ -66,6 +66,28 @@ func TestGetColumnMissing(t *testing.T) {
 	table.GetColumn("missing")
 }
 
+func TestColumnChecks(t *testing.T) {
+	t.Parallel()
+
+	table := Table{
+		Checks: []CheckConstraint{
+			{Name: "age_check", Columns: []string{"age"}, Expression: "(age >= 0)"},
+			{Name: "range_check", Columns: []string{"age", "limit"}, Expression: "(age <= limit)"},
+			{Name: "table_check", Expression: "(true)"},
+		},
+	}
+
+	if checks := table.ColumnChecks("age"); len(checks) != 2 || checks[0].Name != "age_check" || checks[1].Name != "range_check" {
+		t.Errorf("wrong checks for age: %#v", checks)
+	}
+	if checks := table.ColumnChecks("limit"); len(checks) != 1 || checks[0].Name != "range_check" {
+		t.Errorf("wrong checks for limit: %#v", checks)
+	}
+	if checks := table.ColumnChecks("name"); len(checks) != 0 {
+		t.Errorf("want no checks for name: %#v", checks)
+	}
+}
+
 func TestCanLastInsertID(t *testing.T) {
 	t.Parallel()
 
@@ -148,3 +170,39 @@ func TestCanSoftDelete(t *testing.T) {
 		}
 	}
 }
+
+func TestCanSoftDeleteColumn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Can     bool
+		Column  string
+		Columns []Column
+	}{
+		{true, "", []Column{
+			{Name: "deleted_at", Type: "null.Time"},
+		}},
+		{true, "", []Column{
+			{Name: "deleted_at", Type: "*time.Time"},
+		}},
+		{false, "", []Column{
+			{Name: "deleted_at", Type: "time.Time"},
+		}},
+		{true, "removed_at", []Column{
+			{Name: "removed_at", Type: "null.Time"},
+		}},
+		{false, "removed_at", []Column{
+			{Name: "deleted_at", Type: "null.Time"},
+		}},
+	}
+
+	for i, test := range tests {
+		table := Table{
+			Columns: test.Columns,
+		}
+
+		if got := table.CanSoftDeleteColumn(test.Column); got != test.Can {
+			t.Errorf("%d) wrong: %t", i, got)
+		}
+	}
+}
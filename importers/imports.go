@@ -269,9 +269,11 @@ func NewDefaultImports() Collection {
 				`"io"`,
 				`"io/ioutil"`,
 				`"math/rand"`,
+				`"reflect"`,
 				`"regexp"`,
 			},
 			ThirdParty: List{
+				`"github.com/volatiletech/randomize"`,
 				`"github.com/volatiletech/sqlboiler/v4/boil"`,
 			},
 		},
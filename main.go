@@ -22,7 +22,7 @@ const sqlBoilerVersion = "4.4.0"
 
 var (
 	flagConfigFile string
-	cmdState       *boilingcore.State
+	cmdStates      []*boilingcore.State
 	cmdConfig      *boilingcore.Config
 )
 
@@ -93,6 +93,7 @@ func main() {
 	rootCmd.PersistentFlags().StringP("output", "o", "models", "The name of the folder to output to")
 	rootCmd.PersistentFlags().StringP("pkgname", "p", "models", "The name you wish to assign to your generated package")
 	rootCmd.PersistentFlags().StringSliceP("templates", "", nil, "A templates directory, overrides the bindata'd template folders in sqlboiler")
+	rootCmd.PersistentFlags().StringSliceP("extra-templates", "", nil, "A templates directory, rendered in addition to the bindata'd or --templates folders")
 	rootCmd.PersistentFlags().StringSliceP("tag", "t", nil, "Struct tags to be included on your models in addition to json, yaml, toml")
 	rootCmd.PersistentFlags().StringSliceP("replace", "", nil, "Replace templates by directory: relpath/to_file.tpl:relpath/to_replacement.tpl")
 	rootCmd.PersistentFlags().BoolP("debug", "d", false, "Debug mode prints stack traces on error")
@@ -103,12 +104,22 @@ func main() {
 	rootCmd.PersistentFlags().BoolP("no-auto-timestamps", "", false, "Disable automatic timestamps for created_at/updated_at")
 	rootCmd.PersistentFlags().BoolP("no-driver-templates", "", false, "Disable parsing of templates defined by the database driver")
 	rootCmd.PersistentFlags().BoolP("no-back-referencing", "", false, "Disable back referencing in the loaded relationship structs")
+	rootCmd.PersistentFlags().BoolP("nullable-pointers", "", false, "Generate nullable columns as pointers like *string instead of null.String")
 	rootCmd.PersistentFlags().BoolP("add-global-variants", "", false, "Enable generation for global variants")
 	rootCmd.PersistentFlags().BoolP("add-panic-variants", "", false, "Enable generation for panic variants")
 	rootCmd.PersistentFlags().BoolP("add-soft-deletes", "", false, "Enable soft deletion by updating deleted_at timestamp")
+	rootCmd.PersistentFlags().BoolP("add-enum-types", "", false, "Enable generation of types for enums")
+	rootCmd.PersistentFlags().BoolP("add-validation", "", false, "Enable generation of Validate methods checking required columns and string lengths")
+	rootCmd.PersistentFlags().BoolP("add-interfaces", "", false, "Enable generation of repository interfaces for mocking the models in tests")
 	rootCmd.PersistentFlags().BoolP("version", "", false, "Print the version")
 	rootCmd.PersistentFlags().BoolP("wipe", "", false, "Delete the output folder (rm -rf) before generation to ensure sanity")
-	rootCmd.PersistentFlags().StringP("struct-tag-casing", "", "snake", "Decides the casing for go structure tag names. camel, title or snake (default snake)")
+	rootCmd.PersistentFlags().BoolP("clean", "", false, "Delete previously generated files that were not generated again, like those of dropped tables")
+	rootCmd.PersistentFlags().BoolP("dry-run", "", false, "Print the files that would be created, overwritten or removed without writing them")
+	rootCmd.PersistentFlags().StringP("struct-tag-casing", "", "snake", "Decides the casing for go structure tag names. camel, title, alias or snake")
+	rootCmd.PersistentFlags().StringP("output-layout", "", "table", "Decides how the models are split into files. table for a file per table or single for one file")
+	rootCmd.PersistentFlags().StringP("output-file", "", "", "Template for the names of the model files, ex: {{.Table}}_model")
+	rootCmd.PersistentFlags().StringP("header", "", "", "Template of // comment lines written at the top of the generated files, like license text")
+	rootCmd.PersistentFlags().StringP("build-tags", "", "", "Build constraint expression added to the generated files, ex: !nomodels")
 	rootCmd.PersistentFlags().StringP("relation-tag", "r", "-", "Relationship struct tag name")
 	rootCmd.PersistentFlags().StringSliceP("tag-ignore", "", nil, "List of column names that should have tags values set to '-' (ignored during parsing)")
 
@@ -173,6 +184,9 @@ func preRun(cmd *cobra.Command, args []string) error {
 		AddGlobal:         viper.GetBool("add-global-variants"),
 		AddPanic:          viper.GetBool("add-panic-variants"),
 		AddSoftDeletes:    viper.GetBool("add-soft-deletes"),
+		AddEnumTypes:      viper.GetBool("add-enum-types"),
+		AddValidation:     viper.GetBool("add-validation"),
+		AddInterfaces:     viper.GetBool("add-interfaces"),
 		NoContext:         viper.GetBool("no-context"),
 		NoTests:           viper.GetBool("no-tests"),
 		NoHooks:           viper.GetBool("no-hooks"),
@@ -180,16 +194,42 @@ func preRun(cmd *cobra.Command, args []string) error {
 		NoAutoTimestamps:  viper.GetBool("no-auto-timestamps"),
 		NoDriverTemplates: viper.GetBool("no-driver-templates"),
 		NoBackReferencing: viper.GetBool("no-back-referencing"),
+		NullablePointers:  viper.GetBool("nullable-pointers"),
 		Wipe:              viper.GetBool("wipe"),
+		Clean:             viper.GetBool("clean"),
+		DryRun:            viper.GetBool("dry-run"),
+		OutputLayout:      viper.GetString("output-layout"),
+		OutputFile:        viper.GetString("output-file"),
+		Header:            viper.GetString("header"),
+		BuildTags:         viper.GetString("build-tags"),
 		StructTagCasing:   strings.ToLower(viper.GetString("struct-tag-casing")), // camel | snake | title
 		TagIgnore:         viper.GetStringSlice("tag-ignore"),
+		TagCases:          viper.GetStringMapString("struct-tag-cases"),
 		RelationTag:       viper.GetString("relation-tag"),
 		TemplateDirs:      viper.GetStringSlice("templates"),
+		ExtraTemplateDirs: viper.GetStringSlice("extra-templates"),
 		Tags:              viper.GetStringSlice("tag"),
 		Replacements:      viper.GetStringSlice("replace"),
 		Aliases:           boilingcore.ConvertAliases(viper.Get("aliases")),
 		TypeReplaces:      boilingcore.ConvertTypeReplace(viper.Get("types")),
+		OptimisticLock:    viper.GetStringMapString("optimistic-lock"),
+		PrimaryKeys:       viper.GetStringMapStringSlice("primary-keys"),
+		JSONTypes:         boilingcore.ConvertJSONTypes(viper.Get("json-types")),
+		ResultTypes:       boilingcore.ConvertResultTypes(viper.Get("result-types")),
+		Relationships:     boilingcore.ConvertRelationships(viper.Get("relationships")),
+		Polymorphic:       boilingcore.ConvertPolymorphic(viper.Get("polymorphic")),
+		Schemas:           viper.GetStringMapString("schemas"),
 		Version:           sqlBoilerVersion,
+		AutoColumns: boilingcore.AutoColumns{
+			Created: viper.GetString("auto-columns.created"),
+			Updated: viper.GetString("auto-columns.updated"),
+			Deleted: viper.GetString("auto-columns.deleted"),
+		},
+		Inflections: boilingcore.Inflections{
+			Irregular:   viper.GetStringMapString("inflections.irregular"),
+			Uncountable: viper.GetStringSlice("inflections.uncountable"),
+			Initialisms: viper.GetStringSlice("inflections.initialisms"),
+		},
 	}
 
 	if cmdConfig.Debug {
@@ -212,8 +252,15 @@ func preRun(cmd *cobra.Command, args []string) error {
 
 	cmdConfig.Imports = configureImports()
 
-	cmdState, err = boilingcore.New(cmdConfig)
-	return err
+	for _, config := range cmdConfig.SchemaConfigs() {
+		state, err := boilingcore.New(config)
+		if err != nil {
+			return err
+		}
+		cmdStates = append(cmdStates, state)
+	}
+
+	return nil
 }
 
 func configureImports() importers.Collection {
@@ -253,11 +300,23 @@ func configureImports() importers.Collection {
 }
 
 func run(cmd *cobra.Command, args []string) error {
-	return cmdState.Run()
+	for _, state := range cmdStates {
+		if err := state.Run(); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func postRun(cmd *cobra.Command, args []string) error {
-	return cmdState.Cleanup()
+	for _, state := range cmdStates {
+		if err := state.Cleanup(); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func allKeys(prefix string) []string {
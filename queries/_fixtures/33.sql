@@ -0,0 +1 @@
+MERGE INTO "t" USING (SELECT $1 AS id, $2 AS name) AS src ON t.id = src.id WHEN MATCHED THEN UPDATE SET name = src.name, updated_at = $3 WHEN NOT MATCHED THEN INSERT (id, name) VALUES (src.id, src.name);
\ No newline at end of file
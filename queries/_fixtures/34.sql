@@ -0,0 +1 @@
+DELETE FROM "t" WHERE (a < $1) RETURNING *;
\ No newline at end of file
@@ -0,0 +1 @@
+UPDATE "t" SET "a" = $1 RETURNING "id", "t"."a";
\ No newline at end of file
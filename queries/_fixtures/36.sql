@@ -0,0 +1 @@
+SELECT * FROM "t" WHERE (a=$1) AND ("b" IN ($2,$3,$4) OR "b" IN ($5,$6)) AND ((c,d) NOT IN (($7,$8)) AND (c,d) NOT IN (($9,$10)) AND (c,d) NOT IN (($11,$12))) AND ("e" IN ($13,$14));
\ No newline at end of file
@@ -0,0 +1 @@
+UPDATE "t" SET "a" = $1, "b" = greatest(b, $2, $3), "c" = $4, "views" = views + $5 WHERE (id=$6);
\ No newline at end of file
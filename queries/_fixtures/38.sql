@@ -0,0 +1 @@
+UPDATE "t" SET "a" = $1, "b" = DEFAULT, "c" = $2;
\ No newline at end of file
@@ -0,0 +1 @@
+INSERT INTO "t" ("a", "b", "c") VALUES ($1,DEFAULT,$2),(DEFAULT,$3,$4) RETURNING "id";
\ No newline at end of file
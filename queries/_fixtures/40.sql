@@ -0,0 +1 @@
+SELECT * FROM "t" WHERE a=$1 AND (b=$2 OR c=$3);
\ No newline at end of file
@@ -0,0 +1 @@
+SELECT * FROM "t" WHERE (a=$1 OR b=$2) AND (c=$3 OR d=$4);
\ No newline at end of file
@@ -0,0 +1 @@
+SELECT * FROM "t" WHERE a=$1 AND (b=$2 OR (c=$3 AND "d" IN ($4,$5))) OR (e=$6);
\ No newline at end of file
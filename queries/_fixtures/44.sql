@@ -0,0 +1 @@
+SELECT * FROM "t" ORDER BY a ASC NULLS LAST, b DESC NULLS FIRST, c;
\ No newline at end of file
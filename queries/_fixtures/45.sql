@@ -0,0 +1 @@
+SELECT * FROM `t` ORDER BY a IS NULL, a ASC, b IS NOT NULL, b DESC, lower(c) IS NULL, lower(c), d;
\ No newline at end of file
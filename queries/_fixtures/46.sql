@@ -0,0 +1 @@
+SELECT * FROM "t" ORDER BY RANDOM() LIMIT 10;
\ No newline at end of file
@@ -0,0 +1 @@
+SELECT * FROM `t` ORDER BY RAND() LIMIT 10;
\ No newline at end of file
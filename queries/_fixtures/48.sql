@@ -0,0 +1 @@
+SELECT * FROM [t] ORDER BY a, NEWID() OFFSET 5 ROWS FETCH NEXT 10 ROWS ONLY;
\ No newline at end of file
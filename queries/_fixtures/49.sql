@@ -0,0 +1 @@
+INSERT INTO "users" ("email", "name") VALUES ($1,$2) ON CONFLICT ("email") WHERE deleted_at IS NULL AND tenant_id = $3 DO UPDATE SET "logins" = users.logins + $4, "name" = $5 RETURNING "id";
\ No newline at end of file
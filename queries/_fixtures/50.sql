@@ -0,0 +1 @@
+INSERT INTO "users" ("email") VALUES ($1) ON CONFLICT ("email") DO NOTHING;
\ No newline at end of file
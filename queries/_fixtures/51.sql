@@ -0,0 +1 @@
+SELECT * FROM "posts" WHERE (published=$1) AND (to_tsvector(coalesce("title", '') || ' ' || coalesce("body", '')) @@ plainto_tsquery($2));
\ No newline at end of file
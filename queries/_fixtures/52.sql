@@ -0,0 +1 @@
+SELECT * FROM "posts" WHERE (to_tsvector("title") @@ plainto_tsquery($1));
\ No newline at end of file
@@ -0,0 +1 @@
+SELECT * FROM `posts` WHERE (published=?) AND (MATCH(`title`, `body`) AGAINST(?));
\ No newline at end of file
@@ -0,0 +1 @@
+SELECT * FROM [posts] WHERE (FREETEXT(([title], [body]), $1));
\ No newline at end of file
@@ -0,0 +1 @@
+SELECT EXISTS(SELECT * FROM "t" WHERE (a=$1));
\ No newline at end of file
@@ -0,0 +1 @@
+SELECT CASE WHEN EXISTS(SELECT * FROM [t] WHERE (a=$1)) THEN 1 ELSE 0 END;
\ No newline at end of file
@@ -0,0 +1 @@
+WITH cte AS (SELECT 1) SELECT EXISTS(SELECT "t".* FROM "t" INNER JOIN dogs d on d.cat_id = t.id);
\ No newline at end of file
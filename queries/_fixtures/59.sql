@@ -0,0 +1 @@
+SELECT "t".* FROM "t" CROSS JOIN generate_series(1, $1) s WHERE (a=$2);
\ No newline at end of file
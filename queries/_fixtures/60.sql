@@ -0,0 +1 @@
+WITH cte AS (SELECT * FROM x WHERE b=$1) DELETE FROM "t" WHERE (a=$2);
\ No newline at end of file
@@ -0,0 +1 @@
+SELECT * FROM "t" WHERE (a=$1) LIMIT 5 OFFSET 6;
\ No newline at end of file
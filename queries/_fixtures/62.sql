@@ -0,0 +1 @@
+SELECT * FROM "t" WHERE (a=$1) LIMIT $2 OFFSET $3;
\ No newline at end of file
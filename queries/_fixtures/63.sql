@@ -0,0 +1 @@
+SELECT * FROM `t` WHERE (a=?) LIMIT ?;
\ No newline at end of file
@@ -0,0 +1 @@
+SELECT * FROM "t" LIMIT 5 OFFSET 6;
\ No newline at end of file
@@ -0,0 +1 @@
+SELECT * FROM [t] ORDER BY (SELECT NULL) OFFSET 6 ROWS FETCH NEXT 5 ROWS ONLY;
\ No newline at end of file
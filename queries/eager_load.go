@@ -67,6 +67,25 @@ func eagerLoad(ctx context.Context, exec boil.Executor, toLoad []string, mods ma
 	return nil
 }
 
+// allRelationships returns the names of the relationships that the L struct
+// of typ has load methods for, in alphabetical order
+func allRelationships(typ reflect.Type) []string {
+	ln, found := typ.FieldByName(loaderStructName)
+	if !found {
+		return nil
+	}
+
+	var rels []string
+	for i := 0; i < ln.Type.NumMethod(); i++ {
+		name := ln.Type.Method(i).Name
+		if strings.HasPrefix(name, loadMethodPrefix) && len(name) > len(loadMethodPrefix) {
+			rels = append(rels, name[len(loadMethodPrefix):])
+		}
+	}
+
+	return rels
+}
+
 // loadRelationships dynamically calls the template generated eager load
 // functions of the form:
 //
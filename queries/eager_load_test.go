@@ -281,6 +281,87 @@ func TestEagerLoadFromMany(t *testing.T) {
 	checkNestedMany(slice[1].R.ChildMany[1].R.NestedMany)
 }
 
+func TestEagerLoadAll(t *testing.T) {
+	testEagerCounters.ChildOne = 0
+	testEagerCounters.ChildMany = 0
+	testEagerCounters.NestedOne = 0
+	testEagerCounters.NestedMany = 0
+
+	want := []string{"ChildMany", "ChildOne", "ZeroMany", "ZeroOne"}
+	if got := allRelationships(reflect.TypeOf(testEager{})); !reflect.DeepEqual(got, want) {
+		t.Fatalf("want relationships %v, got: %v", want, got)
+	}
+	if got := allRelationships(reflect.TypeOf(struct{ ID int }{})); len(got) != 0 {
+		t.Errorf("want no relationships without an L struct, got: %v", got)
+	}
+
+	slice := []*testEager{
+		{ID: -1},
+		{ID: -2},
+	}
+
+	toLoad := append(allRelationships(reflect.TypeOf(testEager{})), "ChildOne.NestedOne")
+	err := eagerLoad(nil, nil, toLoad, nil, &slice, kindPtrSliceStruct)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Every relationship is loaded once for the whole slice, and the ones
+	// loaded already aren't loaded again on the way to nested ones
+	if testEagerCounters.ChildMany != 1 {
+		t.Error(testEagerCounters.ChildMany)
+	}
+	if testEagerCounters.ChildOne != 1 {
+		t.Error(testEagerCounters.ChildOne)
+	}
+	if testEagerCounters.NestedOne != 1 {
+		t.Error(testEagerCounters.NestedOne)
+	}
+	if testEagerCounters.NestedMany != 0 {
+		t.Error(testEagerCounters.NestedMany)
+	}
+
+	checkChildOne(slice[0].R.ChildOne)
+	checkChildMany(slice[1].R.ChildMany)
+	checkNestedOne(slice[1].R.ChildOne.R.NestedOne)
+}
+
+func TestEagerLoadNestedPathOnly(t *testing.T) {
+	testEagerCounters.ChildOne = 0
+	testEagerCounters.ChildMany = 0
+	testEagerCounters.NestedOne = 0
+	testEagerCounters.NestedMany = 0
+
+	slice := []*testEager{
+		{ID: -1},
+		{ID: -2},
+		{ID: -3},
+	}
+
+	// The intermediate relationship is loaded implicitly, and each level is
+	// loaded once for all of the parents
+	err := eagerLoad(nil, nil, []string{"ChildMany.NestedMany"}, nil, &slice, kindPtrSliceStruct)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if testEagerCounters.ChildMany != 1 {
+		t.Error("want one load of ChildMany, got", testEagerCounters.ChildMany)
+	}
+	if testEagerCounters.NestedMany != 1 {
+		t.Error("want one load of NestedMany, got", testEagerCounters.NestedMany)
+	}
+	if testEagerCounters.ChildOne != 0 || testEagerCounters.NestedOne != 0 {
+		t.Error("should not have loaded unrequested relationships")
+	}
+
+	for _, o := range slice {
+		checkChildMany(o.R.ChildMany)
+		checkNestedMany(o.R.ChildMany[0].R.NestedMany)
+		checkNestedMany(o.R.ChildMany[1].R.NestedMany)
+	}
+}
+
 func TestEagerLoadZeroParents(t *testing.T) {
 	t.Parallel()
 
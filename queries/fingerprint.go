@@ -0,0 +1,46 @@
+package queries
+
+import (
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strings"
+)
+
+var (
+	rgxFingerprintString      = regexp.MustCompile(`'(?:[^']|'')*'`)
+	rgxFingerprintPlaceholder = regexp.MustCompile(`(?:\$|@p)\d+|\?`)
+	rgxFingerprintNumber      = regexp.MustCompile(`\b\d+(?:\.\d+)?\b`)
+	rgxFingerprintList        = regexp.MustCompile(`\(\s*\?(?:\s*,\s*\?)+\s*\)`)
+	rgxFingerprintGroupList   = regexp.MustCompile(`\(\s*\(\?\)(?:\s*,\s*\(\?\))+\s*\)`)
+	rgxFingerprintSpace       = regexp.MustCompile(`\s+`)
+)
+
+// Fingerprint returns a hash of the shape of the query, that is the query's
+// sql with all placeholders, literal strings and numbers replaced and lists
+// of values collapsed so that queries that only differ in their arguments,
+// the length of an IN list, or their limit and offset share a fingerprint.
+// This is useful as a key for caching prepared statements or aggregating
+// metrics per query.
+//
+// This builds the query if it hasn't been built yet.
+func Fingerprint(q *Query) string {
+	sql, _ := BuildQuery(q)
+	sum := fnv.New64a()
+	_, _ = sum.Write([]byte(normalizeQuery(sql)))
+	return fmt.Sprintf("%016x", sum.Sum64())
+}
+
+// normalizeQuery replaces all the literals and placeholders in the query
+// with a ? and collapses lists of them. The rules are simple and there
+// may be some edge cases where the result is not valid sql, but it's only
+// used to compare queries with each other.
+func normalizeQuery(sql string) string {
+	sql = rgxFingerprintString.ReplaceAllString(sql, "?")
+	sql = rgxFingerprintPlaceholder.ReplaceAllString(sql, "?")
+	sql = rgxFingerprintNumber.ReplaceAllString(sql, "?")
+	sql = rgxFingerprintList.ReplaceAllString(sql, "(?)")
+	sql = rgxFingerprintGroupList.ReplaceAllString(sql, "((?))")
+	sql = rgxFingerprintSpace.ReplaceAllString(sql, " ")
+	return strings.TrimSpace(sql)
+}
@@ -0,0 +1,59 @@
+package queries
+
+import (
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestFingerprint(t *testing.T) {
+	t.Parallel()
+
+	dialect := &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}
+
+	q1 := &Query{dialect: dialect, from: []string{"users"}, limit: 5}
+	AppendWhere(q1, "name = ?", "bob")
+	AppendIn(q1, "id in ?", 1, 2, 3)
+
+	q2 := &Query{dialect: dialect, from: []string{"users"}, limit: 10}
+	AppendWhere(q2, "name = ?", "alice")
+	AppendIn(q2, "id in ?", 4)
+
+	if f1, f2 := Fingerprint(q1), Fingerprint(q2); f1 != f2 {
+		t.Errorf("expected queries differing only in values to share a fingerprint: %s != %s", f1, f2)
+	}
+
+	q3 := &Query{dialect: dialect, from: []string{"users"}, limit: 5}
+	AppendWhere(q3, "email = ?", "bob")
+	AppendIn(q3, "id in ?", 1, 2, 3)
+
+	if f1, f3 := Fingerprint(q1), Fingerprint(q3); f1 == f3 {
+		t.Errorf("expected queries with different where clauses to have different fingerprints: %s", f1)
+	}
+
+	raw1 := Raw("select * from users where name = 'bob' and age > 30")
+	raw2 := Raw("select * from users where name = 'it''s' and age > 4")
+	if f1, f2 := Fingerprint(raw1), Fingerprint(raw2); f1 != f2 {
+		t.Errorf("expected raw queries differing only in literals to share a fingerprint: %s != %s", f1, f2)
+	}
+}
+
+func TestNormalizeQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In  string
+		Out string
+	}{
+		{`SELECT * FROM "t" WHERE (a = $1) LIMIT 5;`, `SELECT * FROM "t" WHERE (a = ?) LIMIT ?;`},
+		{`SELECT * FROM "t1" WHERE ("id" IN ($1,$2,$3));`, `SELECT * FROM "t1" WHERE ("id" IN (?));`},
+		{`SELECT * FROM t WHERE (a,b) IN ((?,?),(?,?))`, `SELECT * FROM t WHERE (a,b) IN ((?))`},
+		{"SELECT *\n  FROM t WHERE a = 'x y' AND b = 1.5", `SELECT * FROM t WHERE a = ? AND b = ?`},
+	}
+
+	for i, test := range tests {
+		if got := normalizeQuery(test.In); got != test.Out {
+			t.Errorf("%d) wrong normalized query:\nwant: %s\ngot:  %s", i, test.Out, got)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package queries
 
 import (
+	"database/sql/driver"
 	"fmt"
 	"reflect"
 )
@@ -41,3 +42,28 @@ func NonZeroDefaultSet(defaults []string, obj interface{}) []string {
 
 	return c
 }
+
+// inArgs expands a lone slice argument of an IN clause into its elements so
+// that both WhereIn("id in ?", ids...) and WhereIn("id in ?", ids) work.
+// Byte slices and driver.Valuers are single values and are left alone.
+func inArgs(args []interface{}) []interface{} {
+	if len(args) != 1 {
+		return args
+	}
+
+	switch args[0].(type) {
+	case []byte, driver.Valuer:
+		return args
+	}
+
+	val := reflect.ValueOf(args[0])
+	if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
+		return args
+	}
+
+	expanded := make([]interface{}, val.Len())
+	for i := range expanded {
+		expanded[i] = val.Index(i).Interface()
+	}
+	return expanded
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/types"
 )
 
 type testObj struct {
@@ -65,3 +66,27 @@ func TestNonZeroDefaultSet(t *testing.T) {
 		}
 	}
 }
+
+func TestInArgs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Args []interface{}
+		Want []interface{}
+	}{
+		{nil, nil},
+		{[]interface{}{1, 2}, []interface{}{1, 2}},
+		{[]interface{}{[]int{1, 2}}, []interface{}{1, 2}},
+		{[]interface{}{[2]string{"a", "b"}}, []interface{}{"a", "b"}},
+		{[]interface{}{[]int{}}, []interface{}{}},
+		{[]interface{}{[]byte("a")}, []interface{}{[]byte("a")}},
+		{[]interface{}{types.Int64Array{1, 2}}, []interface{}{types.Int64Array{1, 2}}},
+		{[]interface{}{5}, []interface{}{5}},
+	}
+
+	for i, test := range tests {
+		if got := inArgs(test.Args); !reflect.DeepEqual(got, test.Want) {
+			t.Errorf("%d) want: %#v, got: %#v", i, test.Want, got)
+		}
+	}
+}
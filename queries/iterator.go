@@ -0,0 +1,148 @@
+package queries
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// Iterator binds the rows of a query one at a time so that large results
+// can be processed without holding them all in memory. The rows are closed
+// when Next returns false, if you stop iterating before that call Close.
+//
+//   it, err := queries.Raw("select * from pilots").Iterate(ctx, db, &models.Pilot{})
+//   if err != nil {
+//     return err
+//   }
+//   defer it.Close()
+//
+//   for it.Next() {
+//     pilot := it.Value().(*models.Pilot)
+//   }
+//   if err := it.Err(); err != nil {
+//     return err
+//   }
+type Iterator struct {
+	rows       *sql.Rows
+	structType reflect.Type
+	mapping    []uint64
+	jsonFields []bool
+	cols       []string
+
+	value  reflect.Value
+	err    error
+	closed bool
+}
+
+// Iterate executes the query and returns an Iterator that binds each row
+// to a new struct of the same type as obj, which must be a pointer to a
+// struct. obj itself is left untouched. Eager loading isn't supported.
+//
+// Also see documentation for Bind()
+func (q *Query) Iterate(ctx context.Context, exec boil.Executor, obj interface{}) (*Iterator, error) {
+	structType, _, bkind, err := bindChecks(obj)
+	if err != nil {
+		return nil, err
+	}
+	if bkind != kindStruct {
+		return nil, errors.Errorf("iterate needs a pointer to a struct, got: %T", obj)
+	}
+	if len(q.load) != 0 || q.loadAll {
+		return nil, errors.New("eager loading is not supported when iterating")
+	}
+
+	var rows *sql.Rows
+	if ctx != nil {
+		rows, err = q.QueryContext(ctx, exec.(boil.ContextExecutor))
+	} else {
+		rows, err = q.Query(exec)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "iterate failed to execute query")
+	}
+
+	cols, err := rows.Columns()
+	if err != nil {
+		_ = rows.Close()
+		return nil, errors.Wrap(err, "iterate failed to get column names")
+	}
+
+	mapping, err := getMappingCache(structType).mapping(cols)
+	if err != nil {
+		_ = rows.Close()
+		return nil, err
+	}
+
+	return &Iterator{
+		rows:       rows,
+		structType: structType,
+		mapping:    mapping,
+		jsonFields: jsonFieldsFromMapping(structType, mapping),
+		cols:       cols,
+	}, nil
+}
+
+// Next binds the next row, it returns false when there are no more rows
+// or an error happened, check Err to tell them apart. The rows are closed
+// once it returns false.
+func (it *Iterator) Next() bool {
+	if it.closed {
+		return false
+	}
+
+	if !it.rows.Next() {
+		if err := it.rows.Err(); err != nil {
+			it.err = errors.Wrap(err, "error from rows in iterate")
+		}
+		it.close()
+		return false
+	}
+
+	value := reflect.New(it.structType)
+	ptrs := PtrsFromMapping(reflect.Indirect(value), it.mapping)
+	wrapJSONFields(ptrs, it.jsonFields, it.cols)
+	if err := it.rows.Scan(ptrs...); err != nil {
+		it.err = errors.Wrap(err, "failed to bind pointers to obj")
+		it.close()
+		return false
+	}
+
+	it.value = value
+	return true
+}
+
+// Value returns a pointer to the struct bound by the last call to Next,
+// each call to Next binds a new struct so it can be kept around.
+func (it *Iterator) Value() interface{} {
+	if !it.value.IsValid() {
+		return nil
+	}
+	return it.value.Interface()
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Close closes the rows, it's safe to call more than once and after Next
+// returned false.
+func (it *Iterator) Close() error {
+	if it.closed {
+		return nil
+	}
+
+	it.close()
+	return it.err
+}
+
+// close closes the rows and keeps the first error
+func (it *Iterator) close() {
+	it.closed = true
+	if err := it.rows.Close(); err != nil && it.err == nil {
+		it.err = errors.Wrap(err, "failed to clean up rows in iterate")
+	}
+}
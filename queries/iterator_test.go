@@ -0,0 +1,145 @@
+package queries
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+)
+
+type iteratorPilot struct {
+	ID   int64  `boil:"id"`
+	Name string `boil:"name"`
+}
+
+func iteratorResult() boiltest.Result {
+	return boiltest.Result{
+		Columns: []string{"id", "name"},
+		Rows: [][]interface{}{
+			{int64(1), "Ann"},
+			{int64(2), "Bob"},
+			{int64(3), "Cat"},
+		},
+	}
+}
+
+func TestIterate(t *testing.T) {
+	t.Parallel()
+
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+	exec.Expect(iteratorResult())
+
+	it, err := Raw("select * from pilots").Iterate(context.Background(), exec, &iteratorPilot{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []*iteratorPilot
+	for it.Next() {
+		got = append(got, it.Value().(*iteratorPilot))
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*iteratorPilot{{1, "Ann"}, {2, "Bob"}, {3, "Cat"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: %v, got: %v", want, got)
+	}
+
+	if it.Next() {
+		t.Error("want no more rows after the end")
+	}
+	if n := exec.Stats().InUse; n != 0 {
+		t.Errorf("want the rows closed at the end, %d connections in use", n)
+	}
+	if err := it.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIterateBreak(t *testing.T) {
+	t.Parallel()
+
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+	exec.Expect(iteratorResult())
+
+	it, err := Raw("select * from pilots").Iterate(nil, exec, &iteratorPilot{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for it.Next() {
+		if it.Value().(*iteratorPilot).ID == 2 {
+			break
+		}
+	}
+
+	if n := exec.Stats().InUse; n != 1 {
+		t.Errorf("want the rows open before Close, %d connections in use", n)
+	}
+	if err := it.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if n := exec.Stats().InUse; n != 0 {
+		t.Errorf("want the rows closed, %d connections in use", n)
+	}
+	if err := it.Close(); err != nil {
+		t.Error(err)
+	}
+	if it.Next() {
+		t.Error("want no rows after Close")
+	}
+}
+
+func TestIterateErrors(t *testing.T) {
+	t.Parallel()
+
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	failed := errors.New("failed")
+	exec.Expect(boiltest.Result{Err: failed})
+	if _, err := Raw("select * from pilots").Iterate(nil, exec, &iteratorPilot{}); errors.Cause(err) != failed {
+		t.Errorf("want the query error, got: %v", err)
+	}
+
+	exec.Expect(boiltest.Result{
+		Columns: []string{"id", "name"},
+		Rows:    [][]interface{}{{int64(1), "Ann"}, {"two", "Bob"}},
+	})
+	it, err := Raw("select * from pilots").Iterate(nil, exec, &iteratorPilot{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	for it.Next() {
+		n++
+	}
+	if n != 1 || it.Err() == nil {
+		t.Errorf("want a scan error on the second row, got %d rows and: %v", n, it.Err())
+	}
+	if n := exec.Stats().InUse; n != 0 {
+		t.Errorf("want the rows closed after an error, %d connections in use", n)
+	}
+
+	if _, err := Raw("select * from pilots").Iterate(nil, exec, &[]*iteratorPilot{}); err == nil {
+		t.Error("want an error for a slice")
+	}
+
+	q := Raw("select * from pilots")
+	SetLoad(q, "Jets")
+	if _, err := q.Iterate(nil, exec, &iteratorPilot{}); err == nil {
+		t.Error("want an error for eager loading")
+	}
+
+	q = Raw("select * from pilots")
+	SetLoadAll(q, true)
+	if _, err := q.Iterate(nil, exec, &iteratorPilot{}); err == nil {
+		t.Error("want an error for loading all relationships")
+	}
+}
@@ -0,0 +1,486 @@
+package queries
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+// jsonQuery is the serialized form of a Query, see MarshalJSON
+type jsonQuery struct {
+	Dialect *drivers.Dialect `json:"dialect,omitempty"`
+	RawSQL  *jsonArgClause   `json:"raw_sql,omitempty"`
+
+	PreStatements []string `json:"pre_statements,omitempty"`
+	Load          []string `json:"load,omitempty"`
+	LoadAll       bool     `json:"load_all,omitempty"`
+
+	Delete     bool                     `json:"delete,omitempty"`
+	InsertCols []string                 `json:"insert_cols,omitempty"`
+	InsertRows [][]jsonArg              `json:"insert_rows,omitempty"`
+	Conflict   *jsonConflict            `json:"conflict,omitempty"`
+	Update     map[string]jsonArg       `json:"update,omitempty"`
+	UpdateExpr map[string]jsonArgClause `json:"update_expr,omitempty"`
+	Merge      *jsonMerge               `json:"merge,omitempty"`
+	Returning  []string                 `json:"returning,omitempty"`
+	Withs      []jsonArgClause          `json:"withs,omitempty"`
+	SelectCols []string                 `json:"select_cols,omitempty"`
+	Count      bool                     `json:"count,omitempty"`
+	Exists     bool                     `json:"exists,omitempty"`
+	From       []string                 `json:"from,omitempty"`
+	Joins      []jsonJoin               `json:"joins,omitempty"`
+	Where      []jsonWhere              `json:"where,omitempty"`
+	GroupBy    []string                 `json:"group_by,omitempty"`
+	OrderBy    []jsonArgClause          `json:"order_by,omitempty"`
+	Having     []jsonArgClause          `json:"having,omitempty"`
+	Limit      int                      `json:"limit,omitempty"`
+	Offset     int                      `json:"offset,omitempty"`
+	ForLock    string                   `json:"for_lock,omitempty"`
+	Distinct   string                   `json:"distinct,omitempty"`
+	Comment    string                   `json:"comment,omitempty"`
+
+	MaxInListSize        int  `json:"max_in_list_size,omitempty"`
+	EmulateNullsOrdering bool `json:"emulate_nulls_ordering,omitempty"`
+	OrderByRandom        bool `json:"order_by_random,omitempty"`
+	BindLimitOffset      bool `json:"bind_limit_offset,omitempty"`
+	IncludeDeleted       bool `json:"include_deleted,omitempty"`
+}
+
+type jsonArgClause struct {
+	Clause string    `json:"clause"`
+	Args   []jsonArg `json:"args,omitempty"`
+}
+
+type jsonWhere struct {
+	Kind        whereKind `json:"kind,omitempty"`
+	Clause      string    `json:"clause,omitempty"`
+	OrSeparator bool      `json:"or_separator,omitempty"`
+	Args        []jsonArg `json:"args,omitempty"`
+	SoftDelete  bool      `json:"soft_delete,omitempty"`
+}
+
+type jsonJoin struct {
+	Kind   joinKind  `json:"kind"`
+	Clause string    `json:"clause"`
+	Args   []jsonArg `json:"args,omitempty"`
+}
+
+type jsonConflict struct {
+	Columns []string  `json:"columns,omitempty"`
+	Where   string    `json:"where,omitempty"`
+	Args    []jsonArg `json:"args,omitempty"`
+}
+
+type jsonMerge struct {
+	Using string          `json:"using"`
+	On    string          `json:"on"`
+	Args  []jsonArg       `json:"args,omitempty"`
+	Whens []jsonMergeWhen `json:"whens,omitempty"`
+}
+
+type jsonMergeWhen struct {
+	Matched bool      `json:"matched"`
+	Clause  string    `json:"clause"`
+	Args    []jsonArg `json:"args,omitempty"`
+}
+
+// jsonArg is an argument converted to a driver value and tagged with its
+// type so it comes back as the same type
+type jsonArg struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// MarshalJSON serializes the structure of the query, not the sql it builds,
+// so that another process can rebuild it with UnmarshalJSON and execute it.
+//
+// Arguments are converted to driver values first (int64, float64, bool,
+// []byte, string, time.Time or nil) and come back as those types. Queries
+// with eager loading mods can't be serialized since the mods are code.
+func (q *Query) MarshalJSON() ([]byte, error) {
+	if len(q.loadMods) != 0 {
+		return nil, errors.New("cannot serialize a query with load mods")
+	}
+
+	j := jsonQuery{
+		Dialect:              q.dialect,
+		PreStatements:        q.preStatements,
+		Load:                 q.load,
+		LoadAll:              q.loadAll,
+		Delete:               q.delete,
+		InsertCols:           q.insertCols,
+		Returning:            q.returning,
+		SelectCols:           q.selectCols,
+		Count:                q.count,
+		Exists:               q.exists,
+		From:                 q.from,
+		GroupBy:              q.groupBy,
+		Limit:                q.limit,
+		Offset:               q.offset,
+		ForLock:              q.forlock,
+		Distinct:             q.distinct,
+		Comment:              q.comment,
+		MaxInListSize:        q.maxInListSize,
+		EmulateNullsOrdering: q.emulateNullsOrdering,
+		OrderByRandom:        q.orderByRandom,
+		BindLimitOffset:      q.bindLimitOffset,
+		IncludeDeleted:       q.includeDeleted,
+	}
+
+	var err error
+	if len(q.rawSQL.sql) != 0 {
+		raw, err := toJSONArgClause(argClause{clause: q.rawSQL.sql, args: q.rawSQL.args})
+		if err != nil {
+			return nil, err
+		}
+		j.RawSQL = &raw
+	}
+
+	for _, row := range q.insertRows {
+		jrow, err := toJSONArgs(row)
+		if err != nil {
+			return nil, err
+		}
+		j.InsertRows = append(j.InsertRows, jrow)
+	}
+
+	if q.conflict != nil {
+		j.Conflict = &jsonConflict{Columns: q.conflict.columns, Where: q.conflict.where}
+		if j.Conflict.Args, err = toJSONArgs(q.conflict.args); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(q.update) != 0 {
+		j.Update = make(map[string]jsonArg, len(q.update))
+		for col, val := range q.update {
+			if j.Update[col], err = toJSONArg(val); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(q.updateExpr) != 0 {
+		j.UpdateExpr = make(map[string]jsonArgClause, len(q.updateExpr))
+		for col, expr := range q.updateExpr {
+			if j.UpdateExpr[col], err = toJSONArgClause(expr); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if q.merge != nil {
+		j.Merge = &jsonMerge{Using: q.merge.using, On: q.merge.on}
+		if j.Merge.Args, err = toJSONArgs(q.merge.args); err != nil {
+			return nil, err
+		}
+		for _, w := range q.merge.whens {
+			args, err := toJSONArgs(w.args)
+			if err != nil {
+				return nil, err
+			}
+			j.Merge.Whens = append(j.Merge.Whens, jsonMergeWhen{Matched: w.matched, Clause: w.clause, Args: args})
+		}
+	}
+
+	if j.Withs, err = toJSONArgClauses(q.withs); err != nil {
+		return nil, err
+	}
+	if j.OrderBy, err = toJSONArgClauses(q.orderBy); err != nil {
+		return nil, err
+	}
+	if j.Having, err = toJSONArgClauses(q.having); err != nil {
+		return nil, err
+	}
+
+	for _, jn := range q.joins {
+		args, err := toJSONArgs(jn.args)
+		if err != nil {
+			return nil, err
+		}
+		j.Joins = append(j.Joins, jsonJoin{Kind: jn.kind, Clause: jn.clause, Args: args})
+	}
+
+	for _, w := range q.where {
+		args, err := toJSONArgs(w.args)
+		if err != nil {
+			return nil, err
+		}
+		j.Where = append(j.Where, jsonWhere{
+			Kind:        w.kind,
+			Clause:      w.clause,
+			OrSeparator: w.orSeparator,
+			Args:        args,
+			SoftDelete:  w.softDelete,
+		})
+	}
+
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON rebuilds a query serialized with MarshalJSON, replacing
+// everything in q.
+func (q *Query) UnmarshalJSON(data []byte) error {
+	var j jsonQuery
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+
+	*q = Query{
+		dialect:              j.Dialect,
+		preStatements:        j.PreStatements,
+		load:                 j.Load,
+		loadAll:              j.LoadAll,
+		delete:               j.Delete,
+		insertCols:           j.InsertCols,
+		returning:            j.Returning,
+		selectCols:           j.SelectCols,
+		count:                j.Count,
+		exists:               j.Exists,
+		from:                 j.From,
+		groupBy:              j.GroupBy,
+		limit:                j.Limit,
+		offset:               j.Offset,
+		forlock:              j.ForLock,
+		distinct:             j.Distinct,
+		comment:              j.Comment,
+		maxInListSize:        j.MaxInListSize,
+		emulateNullsOrdering: j.EmulateNullsOrdering,
+		orderByRandom:        j.OrderByRandom,
+		bindLimitOffset:      j.BindLimitOffset,
+		includeDeleted:       j.IncludeDeleted,
+	}
+
+	var err error
+	if j.RawSQL != nil {
+		raw, err := fromJSONArgClause(*j.RawSQL)
+		if err != nil {
+			return err
+		}
+		q.rawSQL = rawSQL{sql: raw.clause, args: raw.args}
+	}
+
+	for _, jrow := range j.InsertRows {
+		row, err := fromJSONArgs(jrow)
+		if err != nil {
+			return err
+		}
+		q.insertRows = append(q.insertRows, row)
+	}
+
+	if j.Conflict != nil {
+		q.conflict = &conflict{columns: j.Conflict.Columns, where: j.Conflict.Where}
+		if q.conflict.args, err = fromJSONArgs(j.Conflict.Args); err != nil {
+			return err
+		}
+	}
+
+	if len(j.Update) != 0 {
+		q.update = make(map[string]interface{}, len(j.Update))
+		for col, val := range j.Update {
+			if q.update[col], err = fromJSONArg(val); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(j.UpdateExpr) != 0 {
+		q.updateExpr = make(map[string]argClause, len(j.UpdateExpr))
+		for col, expr := range j.UpdateExpr {
+			if q.updateExpr[col], err = fromJSONArgClause(expr); err != nil {
+				return err
+			}
+		}
+	}
+
+	if j.Merge != nil {
+		q.merge = &merge{using: j.Merge.Using, on: j.Merge.On}
+		if q.merge.args, err = fromJSONArgs(j.Merge.Args); err != nil {
+			return err
+		}
+		for _, w := range j.Merge.Whens {
+			args, err := fromJSONArgs(w.Args)
+			if err != nil {
+				return err
+			}
+			q.merge.whens = append(q.merge.whens, mergeWhen{matched: w.Matched, clause: w.Clause, args: args})
+		}
+	}
+
+	if q.withs, err = fromJSONArgClauses(j.Withs); err != nil {
+		return err
+	}
+	if q.orderBy, err = fromJSONArgClauses(j.OrderBy); err != nil {
+		return err
+	}
+	if q.having, err = fromJSONArgClauses(j.Having); err != nil {
+		return err
+	}
+
+	for _, jn := range j.Joins {
+		args, err := fromJSONArgs(jn.Args)
+		if err != nil {
+			return err
+		}
+		q.joins = append(q.joins, join{kind: jn.Kind, clause: jn.Clause, args: args})
+	}
+
+	for _, w := range j.Where {
+		args, err := fromJSONArgs(w.Args)
+		if err != nil {
+			return err
+		}
+		appendWhere(q, where{
+			kind:        w.Kind,
+			clause:      w.Clause,
+			orSeparator: w.OrSeparator,
+			args:        args,
+			softDelete:  w.SoftDelete,
+		})
+	}
+
+	return nil
+}
+
+func toJSONArgClauses(clauses []argClause) ([]jsonArgClause, error) {
+	var out []jsonArgClause
+	for _, c := range clauses {
+		jc, err := toJSONArgClause(c)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, jc)
+	}
+	return out, nil
+}
+
+func fromJSONArgClauses(clauses []jsonArgClause) ([]argClause, error) {
+	var out []argClause
+	for _, jc := range clauses {
+		c, err := fromJSONArgClause(jc)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, nil
+}
+
+func toJSONArgClause(c argClause) (jsonArgClause, error) {
+	args, err := toJSONArgs(c.args)
+	return jsonArgClause{Clause: c.clause, Args: args}, err
+}
+
+func fromJSONArgClause(jc jsonArgClause) (argClause, error) {
+	args, err := fromJSONArgs(jc.Args)
+	return argClause{clause: jc.Clause, args: args}, err
+}
+
+func toJSONArgs(args []interface{}) ([]jsonArg, error) {
+	if args == nil {
+		return nil, nil
+	}
+
+	out := make([]jsonArg, len(args))
+	for i, a := range args {
+		var err error
+		if out[i], err = toJSONArg(a); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func fromJSONArgs(args []jsonArg) ([]interface{}, error) {
+	if args == nil {
+		return nil, nil
+	}
+
+	out := make([]interface{}, len(args))
+	for i, a := range args {
+		var err error
+		if out[i], err = fromJSONArg(a); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func toJSONArg(arg interface{}) (jsonArg, error) {
+	val, err := driver.DefaultParameterConverter.ConvertValue(arg)
+	if err != nil {
+		return jsonArg{}, errors.Wrapf(err, "cannot serialize argument of type %T", arg)
+	}
+
+	var typ string
+	switch val.(type) {
+	case nil:
+		return jsonArg{Type: "null"}, nil
+	case int64:
+		typ = "int"
+	case float64:
+		typ = "float"
+	case bool:
+		typ = "bool"
+	case []byte:
+		typ = "bytes"
+	case string:
+		typ = "string"
+	case time.Time:
+		typ = "time"
+	default:
+		return jsonArg{}, errors.Errorf("cannot serialize argument of type %T", arg)
+	}
+
+	raw, err := json.Marshal(val)
+	if err != nil {
+		return jsonArg{}, errors.Wrapf(err, "cannot serialize argument of type %T", arg)
+	}
+
+	return jsonArg{Type: typ, Value: raw}, nil
+}
+
+func fromJSONArg(arg jsonArg) (interface{}, error) {
+	var val interface{}
+	switch arg.Type {
+	case "null":
+		return nil, nil
+	case "int":
+		val = new(int64)
+	case "float":
+		val = new(float64)
+	case "bool":
+		val = new(bool)
+	case "bytes":
+		val = new([]byte)
+	case "string":
+		val = new(string)
+	case "time":
+		val = new(time.Time)
+	default:
+		return nil, errors.Errorf("unknown argument type %q", arg.Type)
+	}
+
+	if err := json.Unmarshal(arg.Value, val); err != nil {
+		return nil, errors.Wrapf(err, "failed to deserialize %s argument", arg.Type)
+	}
+
+	// Dereference the pointer to get the value itself
+	switch v := val.(type) {
+	case *int64:
+		return *v, nil
+	case *float64:
+		return *v, nil
+	case *bool:
+		return *v, nil
+	case *[]byte:
+		return *v, nil
+	case *string:
+		return *v, nil
+	default:
+		return *val.(*time.Time), nil
+	}
+}
@@ -0,0 +1,159 @@
+package queries
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+type jsonTestApplicator struct{}
+
+func (jsonTestApplicator) Apply(*Query) {}
+
+func TestQueryJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	dialect := &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true, UseReturningClause: true, UseOnConflictClause: true}
+	when := time.Date(2020, 5, 6, 7, 8, 9, 0, time.UTC)
+
+	tests := []func() *Query{
+		func() *Query {
+			q := &Query{dialect: dialect}
+			SetSelect(q, []string{"pilots.id", "pilots.name"})
+			SetFrom(q, "pilots")
+			AppendInnerJoin(q, "jets j on j.pilot_id = pilots.id and j.age > ?", int64(5))
+			AppendWhere(q, "pilots.name = ?", "Ann")
+			AppendWhereLeftParen(q)
+			AppendWhere(q, "pilots.created_at > ?", when)
+			AppendWhere(q, "pilots.photo = ?", []byte("png"))
+			SetLastWhereAsOr(q)
+			AppendWhereRightParen(q)
+			AppendIn(q, "pilots.id in ?", int64(1), int64(2), int64(3))
+			AppendOrderBy(q, "pilots.name desc")
+			AppendOrderBy(q, "abs(pilots.rating - ?)", 4.5)
+			SetLimit(q, 10)
+			SetOffset(q, 20)
+			return q
+		},
+		func() *Query {
+			q := &Query{dialect: dialect}
+			SetFrom(q, "pilots")
+			AppendWith(q, "old AS (SELECT id FROM jets WHERE age > ?)", int64(30))
+			AppendSoftDeleteWhere(q, "deleted_at is null")
+			AppendWhere(q, "active = ?", true)
+			AppendWhere(q, "name = ?", nil)
+			AppendGroupBy(q, "name")
+			AppendHaving(q, "count(*) > ?", int64(2))
+			SetCount(q)
+			SetComment(q, "count pilots")
+			return q
+		},
+		func() *Query {
+			q := &Query{dialect: dialect}
+			SetFrom(q, "pilots")
+			SetInsert(q, []string{"id", "name"}, []interface{}{int64(1), "Ann"}, []interface{}{int64(2), nil})
+			SetConflict(q, []string{"id"}, "name <> ?", "Bob")
+			SetUpdate(q, map[string]interface{}{"name": "Ann"})
+			SetReturning(q, "id")
+			return q
+		},
+		func() *Query {
+			q := Raw("select * from pilots where id = ?", int64(5))
+			SetDialect(q, dialect)
+			return q
+		},
+	}
+
+	for i, test := range tests {
+		b, err := json.Marshal(test())
+		if err != nil {
+			t.Fatalf("%d) %+v", i, err)
+		}
+
+		got := &Query{}
+		if err := json.Unmarshal(b, got); err != nil {
+			t.Fatalf("%d) %+v", i, err)
+		}
+
+		wantSQL, wantArgs, err := buildQuery(test())
+		if err != nil {
+			t.Fatal(err)
+		}
+		gotSQL, gotArgs, err := buildQuery(got)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if gotSQL != wantSQL {
+			t.Errorf("%d) want:\n%s\ngot:\n%s", i, wantSQL, gotSQL)
+		}
+		if !reflect.DeepEqual(gotArgs, wantArgs) {
+			t.Errorf("%d) want args: %#v\ngot: %#v", i, wantArgs, gotArgs)
+		}
+	}
+}
+
+func TestQueryJSONArgTypes(t *testing.T) {
+	t.Parallel()
+
+	q := &Query{}
+	AppendWhere(q, "a = ? and b = ? and c = ?", 5, int8(2), float32(1.5))
+
+	b, err := json.Marshal(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := &Query{}
+	if err := json.Unmarshal(b, got); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []interface{}{int64(5), int64(2), float64(1.5)}
+	if !reflect.DeepEqual(got.where[0].args, want) {
+		t.Errorf("want the driver values: %#v, got: %#v", want, got.where[0].args)
+	}
+}
+
+func TestQueryJSONLoad(t *testing.T) {
+	t.Parallel()
+
+	q := &Query{}
+	AppendLoad(q, "Jets.Airport")
+	SetLoadAll(q, true)
+
+	b, err := json.Marshal(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := &Query{}
+	if err := json.Unmarshal(b, got); err != nil {
+		t.Fatal(err)
+	}
+	if !got.loadAll || !reflect.DeepEqual(got.load, []string{"Jets.Airport"}) {
+		t.Errorf("want the loads kept, got: %v %v", got.loadAll, got.load)
+	}
+}
+
+func TestQueryJSONErrors(t *testing.T) {
+	t.Parallel()
+
+	q := &Query{}
+	SetLoadMods(q, "Jets", jsonTestApplicator{})
+	if _, err := json.Marshal(q); err == nil {
+		t.Error("want an error for a query with load mods")
+	}
+
+	q = &Query{}
+	AppendWhere(q, "a = ?", struct{}{})
+	if _, err := json.Marshal(q); err == nil {
+		t.Error("want an error for an argument that isn't a driver value")
+	}
+
+	if err := json.Unmarshal([]byte(`{"where":[{"clause":"a = ?","args":[{"type":"complex"}]}]}`), &Query{}); err == nil {
+		t.Error("want an error for an unknown argument type")
+	}
+}
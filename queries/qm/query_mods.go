@@ -2,6 +2,7 @@ package qm
 
 import (
 	"strings"
+	"time"
 
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
@@ -98,6 +99,15 @@ func (qm loadQueryMod) Apply(q *queries.Query) {
 //     qm.Load("Videos", Where("deleted = false"))
 //     qm.Load("Videos.Tags", Where("deleted = ?", isDeleted))
 //   )
+//
+// A Select mod limits the columns loaded for the relationship, the column the
+// loaded objects are matched to their parents with is added if it's missing:
+//
+//   models.Users(qm.Load("Videos", qm.Select("id", "title")))
+//
+// Each level of a nested load is fetched with a single query for all of the
+// objects loaded at the level above it, so the above issues three queries in
+// total no matter how many users or videos are returned.
 func Load(relationship string, mods ...QueryMod) QueryMod {
 	return loadQueryMod{
 		relationship: relationship,
@@ -105,6 +115,26 @@ func Load(relationship string, mods ...QueryMod) QueryMod {
 	}
 }
 
+type loadAllQueryMod struct{}
+
+// Apply implements QueryMod.Apply.
+func (loadAllQueryMod) Apply(q *queries.Query) {
+	queries.SetLoadAll(q, true)
+}
+
+// LoadAll eager loads every relationship of the model one level deep, which
+// is handy for debugging and admin views. Like Load each relationship is
+// fetched with a single query for all of the objects, so a model with three
+// relationships takes three queries on top of its own.
+//
+// It can be combined with Load to give a relationship query mods or to load
+// nested relationships:
+//
+//   models.Pilots(qm.LoadAll(), qm.Load("Jets.Airport"))
+func LoadAll() QueryMod {
+	return loadAllQueryMod{}
+}
+
 type innerJoinQueryMod struct {
 	clause string
 	args   []interface{}
@@ -177,6 +207,30 @@ func FullOuterJoin(clause string, args ...interface{}) QueryMod {
 	}
 }
 
+// OuterJoin on another table, it's the same as FullOuterJoin
+func OuterJoin(clause string, args ...interface{}) QueryMod {
+	return FullOuterJoin(clause, args...)
+}
+
+type crossJoinQueryMod struct {
+	clause string
+	args   []interface{}
+}
+
+// Apply implements QueryMod.Apply.
+func (qm crossJoinQueryMod) Apply(q *queries.Query) {
+	queries.AppendCrossJoin(q, qm.clause, qm.args...)
+}
+
+// CrossJoin on another table, the clause has no on condition since every
+// row is joined to every other row
+func CrossJoin(clause string, args ...interface{}) QueryMod {
+	return crossJoinQueryMod{
+		clause: clause,
+		args:   args,
+	}
+}
+
 type distinctQueryMod struct {
 	clause string
 }
@@ -236,6 +290,49 @@ func Where(clause string, args ...interface{}) QueryMod {
 	}
 }
 
+type whereTimeRangeQueryMod struct {
+	col            string
+	from, to       time.Time
+	incFrom, incTo bool
+}
+
+// Apply implements QueryMod.Apply.
+func (qm whereTimeRangeQueryMod) Apply(q *queries.Query) {
+	queries.SetWhereTimeRange(q, qm.col, qm.from, qm.to, qm.incFrom, qm.incTo)
+}
+
+// WhereTimeRange allows you to filter col to the times between from and to,
+// a zero time leaves that side of the range open.
+// See queries.SetWhereTimeRange for details.
+func WhereTimeRange(col string, from, to time.Time, incFrom, incTo bool) QueryMod {
+	return whereTimeRangeQueryMod{
+		col:     col,
+		from:    from,
+		to:      to,
+		incFrom: incFrom,
+		incTo:   incTo,
+	}
+}
+
+type whereFullTextQueryMod struct {
+	cols  []string
+	query string
+}
+
+// Apply implements QueryMod.Apply.
+func (qm whereFullTextQueryMod) Apply(q *queries.Query) {
+	queries.SetWhereFullText(q, qm.cols, qm.query)
+}
+
+// WhereFullText allows you to do a full text search of cols for query,
+// see queries.SetWhereFullText for the sql each dialect uses.
+func WhereFullText(cols []string, query string) QueryMod {
+	return whereFullTextQueryMod{
+		cols:  cols,
+		query: query,
+	}
+}
+
 type andQueryMod struct {
 	clause string
 	args   []interface{}
@@ -289,23 +386,29 @@ type or2QueryMod struct {
 	inner QueryMod
 }
 
+// Apply implements QueryMod.Apply.
 func (qm or2QueryMod) Apply(q *queries.Query) {
 	qm.inner.Apply(q)
 	queries.SetLastWhereAsOr(q)
 }
 
-// Apply implements QueryMod.Apply.
 type whereInQueryMod struct {
 	clause string
 	args   []interface{}
 }
 
+// Apply implements QueryMod.Apply.
 func (qm whereInQueryMod) Apply(q *queries.Query) {
 	queries.AppendIn(q, qm.clause, qm.args...)
 }
 
 // WhereIn allows you to specify a "x IN (set)" clause for your where statement
 // Example clauses: "column in ?", "(column1,column2) in ?"
+//
+// The set can be given as args or as a single slice:
+//
+//   WhereIn("id in ?", ids...)
+//   WhereIn("id in ?", ids)
 func WhereIn(clause string, args ...interface{}) QueryMod {
 	return whereInQueryMod{
 		clause: clause,
@@ -366,7 +469,7 @@ func (qm whereNotInQueryMod) Apply(q *queries.Query) {
 
 // WhereNotIn allows you to specify a "x NOT IN (set)" clause for your where
 // statement. Example clauses: "column not in ?",
-// "(column1,column2) not in ?"
+// "(column1,column2) not in ?". Like WhereIn the set can be a single slice.
 func WhereNotIn(clause string, args ...interface{}) QueryMod {
 	return whereNotInQueryMod{
 		clause: clause,
@@ -538,6 +641,83 @@ func Offset(offset int) QueryMod {
 	}
 }
 
+type maxInListSizeQueryMod struct {
+	size int
+}
+
+// Apply implements QueryMod.Apply.
+func (qm maxInListSizeQueryMod) Apply(q *queries.Query) {
+	queries.SetMaxInListSize(q, qm.size)
+}
+
+// MaxInListSize splits the lists of WhereIn and WhereNotIn style query mods
+// into several IN clauses of at most size args each, for databases that
+// limit the number of items in one IN list. It doesn't lower the number of
+// bind parameters of the query.
+func MaxInListSize(size int) QueryMod {
+	return maxInListSizeQueryMod{
+		size: size,
+	}
+}
+
+type preStatementQueryMod struct {
+	stmt string
+}
+
+// Apply implements QueryMod.Apply.
+func (qm preStatementQueryMod) Apply(q *queries.Query) {
+	queries.AddPreStatement(q, qm.stmt)
+}
+
+// PreStatement executes stmt before the query, for example to change
+// session settings with SET LOCAL inside of a transaction.
+func PreStatement(stmt string) QueryMod {
+	return preStatementQueryMod{
+		stmt: stmt,
+	}
+}
+
+type orderByRandomQueryMod struct{}
+
+// Apply implements QueryMod.Apply.
+func (orderByRandomQueryMod) Apply(q *queries.Query) {
+	queries.SetOrderByRandom(q)
+}
+
+// OrderByRandom orders the results randomly, see queries.SetOrderByRandom
+// for the caveats on large tables.
+func OrderByRandom() QueryMod {
+	return orderByRandomQueryMod{}
+}
+
+type emulateNullsOrderingQueryMod struct{}
+
+// Apply implements QueryMod.Apply.
+func (emulateNullsOrderingQueryMod) Apply(q *queries.Query) {
+	queries.SetEmulateNullsOrdering(q, true)
+}
+
+// EmulateNullsOrdering rewrites NULLS FIRST and NULLS LAST in OrderBy
+// clauses into an equivalent ordering on databases that don't support
+// them natively, like MySQL.
+func EmulateNullsOrdering() QueryMod {
+	return emulateNullsOrderingQueryMod{}
+}
+
+type bindLimitOffsetQueryMod struct{}
+
+// Apply implements QueryMod.Apply.
+func (bindLimitOffsetQueryMod) Apply(q *queries.Query) {
+	queries.SetBindLimitOffset(q, true)
+}
+
+// BindLimitOffset passes Limit and Offset as args instead of writing them
+// into the sql on databases that allow it, like PostgreSQL and MySQL, so
+// the same prepared statement serves every page.
+func BindLimitOffset() QueryMod {
+	return bindLimitOffsetQueryMod{}
+}
+
 type forQueryMod struct {
 	clause string
 }
@@ -570,6 +750,37 @@ func Comment(comment string) QueryMod {
 	}
 }
 
+type returningQueryMod struct {
+	columns []string
+}
+
+// Apply implements QueryMod.Apply.
+func (qm returningQueryMod) Apply(q *queries.Query) {
+	queries.SetReturning(q, qm.columns...)
+}
+
+// Returning adds a RETURNING clause to a delete or update query so the
+// affected rows can be bound, eg. qm.Returning("*"). Only dialects with
+// UseReturningClause, like postgres, support it.
+func Returning(columns ...string) QueryMod {
+	return returningQueryMod{
+		columns: columns,
+	}
+}
+
+type withDeletedQueryMod struct{}
+
+// Apply implements QueryMod.Apply.
+func (qm withDeletedQueryMod) Apply(q *queries.Query) {
+	queries.SetIncludeDeleted(q)
+}
+
+// WithDeleted includes soft deleted rows in the query, the generated
+// "deleted_at is null" clause is left out.
+func WithDeleted() QueryMod {
+	return withDeletedQueryMod{}
+}
+
 // Rels is an alias for strings.Join to make it easier to use relationship name
 // constants in Load.
 func Rels(r ...string) string {
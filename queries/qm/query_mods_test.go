@@ -0,0 +1,205 @@
+package qm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+func newQuery() *queries.Query {
+	q := &queries.Query{}
+	queries.SetDialect(q, &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true})
+	queries.SetFrom(q, "jets")
+	return q
+}
+
+func TestGroupByHaving(t *testing.T) {
+	t.Parallel()
+
+	withMods := newQuery()
+	Apply(withMods,
+		Select("pilot_id", "count(*)"),
+		GroupBy("pilot_id"),
+		GroupBy("airport_id"),
+		Having("count(*) > ?", 2),
+		Having("max(age) < ?", 10),
+	)
+
+	withSetters := newQuery()
+	queries.SetSelect(withSetters, []string{"pilot_id", "count(*)"})
+	queries.AppendGroupBy(withSetters, "pilot_id")
+	queries.AppendGroupBy(withSetters, "airport_id")
+	queries.AppendHaving(withSetters, "count(*) > ?", 2)
+	queries.AppendHaving(withSetters, "max(age) < ?", 10)
+
+	gotSQL, gotArgs := queries.BuildQuery(withMods)
+	wantSQL, wantArgs := queries.BuildQuery(withSetters)
+
+	if gotSQL != wantSQL {
+		t.Errorf("want:\n%s\ngot:\n%s", wantSQL, gotSQL)
+	}
+	if !reflect.DeepEqual(gotArgs, wantArgs) {
+		t.Errorf("want args: %#v, got: %#v", wantArgs, gotArgs)
+	}
+
+	want := `SELECT "pilot_id", count(*) FROM "jets" GROUP BY pilot_id, airport_id HAVING count(*) > $1 AND max(age) < $2;`
+	if gotSQL != want {
+		t.Errorf("want:\n%s\ngot:\n%s", want, gotSQL)
+	}
+}
+
+func TestJoins(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Mod  QueryMod
+		Want string
+	}{
+		{InnerJoin("pilots p on p.id = jets.pilot_id and p.age > ?", 30), `SELECT "jets".* FROM "jets" INNER JOIN pilots p on p.id = jets.pilot_id and p.age > $1;`},
+		{LeftOuterJoin("pilots p on p.id = jets.pilot_id and p.age > ?", 30), `SELECT "jets".* FROM "jets" LEFT JOIN pilots p on p.id = jets.pilot_id and p.age > $1;`},
+		{RightOuterJoin("pilots p on p.id = jets.pilot_id and p.age > ?", 30), `SELECT "jets".* FROM "jets" RIGHT JOIN pilots p on p.id = jets.pilot_id and p.age > $1;`},
+		{FullOuterJoin("pilots p on p.id = jets.pilot_id and p.age > ?", 30), `SELECT "jets".* FROM "jets" FULL JOIN pilots p on p.id = jets.pilot_id and p.age > $1;`},
+		{OuterJoin("pilots p on p.id = jets.pilot_id and p.age > ?", 30), `SELECT "jets".* FROM "jets" FULL JOIN pilots p on p.id = jets.pilot_id and p.age > $1;`},
+		{CrossJoin("generate_series(1, ?) s", 30), `SELECT "jets".* FROM "jets" CROSS JOIN generate_series(1, $1) s;`},
+	}
+
+	for i, test := range tests {
+		q := newQuery()
+		Apply(q, test.Mod)
+
+		got, args := queries.BuildQuery(q)
+		if got != test.Want {
+			t.Errorf("%d) want:\n%s\ngot:\n%s", i, test.Want, got)
+		}
+		if !reflect.DeepEqual(args, []interface{}{30}) {
+			t.Errorf("%d) want the join args, got: %#v", i, args)
+		}
+	}
+}
+
+func TestExpr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Mods []QueryMod
+		Want string
+	}{
+		{
+			Mods: []QueryMod{
+				Expr(Where("a = ?", 1), Or("b = ?", 2)),
+				Where("c = ?", 3),
+			},
+			Want: `SELECT * FROM "jets" WHERE (a = $1 OR b = $2) AND c = $3;`,
+		},
+		{
+			Mods: []QueryMod{
+				Expr(
+					Where("a = ?", 1),
+					Or2(Expr(Where("b = ?", 2), Where("c = ?", 3))),
+				),
+				Expr(Where("d = ?", 4), Or("e = ?", 5)),
+			},
+			Want: `SELECT * FROM "jets" WHERE (a = $1 OR (b = $2 AND c = $3)) AND (d = $4 OR e = $5);`,
+		},
+	}
+
+	for i, test := range tests {
+		q := newQuery()
+		Apply(q, test.Mods...)
+
+		got, args := queries.BuildQuery(q)
+		if got != test.Want {
+			t.Errorf("%d) want:\n%s\ngot:\n%s", i, test.Want, got)
+		}
+		for j, arg := range args {
+			if arg != j+1 {
+				t.Errorf("%d) want the args in order, got: %#v", i, args)
+				break
+			}
+		}
+	}
+}
+
+func TestWhereIn(t *testing.T) {
+	t.Parallel()
+
+	ids := []int{1, 2, 3}
+
+	tests := []struct {
+		Mod      QueryMod
+		Want     string
+		WantArgs []interface{}
+	}{
+		{WhereIn("id in ?", 1, 2, 3), `SELECT * FROM "jets" WHERE ("id" IN ($1,$2,$3));`, []interface{}{1, 2, 3}},
+		{WhereIn("id in ?", ids), `SELECT * FROM "jets" WHERE ("id" IN ($1,$2,$3));`, []interface{}{1, 2, 3}},
+		{WhereIn("id in ?"), `SELECT * FROM "jets" WHERE (1=0);`, nil},
+		{WhereIn("id in ?", []int{}), `SELECT * FROM "jets" WHERE (1=0);`, nil},
+		{WhereIn("name in ?", []string{"a", "b"}), `SELECT * FROM "jets" WHERE ("name" IN ($1,$2));`, []interface{}{"a", "b"}},
+		{WhereIn("name in ?", []string(nil)), `SELECT * FROM "jets" WHERE (1=0);`, nil},
+		{WhereIn("(id,name) in ?", []interface{}{1, "a", 2, "b"}), `SELECT * FROM "jets" WHERE ((id,name) IN (($1,$2),($3,$4)));`, []interface{}{1, "a", 2, "b"}},
+		{AndIn("id in ?", ids), `SELECT * FROM "jets" WHERE ("id" IN ($1,$2,$3));`, []interface{}{1, 2, 3}},
+		{WhereNotIn("id not in ?", 1, 2, 3), `SELECT * FROM "jets" WHERE ("id" NOT IN ($1,$2,$3));`, []interface{}{1, 2, 3}},
+		{WhereNotIn("id not in ?", ids), `SELECT * FROM "jets" WHERE ("id" NOT IN ($1,$2,$3));`, []interface{}{1, 2, 3}},
+		{WhereNotIn("id not in ?"), `SELECT * FROM "jets" WHERE (1=1);`, nil},
+		{WhereNotIn("id not in ?", []int{}), `SELECT * FROM "jets" WHERE (1=1);`, nil},
+		{WhereNotIn("name not in ?", []string{"a", "b"}), `SELECT * FROM "jets" WHERE ("name" NOT IN ($1,$2));`, []interface{}{"a", "b"}},
+	}
+
+	for i, test := range tests {
+		q := newQuery()
+		Apply(q, test.Mod)
+
+		got, args := queries.BuildQuery(q)
+		if got != test.Want {
+			t.Errorf("%d) want:\n%s\ngot:\n%s", i, test.Want, got)
+		}
+		if !reflect.DeepEqual(args, test.WantArgs) {
+			t.Errorf("%d) want args: %#v, got: %#v", i, test.WantArgs, args)
+		}
+	}
+}
+
+func TestAndOr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Mods []QueryMod
+		Want string
+	}{
+		{
+			Mods: []QueryMod{Where("a = ?", 1), Or("b = ?", 2)},
+			Want: `SELECT * FROM "jets" WHERE (a = $1) OR (b = $2);`,
+		},
+		{
+			Mods: []QueryMod{Where("a = ?", 1), And("b = ?", 2), Or("c = ?", 3)},
+			Want: `SELECT * FROM "jets" WHERE (a = $1) AND (b = $2) OR (c = $3);`,
+		},
+		{
+			Mods: []QueryMod{Where("a = ?", 1), Or2(Where("b = ?", 2))},
+			Want: `SELECT * FROM "jets" WHERE (a = $1) OR (b = $2);`,
+		},
+		// A lone Or has nothing to be ORed with
+		{
+			Mods: []QueryMod{Or("a = ?", 1)},
+			Want: `SELECT * FROM "jets" WHERE (a = $1);`,
+		},
+	}
+
+	for i, test := range tests {
+		q := newQuery()
+		Apply(q, test.Mods...)
+
+		got, args := queries.BuildQuery(q)
+		if got != test.Want {
+			t.Errorf("%d) want:\n%s\ngot:\n%s", i, test.Want, got)
+		}
+		for j, arg := range args {
+			if arg != j+1 {
+				t.Errorf("%d) want the args in order, got: %#v", i, args)
+				break
+			}
+		}
+	}
+}
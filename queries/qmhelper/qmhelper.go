@@ -58,6 +58,23 @@ func WhereIsNull(name string) WhereQueryMod {
 	return WhereQueryMod{Clause: fmt.Sprintf("%s is null", name)}
 }
 
+// SoftDeleteQueryMod hides the soft deleted rows of a query unless it's
+// given qm.WithDeleted
+type SoftDeleteQueryMod struct {
+	Column string
+}
+
+// Apply implements QueryMod.Apply.
+func (qm SoftDeleteQueryMod) Apply(q *queries.Query) {
+	queries.AppendSoftDeleteWhere(q, fmt.Sprintf("%s is null", qm.Column))
+}
+
+// WhereNotDeleted is a helper that returns "name is null" for the column
+// rows are soft deleted with
+func WhereNotDeleted(name string) SoftDeleteQueryMod {
+	return SoftDeleteQueryMod{Column: name}
+}
+
 // WhereIsNotNull is a helper that just returns "name is not null"
 func WhereIsNotNull(name string) WhereQueryMod {
 	return WhereQueryMod{Clause: fmt.Sprintf("%s is not null", name)}
@@ -3,8 +3,12 @@ package queries
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/friendsofgo/errors"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/drivers"
 )
@@ -19,6 +23,7 @@ const (
 	JoinOuterRight
 	JoinNatural
 	JoinOuterFull
+	JoinCross
 )
 
 // Query holds the state for the built up query
@@ -26,17 +31,28 @@ type Query struct {
 	dialect *drivers.Dialect
 	rawSQL  rawSQL
 
+	preStatements []string
+
 	load     []string
 	loadMods map[string]Applicator
+	loadAll  bool
 
 	delete     bool
+	insertCols []string
+	insertRows [][]interface{}
+	conflict   *conflict
 	update     map[string]interface{}
+	updateExpr map[string]argClause
+	merge      *merge
+	returning  []string
 	withs      []argClause
 	selectCols []string
 	count      bool
+	exists     bool
 	from       []string
 	joins      []join
 	where      []where
+	whereTree  *whereTree
 	groupBy    []string
 	orderBy    []argClause
 	having     []argClause
@@ -45,6 +61,16 @@ type Query struct {
 	forlock    string
 	distinct   string
 	comment    string
+
+	maxInListSize        int
+	emulateNullsOrdering bool
+	orderByRandom        bool
+	bindLimitOffset      bool
+	includeDeleted       bool
+
+	// err is the first error found applying query mods, it's returned
+	// when the query is built since query mods can't return errors
+	err error
 }
 
 // Applicator exists only to allow
@@ -62,6 +88,7 @@ const (
 	whereKindRightParen
 	whereKindIn
 	whereKindNotIn
+	whereKindFullText
 )
 
 type where struct {
@@ -70,6 +97,9 @@ type where struct {
 	clause      string
 	orSeparator bool
 	args        []interface{}
+	// softDelete marks the clause hiding soft deleted rows so it can be
+	// left out when the query includes them
+	softDelete bool
 }
 
 type in struct {
@@ -83,6 +113,25 @@ type argClause struct {
 	args   []interface{}
 }
 
+type merge struct {
+	using string
+	on    string
+	args  []interface{}
+	whens []mergeWhen
+}
+
+type mergeWhen struct {
+	matched bool
+	clause  string
+	args    []interface{}
+}
+
+type conflict struct {
+	columns []string
+	where   string
+	args    []interface{}
+}
+
 type rawSQL struct {
 	sql  string
 	args []interface{}
@@ -111,7 +160,13 @@ func RawG(query string, args ...interface{}) *Query {
 
 // Exec executes a query that does not need a row returned
 func (q *Query) Exec(exec boil.Executor) (sql.Result, error) {
-	qs, args := BuildQuery(q)
+	qs, args, err := buildQuery(q)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.execPreStatements(exec); err != nil {
+		return nil, err
+	}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, qs)
 		fmt.Fprintln(boil.DebugWriter, args)
@@ -121,7 +176,13 @@ func (q *Query) Exec(exec boil.Executor) (sql.Result, error) {
 
 // QueryRow executes the query for the One finisher and returns a row
 func (q *Query) QueryRow(exec boil.Executor) *sql.Row {
-	qs, args := BuildQuery(q)
+	qs, args, err := buildQuery(q)
+	if err != nil {
+		return errRow(err)
+	}
+	if err := q.execPreStatements(exec); err != nil {
+		return errRow(err)
+	}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, qs)
 		fmt.Fprintln(boil.DebugWriter, args)
@@ -131,7 +192,13 @@ func (q *Query) QueryRow(exec boil.Executor) *sql.Row {
 
 // Query executes the query for the All finisher and returns multiple rows
 func (q *Query) Query(exec boil.Executor) (*sql.Rows, error) {
-	qs, args := BuildQuery(q)
+	qs, args, err := buildQuery(q)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.execPreStatements(exec); err != nil {
+		return nil, err
+	}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, qs)
 		fmt.Fprintln(boil.DebugWriter, args)
@@ -141,7 +208,13 @@ func (q *Query) Query(exec boil.Executor) (*sql.Rows, error) {
 
 // ExecContext executes a query that does not need a row returned
 func (q *Query) ExecContext(ctx context.Context, exec boil.ContextExecutor) (sql.Result, error) {
-	qs, args := BuildQuery(q)
+	qs, args, err := buildQuery(q)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.execPreStatementsContext(ctx, exec); err != nil {
+		return nil, err
+	}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, qs)
@@ -152,7 +225,13 @@ func (q *Query) ExecContext(ctx context.Context, exec boil.ContextExecutor) (sql
 
 // QueryRowContext executes the query for the One finisher and returns a row
 func (q *Query) QueryRowContext(ctx context.Context, exec boil.ContextExecutor) *sql.Row {
-	qs, args := BuildQuery(q)
+	qs, args, err := buildQuery(q)
+	if err != nil {
+		return errRow(err)
+	}
+	if err := q.execPreStatementsContext(ctx, exec); err != nil {
+		return errRow(err)
+	}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, qs)
@@ -163,7 +242,13 @@ func (q *Query) QueryRowContext(ctx context.Context, exec boil.ContextExecutor)
 
 // QueryContext executes the query for the All finisher and returns multiple rows
 func (q *Query) QueryContext(ctx context.Context, exec boil.ContextExecutor) (*sql.Rows, error) {
-	qs, args := BuildQuery(q)
+	qs, args, err := buildQuery(q)
+	if err != nil {
+		return nil, err
+	}
+	if err := q.execPreStatementsContext(ctx, exec); err != nil {
+		return nil, err
+	}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, qs)
@@ -172,6 +257,74 @@ func (q *Query) QueryContext(ctx context.Context, exec boil.ContextExecutor) (*s
 	return exec.QueryContext(ctx, qs, args...)
 }
 
+// execPreStatements runs the statements added with AddPreStatement
+func (q *Query) execPreStatements(exec boil.Executor) error {
+	if err := checkPreStatementExecutor(q, exec); err != nil {
+		return err
+	}
+
+	for _, stmt := range q.preStatements {
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, stmt)
+		}
+		if _, err := exec.Exec(stmt); err != nil {
+			return errors.Wrap(err, "failed to execute pre statement")
+		}
+	}
+
+	return nil
+}
+
+// execPreStatementsContext runs the statements added with AddPreStatement
+func (q *Query) execPreStatementsContext(ctx context.Context, exec boil.ContextExecutor) error {
+	if err := checkPreStatementExecutor(q, exec); err != nil {
+		return err
+	}
+
+	for _, stmt := range q.preStatements {
+		if boil.IsDebug(ctx) {
+			fmt.Fprintln(boil.DebugWriterFrom(ctx), stmt)
+		}
+		if _, err := exec.ExecContext(ctx, stmt); err != nil {
+			return errors.Wrap(err, "failed to execute pre statement")
+		}
+	}
+
+	return nil
+}
+
+// checkPreStatementExecutor refuses to run pre statements on a *sql.DB,
+// each statement run on it may get a different connection from the pool
+// so the pre statements wouldn't apply to the query.
+func checkPreStatementExecutor(q *Query, exec interface{}) error {
+	if len(q.preStatements) == 0 {
+		return nil
+	}
+	if _, ok := exec.(*sql.DB); ok {
+		return errors.New("pre statements need a single connection, run the query on a *sql.Tx or *sql.Conn instead of a *sql.DB")
+	}
+	return nil
+}
+
+// errRow returns a row whose Scan returns err. A *sql.Row can't be made
+// outside of database/sql, so this queries a database that fails to
+// connect with err.
+func errRow(err error) *sql.Row {
+	db := sql.OpenDB(errConnector{err: err})
+	defer db.Close()
+	return db.QueryRow("")
+}
+
+// errConnector is a driver.Connector and driver.Driver that fails every
+// connection with err
+type errConnector struct {
+	err error
+}
+
+func (e errConnector) Connect(context.Context) (driver.Conn, error) { return nil, e.err }
+func (e errConnector) Open(string) (driver.Conn, error)             { return nil, e.err }
+func (e errConnector) Driver() driver.Driver                        { return e }
+
 // ExecP executes a query that does not need a row returned
 // It will panic on error
 func (q *Query) ExecP(exec boil.Executor) sql.Result {
@@ -204,6 +357,22 @@ func SetSQL(q *Query, sql string, args ...interface{}) {
 	q.rawSQL = rawSQL{sql: sql, args: args}
 }
 
+// AddPreStatement adds a statement that is executed on the same executor
+// before the query each time it's run, for example:
+//
+//   AddPreStatement(q, "SET LOCAL statement_timeout = '5s'")
+//
+// Pre statements are not part of the query's sql. They have to run on the
+// same connection as the query, so the query must be run on a *sql.Tx or a
+// *sql.Conn, running it on a *sql.DB returns an error. Statements like
+// SET LOCAL only apply to the query if the executor is a transaction.
+//
+// If a pre statement fails the query isn't run and the error is returned,
+// for QueryRow it's returned by the row's Scan.
+func AddPreStatement(q *Query, stmt string) {
+	q.preStatements = append(q.preStatements, stmt)
+}
+
 // SetArgs is primarily for re-use of a query so that the
 // query text does not need to be re-generated, useful
 // if you're performing the same query with different arguments
@@ -222,6 +391,12 @@ func AppendLoad(q *Query, relationships string) {
 	q.load = append(q.load, relationships)
 }
 
+// SetLoadAll on the query. When set every relationship of the model is
+// eager loaded one level deep, before the relationships given to Load.
+func SetLoadAll(q *Query, all bool) {
+	q.loadAll = all
+}
+
 // SetLoadMods on the query.
 func SetLoadMods(q *Query, rel string, appl Applicator) {
 	if q.loadMods == nil {
@@ -251,6 +426,11 @@ func SetCount(q *Query) {
 	q.count = true
 }
 
+// SetExists on the query, wraps the select in EXISTS.
+func SetExists(q *Query) {
+	q.exists = true
+}
+
 // SetDelete on the query.
 func SetDelete(q *Query) {
 	q.delete = true
@@ -266,6 +446,27 @@ func SetOffset(q *Query, offset int) {
 	q.offset = offset
 }
 
+// SetMaxInListSize on the query. IN and NOT IN lists with more args than
+// this are split into multiple clauses, a size of 0 means no limit.
+func SetMaxInListSize(q *Query, size int) {
+	q.maxInListSize = size
+}
+
+// SetEmulateNullsOrdering on the query. When set and the dialect has no
+// support for NULLS FIRST/LAST in ORDER BY, each order by term that uses
+// them is rewritten so it sorts the same way, for example:
+// "a ASC NULLS LAST" becomes "a IS NULL, a ASC"
+func SetEmulateNullsOrdering(q *Query, emulate bool) {
+	q.emulateNullsOrdering = emulate
+}
+
+// SetBindLimitOffset on the query. When set and the dialect supports it
+// the limit and offset are passed as args instead of being written into
+// the sql, so a prepared statement can be reused for every page size.
+func SetBindLimitOffset(q *Query, bind bool) {
+	q.bindLimitOffset = bind
+}
+
 // SetFor on the query.
 func SetFor(q *Query, clause string) {
 	q.forlock = clause
@@ -276,16 +477,139 @@ func SetComment(q *Query, comment string) {
 	q.comment = comment
 }
 
+// SetReturning on the query. The columns are returned from a
+// delete or update query, the result can be scanned using Bind.
+// Building the query fails if the dialect doesn't support RETURNING.
+func SetReturning(q *Query, columns ...string) {
+	q.returning = append([]string(nil), columns...)
+}
+
+// SetInsert turns the query into an insert of one or more rows into the
+// query's table. Each row must have a value for every column, boil.Default
+// can be used as a value to insert the column's default.
+func SetInsert(q *Query, columns []string, rows ...[]interface{}) {
+	q.insertCols = append([]string(nil), columns...)
+	q.insertRows = append([][]interface{}(nil), rows...)
+}
+
+// SetConflict adds an ON CONFLICT clause to an insert query, with
+// columns as the conflict target. A where predicate with its args can be
+// given to match a partial unique index, it can be left empty otherwise.
+//
+// The values set with SetUpdate and SetUpdateExpr are used in the
+// DO UPDATE SET of the conflict, if there are none it will DO NOTHING.
+// Building the query fails if the dialect doesn't support ON CONFLICT.
+func SetConflict(q *Query, columns []string, where string, args ...interface{}) {
+	q.conflict = &conflict{
+		columns: append([]string(nil), columns...),
+		where:   where,
+		args:    args,
+	}
+}
+
 // SetUpdate on the query.
 func SetUpdate(q *Query, cols map[string]interface{}) {
 	q.update = cols
 }
 
+// SetMerge turns the query into a MERGE INTO the query's table using the
+// source and join condition given, the args are for the source and
+// condition. The actions are added with AppendMergeWhenMatched and
+// AppendMergeWhenNotMatched. The dialect must support MERGE
+// (see drivers.Dialect.UseMergeClause).
+func SetMerge(q *Query, using, on string, args ...interface{}) {
+	q.merge = &merge{using: using, on: on, args: args}
+}
+
+// AppendMergeWhenMatched adds a WHEN MATCHED THEN action to the merge,
+// for example: "UPDATE SET name = src.name" or "DELETE"
+func AppendMergeWhenMatched(q *Query, clause string, args ...interface{}) {
+	appendMergeWhen(q, true, clause, args)
+}
+
+// AppendMergeWhenNotMatched adds a WHEN NOT MATCHED THEN action to the
+// merge, for example: "INSERT (id, name) VALUES (src.id, src.name)"
+func AppendMergeWhenNotMatched(q *Query, clause string, args ...interface{}) {
+	appendMergeWhen(q, false, clause, args)
+}
+
+func appendMergeWhen(q *Query, matched bool, clause string, args []interface{}) {
+	if q.merge == nil {
+		if q.err == nil {
+			q.err = errors.New("merge actions require SetMerge to be called first")
+		}
+		return
+	}
+	q.merge.whens = append(q.merge.whens, mergeWhen{matched: matched, clause: clause, args: args})
+}
+
+// SetUpdateExpr sets col to the result of a sql expression in an update
+// query, for example SetUpdateExpr(q, "views", "views + ?", 1). It takes
+// precedence over a value for the same column given to SetUpdate.
+func SetUpdateExpr(q *Query, col, expr string, args ...interface{}) {
+	if q.updateExpr == nil {
+		q.updateExpr = make(map[string]argClause)
+	}
+
+	q.updateExpr[col] = argClause{clause: expr, args: args}
+}
+
 // AppendSelect on the query.
 func AppendSelect(q *Query, columns ...string) {
 	q.selectCols = append(q.selectCols, columns...)
 }
 
+// EnsureSelect appends the columns to the query's select list unless it
+// already selects them. A query without a select list selects everything so
+// it's left alone. Eager loading uses this to keep the columns it needs to
+// match up the loaded objects when a load is given its own qm.Select.
+func EnsureSelect(q *Query, columns ...string) {
+	if len(q.selectCols) == 0 {
+		return
+	}
+
+Columns:
+	for _, c := range columns {
+		for _, sel := range q.selectCols {
+			if selectsColumn(sel, c) {
+				continue Columns
+			}
+		}
+
+		q.selectCols = append(q.selectCols, c)
+	}
+}
+
+// selectsColumn checks if the select list entry sel covers the column col,
+// either of which can be quoted and qualified by its table.
+func selectsColumn(sel, col string) bool {
+	unquote := func(r rune) rune {
+		switch r {
+		case '"', '`', '[', ']':
+			return -1
+		}
+		return r
+	}
+
+	selTable, selName := splitColumn(strings.Map(unquote, sel))
+	table, name := splitColumn(strings.Map(unquote, col))
+
+	if selName != "*" && selName != name {
+		return false
+	}
+
+	return len(selTable) == 0 || selTable == table || strings.HasSuffix(table, "."+selTable)
+}
+
+// splitColumn splits a possibly qualified column into its table and name
+func splitColumn(col string) (table, name string) {
+	col = strings.TrimSpace(col)
+	if i := strings.LastIndexByte(col, '.'); i >= 0 {
+		return col[:i], col[i+1:]
+	}
+	return "", col
+}
+
 // AppendFrom on the query.
 func AppendFrom(q *Query, from ...string) {
 	q.from = append(q.from, from...)
@@ -316,6 +640,11 @@ func AppendFullOuterJoin(q *Query, clause string, args ...interface{}) {
 	q.joins = append(q.joins, join{clause: clause, kind: JoinOuterFull, args: args})
 }
 
+// AppendCrossJoin on the query.
+func AppendCrossJoin(q *Query, clause string, args ...interface{}) {
+	q.joins = append(q.joins, join{clause: clause, kind: JoinCross, args: args})
+}
+
 // AppendHaving on the query.
 func AppendHaving(q *Query, clause string, args ...interface{}) {
 	q.having = append(q.having, argClause{clause: clause, args: args})
@@ -323,17 +652,76 @@ func AppendHaving(q *Query, clause string, args ...interface{}) {
 
 // AppendWhere on the query.
 func AppendWhere(q *Query, clause string, args ...interface{}) {
-	q.where = append(q.where, where{clause: clause, args: args})
+	appendWhere(q, where{clause: clause, args: args})
+}
+
+// AppendSoftDeleteWhere on the query, the clause hides soft deleted rows
+// and is left out when SetIncludeDeleted is used.
+func AppendSoftDeleteWhere(q *Query, clause string, args ...interface{}) {
+	appendWhere(q, where{clause: clause, args: args, softDelete: true})
 }
 
-// AppendIn on the query.
+// SetIncludeDeleted on the query, soft deleted rows are no longer hidden.
+func SetIncludeDeleted(q *Query) {
+	q.includeDeleted = true
+}
+
+// AppendIn on the query, a single slice argument is expanded into its
+// elements.
 func AppendIn(q *Query, clause string, args ...interface{}) {
-	q.where = append(q.where, where{kind: whereKindIn, clause: clause, args: args})
+	appendWhere(q, where{kind: whereKindIn, clause: clause, args: inArgs(args)})
 }
 
-// AppendNotIn on the query.
+// AppendNotIn on the query, a single slice argument is expanded into its
+// elements.
 func AppendNotIn(q *Query, clause string, args ...interface{}) {
-	q.where = append(q.where, where{kind: whereKindNotIn, clause: clause, args: args})
+	appendWhere(q, where{kind: whereKindNotIn, clause: clause, args: inArgs(args)})
+}
+
+// SetWhereTimeRange adds a where clause that limits col to the times
+// between from and to. incFrom and incTo control whether the bounds
+// themselves are included, the half-open range [from, to) (incFrom true
+// and incTo false) is usually what is wanted so that consecutive ranges
+// don't count a row twice. A zero time leaves that side of the range open
+// and if both are zero no where clause is added.
+func SetWhereTimeRange(q *Query, col string, from, to time.Time, incFrom, incTo bool) {
+	var clauses []string
+	var args []interface{}
+
+	if !from.IsZero() {
+		op := ">"
+		if incFrom {
+			op = ">="
+		}
+		clauses = append(clauses, fmt.Sprintf("%s %s ?", col, op))
+		args = append(args, from)
+	}
+	if !to.IsZero() {
+		op := "<"
+		if incTo {
+			op = "<="
+		}
+		clauses = append(clauses, fmt.Sprintf("%s %s ?", col, op))
+		args = append(args, to)
+	}
+
+	if len(clauses) == 0 {
+		return
+	}
+
+	AppendWhere(q, strings.Join(clauses, " AND "), args...)
+}
+
+// SetWhereFullText adds a where clause that does a full text search of
+// the columns for query, using the dialect's full text search:
+//
+//   postgres: to_tsvector(coalesce("a", '') || ' ' || coalesce("b", '')) @@ plainto_tsquery($1)
+//   mysql:    MATCH(`a`, `b`) AGAINST(?)
+//   mssql:    FREETEXT(([a], [b]), $1)
+//
+// MySQL and mssql require a full text index on the columns.
+func SetWhereFullText(q *Query, cols []string, query string) {
+	appendWhere(q, where{kind: whereKindFullText, clause: strings.Join(cols, ","), args: []interface{}{query}})
 }
 
 // SetLastWhereAsOr sets the or separator for the tail "WHERE" in the slice
@@ -342,6 +730,11 @@ func SetLastWhereAsOr(q *Query) {
 		return
 	}
 
+	if q.whereTree == nil {
+		q.whereTree = buildWhereTree(q.where)
+	}
+	q.whereTree.setLastOr()
+
 	pos := len(q.where) - 1
 	where := q.where[pos]
 	if where.kind != whereKindRightParen {
@@ -349,6 +742,8 @@ func SetLastWhereAsOr(q *Query) {
 		return
 	}
 
+	// A ) without a matching ( is an error of the where tree, returned
+	// when the query is built
 	stack := 0
 	pos--
 	for ; pos >= 0; pos-- {
@@ -363,8 +758,6 @@ func SetLastWhereAsOr(q *Query) {
 			stack++
 		}
 	}
-
-	panic("could not find matching ( in where query expr")
 }
 
 // SetLastInAsOr is an alias for SetLastWhereAsOr
@@ -374,12 +767,12 @@ func SetLastInAsOr(q *Query) {
 
 // AppendWhereLeftParen creates a right left in the where expression
 func AppendWhereLeftParen(q *Query) {
-	q.where = append(q.where, where{kind: whereKindLeftParen})
+	appendWhere(q, where{kind: whereKindLeftParen})
 }
 
 // AppendWhereRightParen creates a right paren in the where expression
 func AppendWhereRightParen(q *Query) {
-	q.where = append(q.where, where{kind: whereKindRightParen})
+	appendWhere(q, where{kind: whereKindRightParen})
 }
 
 // AppendGroupBy on the query.
@@ -392,7 +785,25 @@ func AppendOrderBy(q *Query, clause string, args ...interface{}) {
 	q.orderBy = append(q.orderBy, argClause{clause: clause, args: args})
 }
 
+// SetOrderByRandom orders the results of the query randomly, after any
+// other order by clauses. The function used depends on the dialect, it's
+// RANDOM() for postgres and sqlite, RAND() for mysql and NEWID() for mssql.
+//
+// Combined with a limit this gives a random sample of the rows, but keep
+// in mind the database has to read and sort the whole table to do it
+// which is slow for big tables. Postgres and mssql have TABLESAMPLE
+// which is much faster when an approximate sample is good enough.
+func SetOrderByRandom(q *Query) {
+	q.orderByRandom = true
+}
+
 // AppendWith on the query.
 func AppendWith(q *Query, clause string, args ...interface{}) {
 	q.withs = append(q.withs, argClause{clause: clause, args: args})
 }
+
+// SetWith replaces the current with statements. The declared CTE can then
+// be selected from by name using SetFrom.
+func SetWith(q *Query, clause string, args ...interface{}) {
+	q.withs = []argClause{{clause: clause, args: args}}
+}
@@ -7,6 +7,9 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
 	"github.com/volatiletech/strmangle"
 )
 
@@ -14,43 +17,106 @@ var (
 	rgxIdentifier  = regexp.MustCompile(`^(?i)"?[a-z_][_a-z0-9]*"?(?:\."?[_a-z][_a-z0-9]*"?)*$`)
 	rgxInClause    = regexp.MustCompile(`^(?i)(.*[\s|\)|\?])IN([\s|\(|\?].*)$`)
 	rgxNotInClause = regexp.MustCompile(`^(?i)(.*[\s|\)|\?])NOT\s+IN([\s|\(|\?].*)$`)
+	rgxNullsOrder  = regexp.MustCompile(`^(?is)(.*?)(\s+(?:ASC|DESC))?\s+NULLS\s+(FIRST|LAST)$`)
 )
 
 // BuildQuery builds a query object into the query string
 // and it's accompanying arguments. Using this method
 // allows query building without immediate execution.
+//
+// A query that can't be built, like one whose where clauses have
+// unbalanced parens, gives an empty string. Running the query returns
+// the reason as an error.
 func BuildQuery(q *Query) (string, []interface{}) {
+	query, args, _ := buildQuery(q)
+	return query, args
+}
+
+// buildQuery builds the query like BuildQuery, returning an error if it
+// can't be built or if boil.StrictMode is enabled and it fails validation
+func buildQuery(q *Query) (string, []interface{}, error) {
 	var buf *bytes.Buffer
 	var args []interface{}
 
+	if err := validateStrict(q); err != nil {
+		return "", nil, err
+	}
+
+	if len(q.rawSQL.sql) != 0 {
+		return q.rawSQL.sql, q.rawSQL.args, nil
+	}
+
+	if q.err != nil {
+		return "", nil, q.err
+	}
+	if q.whereTree == nil {
+		q.whereTree = buildWhereTree(q.where)
+	}
+	if err := q.whereTree.check(); err != nil {
+		return "", nil, err
+	}
+	if err := checkDialect(q); err != nil {
+		return "", nil, err
+	}
+
+	var err error
 	switch {
-	case len(q.rawSQL.sql) != 0:
-		return q.rawSQL.sql, q.rawSQL.args
+	case q.merge != nil:
+		buf, args = buildMergeQuery(q)
 	case q.delete:
 		buf, args = buildDeleteQuery(q)
-	case len(q.update) > 0:
+	case len(q.insertCols) > 0, len(q.insertRows) > 0:
+		buf, args, err = buildInsertQuery(q)
+	case len(q.update) > 0, len(q.updateExpr) > 0:
 		buf, args = buildUpdateQuery(q)
 	default:
-		buf, args = buildSelectQuery(q)
+		buf, args, err = buildSelectQuery(q)
 	}
 
 	defer strmangle.PutBuffer(buf)
+	if err != nil {
+		return "", nil, err
+	}
 
 	// Cache the generated query for query object re-use
 	bufStr := buf.String()
 	q.rawSQL.sql = bufStr
 	q.rawSQL.args = args
 
-	return bufStr, args
+	return bufStr, args, nil
 }
 
-func buildSelectQuery(q *Query) (*bytes.Buffer, []interface{}) {
+// checkDialect returns an error if the query uses a clause that the
+// dialect doesn't support
+func checkDialect(q *Query) error {
+	if len(q.returning) != 0 && !q.dialect.UseReturningClause {
+		return errors.New("RETURNING is not supported by this dialect")
+	}
+	if q.conflict != nil && !q.dialect.UseOnConflictClause {
+		return errors.New("ON CONFLICT is not supported by this dialect")
+	}
+	if q.merge != nil && !q.dialect.UseMergeClause {
+		return errors.New("MERGE is not supported by this dialect")
+	}
+
+	return nil
+}
+
+func buildSelectQuery(q *Query) (*bytes.Buffer, []interface{}, error) {
 	buf := strmangle.GetBuffer()
 	var args []interface{}
 
 	writeComment(q, buf)
 	writeCTEs(q, buf, &args)
 
+	if q.exists {
+		if q.dialect.UseCaseWhenExistsClause {
+			buf.WriteString("SELECT CASE WHEN EXISTS(")
+		} else {
+			buf.WriteString("SELECT EXISTS(")
+		}
+	}
+
 	buf.WriteString("SELECT ")
 
 	if q.dialect.UseTopClause {
@@ -76,7 +142,10 @@ func buildSelectQuery(q *Query) (*bytes.Buffer, []interface{}) {
 			buf.WriteString(")")
 		}
 	} else if hasJoins && hasSelectCols && !q.count {
-		selectColsWithAs := writeAsStatements(q)
+		selectColsWithAs, err := writeAsStatements(q)
+		if err != nil {
+			return buf, nil, err
+		}
 		// Don't identQuoteSlice - writeAsStatements does this
 		buf.WriteString(strings.Join(selectColsWithAs, ", "))
 	} else if hasSelectCols {
@@ -108,6 +177,8 @@ func buildSelectQuery(q *Query) (*bytes.Buffer, []interface{}) {
 				fmt.Fprintf(joinBuf, " RIGHT JOIN %s", j.clause)
 			case JoinOuterFull:
 				fmt.Fprintf(joinBuf, " FULL JOIN %s", j.clause)
+			case JoinCross:
+				fmt.Fprintf(joinBuf, " CROSS JOIN %s", j.clause)
 			default:
 				panic(fmt.Sprintf("Unsupported join of kind %v", j.kind))
 			}
@@ -131,8 +202,16 @@ func buildSelectQuery(q *Query) (*bytes.Buffer, []interface{}) {
 
 	writeModifiers(q, buf, &args)
 
+	if q.exists {
+		if q.dialect.UseCaseWhenExistsClause {
+			buf.WriteString(") THEN 1 ELSE 0 END")
+		} else {
+			buf.WriteByte(')')
+		}
+	}
+
 	buf.WriteByte(';')
-	return buf, args
+	return buf, args, nil
 }
 
 func buildDeleteQuery(q *Query) (*bytes.Buffer, []interface{}) {
@@ -145,49 +224,90 @@ func buildDeleteQuery(q *Query) (*bytes.Buffer, []interface{}) {
 	buf.WriteString("DELETE FROM ")
 	buf.WriteString(strings.Join(strmangle.IdentQuoteSlice(q.dialect.LQ, q.dialect.RQ, q.from), ", "))
 
-	where, whereArgs := whereClause(q, 1)
+	where, whereArgs := whereClause(q, len(args)+1)
 	if len(whereArgs) != 0 {
 		args = append(args, whereArgs...)
 	}
 	buf.WriteString(where)
 
 	writeModifiers(q, buf, &args)
+	writeReturning(q, buf)
 
 	buf.WriteByte(';')
 
 	return buf, args
 }
 
-func buildUpdateQuery(q *Query) (*bytes.Buffer, []interface{}) {
+func buildInsertQuery(q *Query) (*bytes.Buffer, []interface{}, error) {
 	buf := strmangle.GetBuffer()
 	var args []interface{}
 
 	writeComment(q, buf)
 	writeCTEs(q, buf, &args)
 
-	buf.WriteString("UPDATE ")
+	buf.WriteString("INSERT INTO ")
 	buf.WriteString(strings.Join(strmangle.IdentQuoteSlice(q.dialect.LQ, q.dialect.RQ, q.from), ", "))
 
-	cols := make(sort.StringSlice, len(q.update))
+	if len(q.insertCols) == 0 {
+		if q.dialect.UseDefaultKeyword {
+			buf.WriteString(" DEFAULT VALUES")
+		} else {
+			buf.WriteString(" () VALUES ()")
+		}
+	} else {
+		if len(q.insertRows) == 0 {
+			return buf, nil, errors.New("insert has columns but no rows")
+		}
 
-	count := 0
-	for name := range q.update {
-		cols[count] = name
-		count++
-	}
+		fmt.Fprintf(buf, " (%s) VALUES ", strings.Join(strmangle.IdentQuoteSlice(q.dialect.LQ, q.dialect.RQ, q.insertCols), ", "))
 
-	cols.Sort()
+		for i, row := range q.insertRows {
+			if len(row) != len(q.insertCols) {
+				return buf, nil, errors.Errorf("insert row %d has %d values but there are %d columns", i, len(row), len(q.insertCols))
+			}
 
-	for i := 0; i < len(cols); i++ {
-		args = append(args, q.update[cols[i]])
-		cols[i] = strmangle.IdentQuote(q.dialect.LQ, q.dialect.RQ, cols[i])
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteByte('(')
+			for j, val := range row {
+				if j > 0 {
+					buf.WriteByte(',')
+				}
+				if boil.IsDefault(val) {
+					buf.WriteString("DEFAULT")
+					continue
+				}
+				args = append(args, val)
+				buf.WriteString(strmangle.Placeholders(q.dialect.UseIndexPlaceholders, 1, len(args), 1))
+			}
+			buf.WriteByte(')')
+		}
 	}
 
-	setSlice := make([]string, len(cols))
-	for index, col := range cols {
-		setSlice[index] = fmt.Sprintf("%s = %s", col, strmangle.Placeholders(q.dialect.UseIndexPlaceholders, 1, index+1, 1))
+	if q.conflict != nil {
+		writeConflict(q, buf, &args)
 	}
-	fmt.Fprintf(buf, " SET %s", strings.Join(setSlice, ", "))
+
+	writeReturning(q, buf)
+
+	buf.WriteByte(';')
+
+	return buf, args, nil
+}
+
+func buildUpdateQuery(q *Query) (*bytes.Buffer, []interface{}) {
+	buf := strmangle.GetBuffer()
+	var args []interface{}
+
+	writeComment(q, buf)
+	writeCTEs(q, buf, &args)
+
+	buf.WriteString("UPDATE ")
+	buf.WriteString(strings.Join(strmangle.IdentQuoteSlice(q.dialect.LQ, q.dialect.RQ, q.from), ", "))
+
+	buf.WriteString(" SET ")
+	writeSetClause(q, buf, &args)
 
 	where, whereArgs := whereClause(q, len(args)+1)
 	if len(whereArgs) != 0 {
@@ -196,12 +316,123 @@ func buildUpdateQuery(q *Query) (*bytes.Buffer, []interface{}) {
 	buf.WriteString(where)
 
 	writeModifiers(q, buf, &args)
+	writeReturning(q, buf)
 
 	buf.WriteByte(';')
 
 	return buf, args
 }
 
+func buildMergeQuery(q *Query) (*bytes.Buffer, []interface{}) {
+	buf := strmangle.GetBuffer()
+	var args []interface{}
+
+	writeComment(q, buf)
+	writeCTEs(q, buf, &args)
+
+	argsLen := len(args)
+	mergeBuf := strmangle.GetBuffer()
+
+	mergeBuf.WriteString("MERGE INTO ")
+	mergeBuf.WriteString(strings.Join(strmangle.IdentQuoteSlice(q.dialect.LQ, q.dialect.RQ, q.from), ", "))
+	fmt.Fprintf(mergeBuf, " USING %s ON %s", q.merge.using, q.merge.on)
+	args = append(args, q.merge.args...)
+
+	for _, w := range q.merge.whens {
+		if w.matched {
+			mergeBuf.WriteString(" WHEN MATCHED THEN ")
+		} else {
+			mergeBuf.WriteString(" WHEN NOT MATCHED THEN ")
+		}
+		mergeBuf.WriteString(w.clause)
+		args = append(args, w.args...)
+	}
+
+	if q.dialect.UseIndexPlaceholders {
+		resp, _ := convertQuestionMarks(mergeBuf.String(), argsLen+1)
+		buf.WriteString(resp)
+	} else {
+		buf.Write(mergeBuf.Bytes())
+	}
+	strmangle.PutBuffer(mergeBuf)
+
+	buf.WriteByte(';')
+
+	return buf, args
+}
+
+// writeConflict writes the ON CONFLICT clause of an insert. The args of
+// the conflict target's predicate come after the inserted values and
+// before the args of the DO UPDATE SET.
+func writeConflict(q *Query, buf *bytes.Buffer, args *[]interface{}) {
+	buf.WriteString(" ON CONFLICT")
+	if len(q.conflict.columns) != 0 {
+		fmt.Fprintf(buf, " (%s)", strings.Join(strmangle.IdentQuoteSlice(q.dialect.LQ, q.dialect.RQ, q.conflict.columns), ", "))
+	}
+
+	if len(q.conflict.where) != 0 {
+		where := q.conflict.where
+		if q.dialect.UseIndexPlaceholders {
+			where, _ = convertQuestionMarks(where, len(*args)+1)
+		}
+		buf.WriteString(" WHERE ")
+		buf.WriteString(where)
+		*args = append(*args, q.conflict.args...)
+	}
+
+	if len(q.update) == 0 && len(q.updateExpr) == 0 {
+		buf.WriteString(" DO NOTHING")
+		return
+	}
+
+	buf.WriteString(" DO UPDATE SET ")
+	writeSetClause(q, buf, args)
+}
+
+// writeSetClause writes the columns and values of an update, it's used by
+// both updates and the DO UPDATE of an insert's ON CONFLICT.
+func writeSetClause(q *Query, buf *bytes.Buffer, args *[]interface{}) {
+	cols := make(sort.StringSlice, 0, len(q.update)+len(q.updateExpr))
+	for name := range q.update {
+		if _, ok := q.updateExpr[name]; !ok {
+			cols = append(cols, name)
+		}
+	}
+	for name := range q.updateExpr {
+		cols = append(cols, name)
+	}
+
+	cols.Sort()
+
+	// Columns are written in sorted order regardless of whether they're
+	// set to a value or an expression so the args are always in the
+	// same order as their placeholders
+	setSlice := make([]string, len(cols))
+	for index, name := range cols {
+		col := strmangle.IdentQuote(q.dialect.LQ, q.dialect.RQ, name)
+
+		expr, ok := q.updateExpr[name]
+		if !ok {
+			val := q.update[name]
+			if boil.IsDefault(val) {
+				setSlice[index] = fmt.Sprintf("%s = DEFAULT", col)
+				continue
+			}
+			*args = append(*args, val)
+			setSlice[index] = fmt.Sprintf("%s = %s", col, strmangle.Placeholders(q.dialect.UseIndexPlaceholders, 1, len(*args), 1))
+			continue
+		}
+
+		clause := expr.clause
+		if q.dialect.UseIndexPlaceholders {
+			clause, _ = convertQuestionMarks(clause, len(*args)+1)
+		}
+		*args = append(*args, expr.args...)
+		setSlice[index] = fmt.Sprintf("%s = %s", col, clause)
+	}
+	buf.WriteString(strings.Join(setSlice, ", "))
+}
+
 func writeParameterizedModifiers(q *Query, buf *bytes.Buffer, args *[]interface{}, keyword, delim string, clauses []argClause) {
 	argsLen := len(*args)
 	modBuf := strmangle.GetBuffer()
@@ -235,11 +466,33 @@ func writeModifiers(q *Query, buf *bytes.Buffer, args *[]interface{}) {
 		writeParameterizedModifiers(q, buf, args, " HAVING ", " AND ", q.having)
 	}
 
-	if len(q.orderBy) != 0 {
-		writeParameterizedModifiers(q, buf, args, " ORDER BY ", ", ", q.orderBy)
+	// The order of rows doesn't matter when counting them or checking that
+	// they exist
+	var orderBy []argClause
+	if !q.count && !q.exists {
+		orderBy = q.orderBy
+		if q.emulateNullsOrdering && !q.dialect.UseNullsOrdering {
+			orderBy = emulateNullsOrdering(q, orderBy)
+		}
+		if q.orderByRandom {
+			orderBy = append(orderBy[:len(orderBy):len(orderBy)], argClause{clause: randomFunction(q)})
+		}
 	}
+	if len(orderBy) != 0 {
+		writeParameterizedModifiers(q, buf, args, " ORDER BY ", ", ", orderBy)
+	}
+
+	if q.bindLimitOffset && q.dialect.UseBoundLimitOffset {
+		if q.limit != 0 {
+			buf.WriteString(" LIMIT ")
+			writeModifierArg(q, buf, args, q.limit)
+		}
 
-	if !q.dialect.UseTopClause {
+		if q.offset != 0 {
+			buf.WriteString(" OFFSET ")
+			writeModifierArg(q, buf, args, q.offset)
+		}
+	} else if !q.dialect.UseTopClause {
 		if q.limit != 0 {
 			fmt.Fprintf(buf, " LIMIT %d", q.limit)
 		}
@@ -259,7 +512,7 @@ func writeModifiers(q *Query, buf *bytes.Buffer, args *[]interface{}) {
 			// As mentioned, the OFFSET-FETCH filter requires an ORDER BY clause. If you want to use arbitrary order,
 			// like TOP without an ORDER BY clause, you can use the trick with ORDER BY (SELECT NULL)
 			// ...
-			if len(q.orderBy) == 0 {
+			if len(orderBy) == 0 {
 				buf.WriteString(" ORDER BY (SELECT NULL)")
 			}
 
@@ -281,6 +534,102 @@ func writeModifiers(q *Query, buf *bytes.Buffer, args *[]interface{}) {
 	}
 }
 
+// writeModifierArg writes a placeholder for arg and appends it to args
+func writeModifierArg(q *Query, buf *bytes.Buffer, args *[]interface{}, arg interface{}) {
+	*args = append(*args, arg)
+	if q.dialect.UseIndexPlaceholders {
+		fmt.Fprintf(buf, "$%d", len(*args))
+	} else {
+		buf.WriteByte('?')
+	}
+}
+
+func writeReturning(q *Query, buf *bytes.Buffer) {
+	if len(q.returning) == 0 {
+		return
+	}
+
+	buf.WriteString(" RETURNING ")
+	buf.WriteString(strings.Join(strmangle.IdentQuoteSlice(q.dialect.LQ, q.dialect.RQ, q.returning), ", "))
+}
+
+// randomFunction returns the dialect's function for a random order
+func randomFunction(q *Query) string {
+	switch {
+	case q.dialect.UseRandFunction:
+		return "RAND()"
+	case q.dialect.UseTopClause:
+		return "NEWID()"
+	default:
+		return "RANDOM()"
+	}
+}
+
+// emulateNullsOrdering rewrites the order by terms that use NULLS FIRST or
+// NULLS LAST into a term that sorts the NULLs followed by the original
+// term. Terms with args are left alone since the expression would have
+// to be repeated.
+func emulateNullsOrdering(q *Query, orderBy []argClause) []argClause {
+	emulated := make([]argClause, len(orderBy))
+	for i, o := range orderBy {
+		emulated[i] = o
+		if len(o.args) != 0 {
+			continue
+		}
+
+		terms := splitTopLevel(o.clause, ',')
+		for j, term := range terms {
+			trimmed := strings.TrimSpace(term)
+			matches := rgxNullsOrder.FindStringSubmatch(trimmed)
+			if matches == nil {
+				continue
+			}
+
+			lead := term[:strings.Index(term, trimmed)]
+			expr, dir := matches[1], matches[2]
+			nullsLast := strings.EqualFold(matches[3], "LAST")
+			switch {
+			case q.dialect.UseTopClause:
+				// T-SQL can't order by a boolean expression
+				if nullsLast {
+					terms[j] = fmt.Sprintf("%sCASE WHEN %s IS NULL THEN 1 ELSE 0 END, %s%s", lead, expr, expr, dir)
+				} else {
+					terms[j] = fmt.Sprintf("%sCASE WHEN %s IS NULL THEN 0 ELSE 1 END, %s%s", lead, expr, expr, dir)
+				}
+			case nullsLast:
+				terms[j] = fmt.Sprintf("%s%s IS NULL, %s%s", lead, expr, expr, dir)
+			default:
+				terms[j] = fmt.Sprintf("%s%s IS NOT NULL, %s%s", lead, expr, expr, dir)
+			}
+		}
+
+		emulated[i].clause = strings.Join(terms, ",")
+	}
+
+	return emulated
+}
+
+// splitTopLevel splits s on sep where sep is not inside of parens
+func splitTopLevel(s string, sep byte) []string {
+	var parts []string
+	depth, last := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case sep:
+			if depth == 0 {
+				parts = append(parts, s[last:i])
+				last = i + 1
+			}
+		}
+	}
+
+	return append(parts, s[last:])
+}
+
 func writeStars(q *Query) []string {
 	cols := make([]string, len(q.from))
 	for i, f := range q.from {
@@ -304,7 +653,9 @@ func writeStars(q *Query) []string {
 	return cols
 }
 
-func writeAsStatements(q *Query) []string {
+// writeAsStatements quotes the select columns, those qualified with their
+// table are given an alias of "table.column" so they can be bound
+func writeAsStatements(q *Query) ([]string, error) {
 	cols := make([]string, len(q.selectCols))
 	for i, col := range q.selectCols {
 		if !rgxIdentifier.MatchString(col) {
@@ -323,139 +674,238 @@ func writeAsStatements(q *Query) []string {
 			asParts[j] = strings.Trim(tok, `"`)
 		}
 
-		cols[i] = fmt.Sprintf(`%s as "%s"`, strmangle.IdentQuote(q.dialect.LQ, q.dialect.RQ, col), strings.Join(asParts, "."))
+		alias, err := boil.QuoteIdentifier(quoteDialect(q.dialect), strings.Join(asParts, "."))
+		if err != nil {
+			return nil, err
+		}
+		cols[i] = fmt.Sprintf(`%s as %s`, strmangle.IdentQuote(q.dialect.LQ, q.dialect.RQ, col), alias)
 	}
 
-	return cols
+	return cols, nil
 }
 
-// whereClause parses a where slice and converts it into a
-// single WHERE clause like:
+// quoteDialect is the dialect boil.QuoteIdentifier quotes identifiers of d
+// with, dialects quoting like postgres are treated as postgres
+func quoteDialect(d *drivers.Dialect) string {
+	switch d.LQ {
+	case '`':
+		return "mysql"
+	case '[':
+		return "mssql"
+	default:
+		return "psql"
+	}
+}
+
+// whereClause writes the where tree of the query out as a single WHERE
+// clause like:
 // WHERE (a=$1) AND (b=$2) AND (a,b) in (($3, $4), ($5, $6))
 //
 // startAt specifies what number placeholders start at
 func whereClause(q *Query, startAt int) (string, []interface{}) {
-	if len(q.where) == 0 {
-		return "", nil
+	if q.whereTree == nil {
+		q.whereTree = buildWhereTree(q.where)
 	}
 
-	manualParens := false
-ManualParen:
-	for _, w := range q.where {
-		switch w.kind {
-		case whereKindLeftParen, whereKindRightParen:
-			manualParens = true
-			break ManualParen
-		}
+	root := q.whereTree.root
+	if whereNodeEmpty(q, root) {
+		return "", nil
 	}
 
 	buf := strmangle.GetBuffer()
 	defer strmangle.PutBuffer(buf)
 	var args []interface{}
 
-	notFirstExpression := false
 	buf.WriteString(" WHERE ")
-	for _, where := range q.where {
-		if notFirstExpression && where.kind != whereKindRightParen {
-			if where.orSeparator {
-				buf.WriteString(" OR ")
-			} else {
-				buf.WriteString(" AND ")
+	writeWhereNode(q, buf, root, whereNodeHasGroup(q, root), startAt, &args)
+
+	return buf.String(), args
+}
+
+// whereNodeEmpty is true if nothing is written out for the node, which is
+// the case when it only has soft delete clauses and the query includes
+// deleted rows
+func whereNodeEmpty(q *Query, node *whereNode) bool {
+	if node.kind == whereNodeLeaf {
+		return q.includeDeleted && node.where.softDelete
+	}
+
+	for _, child := range node.children {
+		if !whereNodeEmpty(q, child) {
+			return false
+		}
+	}
+	return true
+}
+
+// whereNodeHasGroup is true if a group is written out for the node or one
+// of its children, in which case the user is managing the parens and the
+// expressions aren't wrapped in parens of their own
+func whereNodeHasGroup(q *Query, node *whereNode) bool {
+	switch node.kind {
+	case whereNodeLeaf:
+		return false
+	case whereNodeGroup:
+		return !whereNodeEmpty(q, node)
+	}
+
+	for _, child := range node.children {
+		if whereNodeHasGroup(q, child) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeWhereNode writes out a node of the where tree and its children,
+// it returns the number the next placeholder should start at.
+//
+// Since AND has a higher precedence than OR, neither of them need to be
+// wrapped in parens. Only groups, which were explicitly created by the
+// user, are parenthesized.
+func writeWhereNode(q *Query, buf *bytes.Buffer, node *whereNode, manualParens bool, startAt int, args *[]interface{}) int {
+	switch node.kind {
+	case whereNodeLeaf:
+		return writeWhere(q, buf, node.where, manualParens, startAt, args)
+	case whereNodeGroup:
+		buf.WriteByte('(')
+		for _, child := range node.children {
+			startAt = writeWhereNode(q, buf, child, manualParens, startAt, args)
+		}
+		buf.WriteByte(')')
+	case whereNodeAnd, whereNodeOr:
+		first := true
+		for _, child := range node.children {
+			if whereNodeEmpty(q, child) {
+				continue
 			}
+			if !first {
+				if node.kind == whereNodeOr {
+					buf.WriteString(" OR ")
+				} else {
+					buf.WriteString(" AND ")
+				}
+			}
+			first = false
+			startAt = writeWhereNode(q, buf, child, manualParens, startAt, args)
+		}
+	}
+
+	return startAt
+}
+
+// writeWhere writes out a single where expression, it returns the number
+// the next placeholder should start at.
+func writeWhere(q *Query, buf *bytes.Buffer, where where, manualParens bool, startAt int, args *[]interface{}) int {
+	switch where.kind {
+	case whereKindNormal:
+		if !manualParens {
+			buf.WriteByte('(')
+		}
+		if q.dialect.UseIndexPlaceholders {
+			replaced, n := convertQuestionMarks(where.clause, startAt)
+			buf.WriteString(replaced)
+			startAt += n
+		} else {
+			buf.WriteString(where.clause)
+		}
+		if !manualParens {
+			buf.WriteByte(')')
+		}
+		*args = append(*args, where.args...)
+	case whereKindIn, whereKindNotIn:
+		ln := len(where.args)
+		// WHERE IN () is invalid sql, so it is difficult to simply run code like:
+		// for _, u := range model.Users(qm.WhereIn("id IN ?",uids...)).AllP(db) {
+		//    ...
+		// }
+		// instead when we see empty IN we produce 1=0 so it can still be chained
+		// with other queries
+		if ln == 0 {
+			if where.kind == whereKindIn {
+				buf.WriteString("(1=0)")
+			} else if where.kind == whereKindNotIn {
+				buf.WriteString("(1=1)")
+			}
+			break
+		}
+
+		var matches []string
+		if where.kind == whereKindIn {
+			matches = rgxInClause.FindStringSubmatch(where.clause)
 		} else {
-			notFirstExpression = true
+			matches = rgxNotInClause.FindStringSubmatch(where.clause)
 		}
 
-		switch where.kind {
-		case whereKindNormal:
+		// If we can't find any matches attempt a simple replace with 1 group.
+		// Clauses that fit this criteria will not be able to contain ? in their
+		// column name side, however if this case is being hit then the regexp
+		// probably needs adjustment, or the user is passing in invalid clauses.
+		if matches == nil {
+			clause, count := convertInQuestionMarks(q.dialect.UseIndexPlaceholders, where.clause, startAt, 1, ln)
 			if !manualParens {
 				buf.WriteByte('(')
 			}
-			if q.dialect.UseIndexPlaceholders {
-				replaced, n := convertQuestionMarks(where.clause, startAt)
-				buf.WriteString(replaced)
-				startAt += n
-			} else {
-				buf.WriteString(where.clause)
-			}
+			buf.WriteString(clause)
 			if !manualParens {
 				buf.WriteByte(')')
 			}
-			args = append(args, where.args...)
-		case whereKindLeftParen:
-			buf.WriteByte('(')
-			notFirstExpression = false
-		case whereKindRightParen:
-			buf.WriteByte(')')
-		case whereKindIn, whereKindNotIn:
-			ln := len(where.args)
-			// WHERE IN () is invalid sql, so it is difficult to simply run code like:
-			// for _, u := range model.Users(qm.WhereIn("id IN ?",uids...)).AllP(db) {
-			//    ...
-			// }
-			// instead when we see empty IN we produce 1=0 so it can still be chained
-			// with other queries
-			if ln == 0 {
-				if where.kind == whereKindIn {
-					buf.WriteString("(1=0)")
-				} else if where.kind == whereKindNotIn {
-					buf.WriteString("(1=1)")
+			*args = append(*args, where.args...)
+			startAt += count
+			break
+		}
+
+		leftSide := strings.TrimSpace(matches[1])
+		rightSide := strings.TrimSpace(matches[2])
+		// If matches are found, we have to parse the left side (column side)
+		// of the clause to determine how many columns they are using.
+		// This number determines the groupAt for the convert function.
+		cols := strings.Split(leftSide, ",")
+		cols = strmangle.IdentQuoteSlice(q.dialect.LQ, q.dialect.RQ, cols)
+		groupAt := len(cols)
+
+		var leftClause string
+		var leftCount int
+		if q.dialect.UseIndexPlaceholders {
+			leftClause, leftCount = convertQuestionMarks(strings.Join(cols, ","), startAt)
+		} else {
+			// Count the number of cols that are question marks, so we know
+			// how much to offset convertInQuestionMarks by
+			for _, v := range cols {
+				if v == "?" {
+					leftCount++
 				}
-				break
 			}
+			leftClause = strings.Join(cols, ",")
+		}
 
-			var matches []string
-			if where.kind == whereKindIn {
-				matches = rgxInClause.FindStringSubmatch(where.clause)
-			} else {
-				matches = rgxNotInClause.FindStringSubmatch(where.clause)
+		// Lists longer than the maximum size are split into several IN
+		// clauses joined by OR (or NOT IN clauses joined by AND) for
+		// databases that limit the number of items in one IN list, like
+		// Oracle's 1000. The args still count towards the limit on bind
+		// parameters of the whole query, this doesn't help with that.
+		// This is only possible when there are no args in the left side.
+		rightLen := ln - leftCount
+		chunkSize := rightLen
+		if q.maxInListSize > 0 && leftCount == 0 && rightLen > q.maxInListSize {
+			chunkSize = q.maxInListSize - q.maxInListSize%groupAt
+			if chunkSize == 0 {
+				chunkSize = groupAt
 			}
+		}
+		chunked := chunkSize < rightLen
 
-			// If we can't find any matches attempt a simple replace with 1 group.
-			// Clauses that fit this criteria will not be able to contain ? in their
-			// column name side, however if this case is being hit then the regexp
-			// probably needs adjustment, or the user is passing in invalid clauses.
-			if matches == nil {
-				clause, count := convertInQuestionMarks(q.dialect.UseIndexPlaceholders, where.clause, startAt, 1, ln)
-				if !manualParens {
-					buf.WriteByte('(')
-				}
-				buf.WriteString(clause)
-				if !manualParens {
-					buf.WriteByte(')')
-				}
-				args = append(args, where.args...)
-				startAt += count
-				break
+		if !manualParens || chunked {
+			buf.WriteByte('(')
+		}
+		startAt += leftCount
+		for done := 0; ; {
+			n := chunkSize
+			if rightLen-done < n {
+				n = rightLen - done
 			}
 
-			leftSide := strings.TrimSpace(matches[1])
-			rightSide := strings.TrimSpace(matches[2])
-			// If matches are found, we have to parse the left side (column side)
-			// of the clause to determine how many columns they are using.
-			// This number determines the groupAt for the convert function.
-			cols := strings.Split(leftSide, ",")
-			cols = strmangle.IdentQuoteSlice(q.dialect.LQ, q.dialect.RQ, cols)
-			groupAt := len(cols)
-
-			var leftClause string
-			var leftCount int
-			if q.dialect.UseIndexPlaceholders {
-				leftClause, leftCount = convertQuestionMarks(strings.Join(cols, ","), startAt)
-			} else {
-				// Count the number of cols that are question marks, so we know
-				// how much to offset convertInQuestionMarks by
-				for _, v := range cols {
-					if v == "?" {
-						leftCount++
-					}
-				}
-				leftClause = strings.Join(cols, ",")
-			}
-			rightClause, rightCount := convertInQuestionMarks(q.dialect.UseIndexPlaceholders, rightSide, startAt+leftCount, groupAt, ln-leftCount)
-			if !manualParens {
-				buf.WriteByte('(')
-			}
+			rightClause, rightCount := convertInQuestionMarks(q.dialect.UseIndexPlaceholders, rightSide, startAt, groupAt, n)
 			buf.WriteString(leftClause)
 			if where.kind == whereKindIn {
 				buf.WriteString(" IN ")
@@ -463,17 +913,53 @@ ManualParen:
 				buf.WriteString(" NOT IN ")
 			}
 			buf.WriteString(rightClause)
-			if !manualParens {
-				buf.WriteByte(')')
+			startAt += rightCount
+
+			done += n
+			if done >= rightLen {
+				break
+			}
+			if where.kind == whereKindIn {
+				buf.WriteString(" OR ")
+			} else {
+				buf.WriteString(" AND ")
 			}
-			startAt += leftCount + rightCount
-			args = append(args, where.args...)
+		}
+		if !manualParens || chunked {
+			buf.WriteByte(')')
+		}
+		*args = append(*args, where.args...)
+	case whereKindFullText:
+		if !manualParens {
+			buf.WriteByte('(')
+		}
+		cols := strmangle.IdentQuoteSlice(q.dialect.LQ, q.dialect.RQ, strings.Split(where.clause, ","))
+		placeholder := strmangle.Placeholders(q.dialect.UseIndexPlaceholders, 1, startAt, 1)
+		switch {
+		case q.dialect.UseMatchAgainst:
+			fmt.Fprintf(buf, "MATCH(%s) AGAINST(%s)", strings.Join(cols, ", "), placeholder)
+		case q.dialect.UseTopClause:
+			fmt.Fprintf(buf, "FREETEXT((%s), %s)", strings.Join(cols, ", "), placeholder)
 		default:
-			panic("unknown where type")
+			if len(cols) > 1 {
+				for i, col := range cols {
+					cols[i] = fmt.Sprintf("coalesce(%s, '')", col)
+				}
+			}
+			fmt.Fprintf(buf, "to_tsvector(%s) @@ plainto_tsquery(%s)", strings.Join(cols, " || ' ' || "), placeholder)
+		}
+		if !manualParens {
+			buf.WriteByte(')')
 		}
+		if q.dialect.UseIndexPlaceholders {
+			startAt++
+		}
+		*args = append(*args, where.args...)
+	default:
+		panic("unknown where type")
 	}
 
-	return buf.String(), args
+	return startAt
 }
 
 // convertInQuestionMarks finds the first unescaped occurrence of ? and swaps it
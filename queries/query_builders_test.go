@@ -5,11 +5,16 @@ import (
 	"flag"
 	"fmt"
 	"io/ioutil"
+	"math/rand"
 	"path/filepath"
 	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/drivers"
 )
 
@@ -124,12 +129,159 @@ func TestBuildQuery(t *testing.T) {
 		{&Query{from: []string{"t"}, distinct: "id", count: true}, nil},
 		{&Query{from: []string{"t"}, distinct: "id, t.*", joins: []join{{JoinInner, "dogs d on d.cat_id = t.id", nil}}}, nil},
 		{&Query{from: []string{"t"}, distinct: "id, t.*", count: true, joins: []join{{JoinInner, "dogs d on d.cat_id = t.id", nil}}}, nil},
+		{&Query{
+			dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true, UseMergeClause: true},
+			from:    []string{"t"},
+			merge: &merge{
+				using: "(SELECT ? AS id, ? AS name) AS src",
+				on:    "t.id = src.id",
+				args:  []interface{}{1, "cat"},
+				whens: []mergeWhen{
+					{matched: true, clause: "UPDATE SET name = src.name, updated_at = ?", args: []interface{}{"now"}},
+					{matched: false, clause: "INSERT (id, name) VALUES (src.id, src.name)"},
+				},
+			},
+		}, []interface{}{1, "cat", "now"}},
+		{&Query{dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true, UseReturningClause: true}, from: []string{"t"}, delete: true, where: []where{{clause: "a < ?", args: []interface{}{5}}}, returning: []string{"*"}}, []interface{}{5}},
+		{&Query{dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true, UseReturningClause: true}, from: []string{"t"}, update: map[string]interface{}{"a": 1}, returning: []string{"id", "t.a"}}, []interface{}{1}},
+		{&Query{
+			from:          []string{"t"},
+			maxInListSize: 3,
+			where: []where{
+				{clause: "a=?", args: []interface{}{1}},
+				{kind: whereKindIn, clause: "b in ?", args: []interface{}{2, 3, 4, 5, 6}},
+				{kind: whereKindNotIn, clause: "(c,d) not in ?", args: []interface{}{7, 8, 9, 10, 11, 12}},
+				{kind: whereKindIn, clause: "e in ?", args: []interface{}{13, 14}},
+			},
+		}, []interface{}{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14}},
+		{&Query{
+			from:   []string{"t"},
+			update: map[string]interface{}{"a": 1, "c": 2, "views": 100},
+			updateExpr: map[string]argClause{
+				"views": {clause: "views + ?", args: []interface{}{3}},
+				"b":     {clause: "greatest(b, ?, ?)", args: []interface{}{4, 5}},
+			},
+			where: []where{{clause: "id=?", args: []interface{}{6}}},
+		}, []interface{}{1, 4, 5, 2, 3, 6}},
+		{&Query{from: []string{"t"}, update: map[string]interface{}{"a": 1, "b": boil.Default, "c": 2}}, []interface{}{1, 2}},
+		{&Query{
+			dialect:    &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true, UseReturningClause: true},
+			from:       []string{"t"},
+			insertCols: []string{"a", "b", "c"},
+			insertRows: [][]interface{}{{1, boil.Default, 2}, {boil.Default, 3, 4}},
+			returning:  []string{"id"},
+		}, []interface{}{1, 2, 3, 4}},
+		// a AND (b OR c)
+		{&Query{from: []string{"t"}, where: []where{
+			{clause: "a=?", args: []interface{}{1}},
+			{kind: whereKindLeftParen},
+			{clause: "b=?", args: []interface{}{2}},
+			{clause: "c=?", args: []interface{}{3}, orSeparator: true},
+			{kind: whereKindRightParen},
+		}}, []interface{}{1, 2, 3}},
+		// (a OR b) AND (c OR d)
+		{&Query{from: []string{"t"}, where: []where{
+			{kind: whereKindLeftParen},
+			{clause: "a=?", args: []interface{}{1}},
+			{clause: "b=?", args: []interface{}{2}, orSeparator: true},
+			{kind: whereKindRightParen},
+			{kind: whereKindLeftParen},
+			{clause: "c=?", args: []interface{}{3}},
+			{clause: "d=?", args: []interface{}{4}, orSeparator: true},
+			{kind: whereKindRightParen},
+		}}, []interface{}{1, 2, 3, 4}},
+		// a OR b AND c OR d, precedence without any groups
+		{&Query{from: []string{"t"}, where: []where{
+			{clause: "a=?", args: []interface{}{1}},
+			{clause: "b=?", args: []interface{}{2}, orSeparator: true},
+			{clause: "c=?", args: []interface{}{3}},
+			{clause: "d=?", args: []interface{}{4}, orSeparator: true},
+		}}, []interface{}{1, 2, 3, 4}},
+		// a AND (b OR (c AND d IN (...))) OR (e)
+		{&Query{from: []string{"t"}, where: []where{
+			{clause: "a=?", args: []interface{}{1}},
+			{kind: whereKindLeftParen},
+			{clause: "b=?", args: []interface{}{2}},
+			{kind: whereKindLeftParen, orSeparator: true},
+			{clause: "c=?", args: []interface{}{3}},
+			{kind: whereKindIn, clause: "d in ?", args: []interface{}{4, 5}},
+			{kind: whereKindRightParen},
+			{kind: whereKindRightParen},
+			{kind: whereKindLeftParen, orSeparator: true},
+			{clause: "e=?", args: []interface{}{6}},
+			{kind: whereKindRightParen},
+		}}, []interface{}{1, 2, 3, 4, 5, 6}},
+		// Native NULLS ordering is untouched even with emulation turned on
+		{&Query{
+			dialect:              &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true, UseNullsOrdering: true},
+			from:                 []string{"t"},
+			emulateNullsOrdering: true,
+			orderBy:              []argClause{{clause: "a ASC NULLS LAST, b DESC NULLS FIRST"}, {clause: "c"}},
+		}, nil},
+		{&Query{
+			dialect:              &drivers.Dialect{LQ: '`', RQ: '`'},
+			from:                 []string{"t"},
+			emulateNullsOrdering: true,
+			orderBy:              []argClause{{clause: "a ASC NULLS LAST, b DESC NULLS FIRST"}, {clause: "lower(c) nulls last"}, {clause: "d"}},
+		}, nil},
+		{&Query{from: []string{"t"}, orderByRandom: true, limit: 10}, nil},
+		{&Query{dialect: &drivers.Dialect{LQ: '`', RQ: '`', UseRandFunction: true}, from: []string{"t"}, orderByRandom: true, limit: 10}, nil},
+		{&Query{dialect: &drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, UseTopClause: true}, from: []string{"t"}, orderBy: []argClause{{clause: "a"}}, orderByRandom: true, limit: 10, offset: 5}, nil},
+		{&Query{
+			dialect:    &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true, UseReturningClause: true, UseOnConflictClause: true},
+			from:       []string{"users"},
+			insertCols: []string{"email", "name"},
+			insertRows: [][]interface{}{{"a@b.c", "a"}},
+			conflict:   &conflict{columns: []string{"email"}, where: "deleted_at IS NULL AND tenant_id = ?", args: []interface{}{7}},
+			update:     map[string]interface{}{"name": "b"},
+			updateExpr: map[string]argClause{"logins": {clause: "users.logins + ?", args: []interface{}{1}}},
+			returning:  []string{"id"},
+		}, []interface{}{"a@b.c", "a", 7, 1, "b"}},
+		{&Query{
+			dialect:    &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true, UseOnConflictClause: true},
+			from:       []string{"users"},
+			insertCols: []string{"email"},
+			insertRows: [][]interface{}{{"a@b.c"}},
+			conflict:   &conflict{columns: []string{"email"}},
+		}, []interface{}{"a@b.c"}},
+		{&Query{from: []string{"posts"}, where: []where{
+			{clause: "published=?", args: []interface{}{true}},
+			{kind: whereKindFullText, clause: "title,body", args: []interface{}{"cats"}},
+		}}, []interface{}{true, "cats"}},
+		{&Query{from: []string{"posts"}, where: []where{
+			{kind: whereKindFullText, clause: "title", args: []interface{}{"cats"}},
+		}}, []interface{}{"cats"}},
+		{&Query{dialect: &drivers.Dialect{LQ: '`', RQ: '`', UseRandFunction: true, UseMatchAgainst: true}, from: []string{"posts"}, where: []where{
+			{clause: "published=?", args: []interface{}{true}},
+			{kind: whereKindFullText, clause: "title,body", args: []interface{}{"cats"}},
+		}}, []interface{}{true, "cats"}},
+		{&Query{dialect: &drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, UseTopClause: true}, from: []string{"posts"}, where: []where{
+			{kind: whereKindFullText, clause: "title,body", args: []interface{}{"cats"}},
+		}}, []interface{}{"cats"}},
+		// Counting and checking existence drops the ordering
+		{&Query{from: []string{"t"}, count: true, where: []where{{clause: "a=?", args: []interface{}{1}}}, orderBy: []argClause{{clause: "b DESC"}}, orderByRandom: true}, []interface{}{1}},
+		{&Query{from: []string{"t"}, exists: true, where: []where{{clause: "a=?", args: []interface{}{1}}}, orderBy: []argClause{{clause: "b DESC"}}}, []interface{}{1}},
+		{&Query{dialect: &drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, UseTopClause: true, UseCaseWhenExistsClause: true}, from: []string{"t"}, exists: true, where: []where{{clause: "a=?", args: []interface{}{1}}}, orderBy: []argClause{{clause: "b DESC"}}}, []interface{}{1}},
+		{&Query{from: []string{"t"}, exists: true, withs: []argClause{{clause: "cte AS (SELECT 1)"}}, joins: []join{{JoinInner, "dogs d on d.cat_id = t.id", nil}}}, nil},
+		{&Query{from: []string{"t"}, joins: []join{{JoinCross, "generate_series(1, ?) s", []interface{}{3}}}, where: []where{{clause: "a=?", args: []interface{}{1}}}}, []interface{}{3, 1}},
+		{&Query{from: []string{"t"}, delete: true, withs: []argClause{{clause: "cte AS (SELECT * FROM x WHERE b=?)", args: []interface{}{2}}}, where: []where{{clause: "a=?", args: []interface{}{1}}}}, []interface{}{2, 1}},
+		// Limit and offset are only bound when asked to and the dialect allows it
+		{&Query{dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true, UseBoundLimitOffset: true}, from: []string{"t"}, where: []where{{clause: "a=?", args: []interface{}{1}}}, limit: 5, offset: 6}, []interface{}{1}},
+		{&Query{dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true, UseBoundLimitOffset: true}, from: []string{"t"}, where: []where{{clause: "a=?", args: []interface{}{1}}}, limit: 5, offset: 6, bindLimitOffset: true}, []interface{}{1, 5, 6}},
+		{&Query{dialect: &drivers.Dialect{LQ: '`', RQ: '`', UseBoundLimitOffset: true}, from: []string{"t"}, where: []where{{clause: "a=?", args: []interface{}{1}}}, limit: 5, bindLimitOffset: true}, []interface{}{1, 5}},
+		{&Query{from: []string{"t"}, limit: 5, offset: 6, bindLimitOffset: true}, nil},
+		{&Query{dialect: &drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, UseTopClause: true}, from: []string{"t"}, limit: 5, offset: 6, bindLimitOffset: true}, nil},
 	}
 
 	for i, test := range tests {
 		filename := filepath.Join("_fixtures", fmt.Sprintf("%02d.sql", i))
-		test.q.dialect = &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}
-		out, args := BuildQuery(test.q)
+		if test.q.dialect == nil {
+			test.q.dialect = &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}
+		}
+		out, args, err := buildQuery(test.q)
+		if err != nil {
+			t.Fatal(err)
+		}
 
 		if *writeGoldenFiles {
 			err := ioutil.WriteFile(filename, []byte(out), 0664)
@@ -155,6 +307,31 @@ func TestBuildQuery(t *testing.T) {
 	}
 }
 
+func TestBuildQueryUnsupportedDialect(t *testing.T) {
+	t.Parallel()
+
+	mysql := &drivers.Dialect{LQ: '`', RQ: '`'}
+	mssql := &drivers.Dialect{LQ: '[', RQ: ']', UseIndexPlaceholders: true, UseOutputClause: true}
+
+	tests := []struct {
+		q   *Query
+		err string
+	}{
+		{&Query{dialect: mysql, from: []string{"t"}, delete: true, returning: []string{"*"}}, "RETURNING"},
+		{&Query{dialect: mssql, from: []string{"t"}, update: map[string]interface{}{"a": 1}, returning: []string{"id"}}, "RETURNING"},
+		{&Query{dialect: mysql, from: []string{"t"}, insertCols: []string{"a"}, insertRows: [][]interface{}{{1}}, conflict: &conflict{columns: []string{"a"}}}, "ON CONFLICT"},
+		{&Query{dialect: mssql, from: []string{"t"}, insertCols: []string{"a"}, insertRows: [][]interface{}{{1}}, conflict: &conflict{}}, "ON CONFLICT"},
+		{&Query{dialect: mysql, from: []string{"t"}, merge: &merge{using: "src", on: "t.id = src.id"}}, "MERGE"},
+	}
+
+	for i, test := range tests {
+		_, _, err := buildQuery(test.q)
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%d) expected an error about %s, got: %v", i, test.err, err)
+		}
+	}
+}
+
 func TestWriteStars(t *testing.T) {
 	t.Parallel()
 
@@ -317,6 +494,29 @@ func TestWhereClause(t *testing.T) {
 			},
 			expect: " WHERE a=$1 OR (b=$2 and c=$3)",
 		},
+		// Where("a=?"), WhereNotDeleted("deleted_at")
+		{
+			q: Query{
+				where: []where{{clause: "a=?"}, {clause: "deleted_at is null", softDelete: true}},
+			},
+			expect: " WHERE (a=$1) AND (deleted_at is null)",
+		},
+		// Where("a=?"), WhereNotDeleted("deleted_at"), WithDeleted()
+		{
+			q: Query{
+				where:          []where{{clause: "a=?"}, {clause: "deleted_at is null", softDelete: true}},
+				includeDeleted: true,
+			},
+			expect: " WHERE (a=$1)",
+		},
+		// WhereNotDeleted("deleted_at"), WithDeleted()
+		{
+			q: Query{
+				where:          []where{{clause: "deleted_at is null", softDelete: true}},
+				includeDeleted: true,
+			},
+			expect: "",
+		},
 	}
 
 	for i, test := range tests {
@@ -621,13 +821,28 @@ func TestWriteAsStatements(t *testing.T) {
 		`COUNT(a)`,
 	}
 
-	gots := writeAsStatements(&query)
+	gots, err := writeAsStatements(&query)
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	for i, got := range gots {
 		if expect[i] != got {
 			t.Errorf(`%d) want: %s, got: %s`, i, expect[i], got)
 		}
 	}
+
+	// The alias is quoted like any other identifier of the dialect
+	query.selectCols = []string{`a.fun`}
+	query.dialect = &drivers.Dialect{LQ: '`', RQ: '`'}
+	if gots, err = writeAsStatements(&query); err != nil || gots[0] != "`a`.`fun` as `a.fun`" {
+		t.Errorf("want a mysql alias, got: %v, %v", gots, err)
+	}
+
+	query.dialect = &drivers.Dialect{LQ: '[', RQ: ']'}
+	if gots, err = writeAsStatements(&query); err != nil || gots[0] != "[a].[fun] as [a.fun]" {
+		t.Errorf("want an mssql alias, got: %v, %v", gots, err)
+	}
 }
 
 func TestWriteComment(t *testing.T) {
@@ -662,3 +877,170 @@ func TestWriteComment(t *testing.T) {
 		t.Errorf(`bad two lines comment, got: %s`, got)
 	}
 }
+
+// TestBuildQueryPlaceholders builds many random query shapes and checks that
+// the placeholders always line up with the args. It catches renumbering
+// mistakes when a clause that takes args is added to the builders.
+func TestBuildQueryPlaceholders(t *testing.T) {
+	t.Parallel()
+
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 2000; i++ {
+		indexed := rnd.Intn(4) != 0
+
+		q := randomQuery(rnd)
+		q.dialect = &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: indexed, UseOnConflictClause: true}
+
+		sql, args, err := buildQuery(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !indexed {
+			if n := strings.Count(sql, "?"); n != len(args) {
+				t.Fatalf("%d) %d placeholders for %d args:\n%s", i, n, len(args), sql)
+			}
+			continue
+		}
+
+		matches := rgxPlaceholder.FindAllStringSubmatch(sql, -1)
+		if len(matches) != len(args) {
+			t.Fatalf("%d) %d placeholders for %d args:\n%s", i, len(matches), len(args), sql)
+		}
+		for j, m := range matches {
+			if m[1] != strconv.Itoa(j+1) {
+				t.Fatalf("%d) placeholder %d is $%s, want $%d:\n%s", i, j+1, m[1], j+1, sql)
+			}
+		}
+	}
+}
+
+var rgxPlaceholder = regexp.MustCompile(`\$(\d+)`)
+
+// randomQuery creates a select, count, update, delete or insert query with
+// a random assortment of the clauses that take args
+func randomQuery(rnd *rand.Rand) *Query {
+	q := &Query{from: []string{"t"}}
+
+	// clause makes a clause with n placeholders and the args for it
+	clause := func(format string, n int) (string, []interface{}) {
+		conds := make([]string, n)
+		args := make([]interface{}, n)
+		for i := range conds {
+			conds[i] = fmt.Sprintf("c%d = ?", i)
+			args[i] = rnd.Int()
+		}
+		if n == 0 {
+			conds = []string{"1 = 1"}
+		}
+		return fmt.Sprintf(format, strings.Join(conds, " AND ")), args
+	}
+
+	for i, n := 0, rnd.Intn(3); i < n; i++ {
+		c, args := clause(fmt.Sprintf("cte_%d AS (SELECT * FROM x WHERE %%s)", i), rnd.Intn(3))
+		AppendWith(q, c, args...)
+	}
+
+	update := func() {
+		cols := map[string]interface{}{}
+		for i, n := 0, 1+rnd.Intn(3); i < n; i++ {
+			cols[fmt.Sprintf("u%d", i)] = rnd.Int()
+		}
+		SetUpdate(q, cols)
+		if rnd.Intn(2) == 0 {
+			SetUpdateExpr(q, "e", "e + ? * ?", rnd.Int(), rnd.Int())
+		}
+	}
+
+	kind := rnd.Intn(5)
+	if kind == 4 {
+		cols := []string{"a", "b", "c"}[:1+rnd.Intn(3)]
+		rows := make([][]interface{}, 1+rnd.Intn(3))
+		for i := range rows {
+			for range cols {
+				rows[i] = append(rows[i], rnd.Int())
+			}
+		}
+		SetInsert(q, cols, rows...)
+
+		if rnd.Intn(2) == 0 {
+			c, args := clause("%s", rnd.Intn(3))
+			SetConflict(q, []string{"a"}, c, args...)
+			if rnd.Intn(2) == 0 {
+				update()
+			}
+		}
+		return q
+	}
+
+	joins := []func(*Query, string, ...interface{}){AppendInnerJoin, AppendLeftOuterJoin, AppendRightOuterJoin, AppendFullOuterJoin, AppendCrossJoin}
+	for i, n := 0, rnd.Intn(3); i < n; i++ {
+		c, args := clause(fmt.Sprintf("j%d ON %%s", i), rnd.Intn(3))
+		joins[rnd.Intn(len(joins))](q, c, args...)
+	}
+
+	depth := 0
+	for i, n := 0, rnd.Intn(6); i < n; i++ {
+		switch rnd.Intn(5) {
+		case 0:
+			AppendWhereLeftParen(q)
+			depth++
+		case 1:
+			cols := 1 + rnd.Intn(2)
+			args := make([]interface{}, cols*rnd.Intn(4))
+			for j := range args {
+				args[j] = rnd.Int()
+			}
+			c := "a in ?"
+			if cols == 2 {
+				c = "(a, b) in ?"
+			}
+			if rnd.Intn(2) == 0 {
+				AppendIn(q, c, args...)
+			} else {
+				AppendNotIn(q, strings.Replace(c, "in", "not in", 1), args...)
+			}
+		default:
+			c, args := clause("%s", rnd.Intn(3))
+			AppendWhere(q, c, args...)
+		}
+
+		if rnd.Intn(3) == 0 {
+			SetLastWhereAsOr(q)
+		}
+		if depth > 0 && rnd.Intn(2) == 0 {
+			AppendWhereRightParen(q)
+			depth--
+		}
+	}
+	for ; depth > 0; depth-- {
+		AppendWhereRightParen(q)
+	}
+
+	if rnd.Intn(2) == 0 {
+		SetMaxInListSize(q, 1+rnd.Intn(3))
+	}
+
+	switch kind {
+	case 0, 1:
+		if kind == 1 {
+			SetCount(q)
+		}
+		if rnd.Intn(2) == 0 {
+			AppendGroupBy(q, "a")
+			c, args := clause("%s", rnd.Intn(3))
+			AppendHaving(q, c, args...)
+		}
+		if rnd.Intn(2) == 0 {
+			AppendOrderBy(q, "f(?, ?) DESC", rnd.Int(), rnd.Int())
+		}
+		SetLimit(q, rnd.Intn(10))
+		SetOffset(q, rnd.Intn(10))
+	case 2:
+		update()
+	case 3:
+		SetDelete(q)
+	}
+
+	return q
+}
@@ -0,0 +1,79 @@
+package queries
+
+// The methods in this file mirror the package level Append* functions
+// for the where clause but return the query so that calls can be chained:
+//
+//   q.Where("a = ?", 1).And("b = ?", 2).Or("c = ?", 3)
+
+// Where adds a where clause to the query and returns the query.
+func (q *Query) Where(clause string, args ...interface{}) *Query {
+	AppendWhere(q, clause, args...)
+	return q
+}
+
+// And is an alias for Where, it exists to read better when chaining.
+func (q *Query) And(clause string, args ...interface{}) *Query {
+	return q.Where(clause, args...)
+}
+
+// Or adds a where clause to the query that is joined to the previous
+// clause with OR and returns the query.
+func (q *Query) Or(clause string, args ...interface{}) *Query {
+	AppendWhere(q, clause, args...)
+	SetLastWhereAsOr(q)
+	return q
+}
+
+// WhereIn adds a where in clause to the query and returns the query.
+func (q *Query) WhereIn(clause string, args ...interface{}) *Query {
+	AppendIn(q, clause, args...)
+	return q
+}
+
+// AndIn is an alias for WhereIn, it exists to read better when chaining.
+func (q *Query) AndIn(clause string, args ...interface{}) *Query {
+	return q.WhereIn(clause, args...)
+}
+
+// OrIn adds a where in clause to the query that is joined to the
+// previous clause with OR and returns the query.
+func (q *Query) OrIn(clause string, args ...interface{}) *Query {
+	AppendIn(q, clause, args...)
+	SetLastInAsOr(q)
+	return q
+}
+
+// WhereNotIn adds a where not in clause to the query and returns the query.
+func (q *Query) WhereNotIn(clause string, args ...interface{}) *Query {
+	AppendNotIn(q, clause, args...)
+	return q
+}
+
+// AndNotIn is an alias for WhereNotIn, it exists to read better when chaining.
+func (q *Query) AndNotIn(clause string, args ...interface{}) *Query {
+	return q.WhereNotIn(clause, args...)
+}
+
+// OrNotIn adds a where not in clause to the query that is joined to the
+// previous clause with OR and returns the query.
+func (q *Query) OrNotIn(clause string, args ...interface{}) *Query {
+	AppendNotIn(q, clause, args...)
+	SetLastInAsOr(q)
+	return q
+}
+
+// Expr groups the where clauses added by fn in parentheses and returns
+// the query.
+func (q *Query) Expr(fn func(q *Query)) *Query {
+	AppendWhereLeftParen(q)
+	fn(q)
+	AppendWhereRightParen(q)
+	return q
+}
+
+// OrExpr is like Expr but joins the group to the previous clause with OR.
+func (q *Query) OrExpr(fn func(q *Query)) *Query {
+	q.Expr(fn)
+	SetLastWhereAsOr(q)
+	return q
+}
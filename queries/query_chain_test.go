@@ -0,0 +1,89 @@
+package queries
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestQueryChaining(t *testing.T) {
+	t.Parallel()
+
+	dialect := &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}
+
+	chained := &Query{dialect: dialect, from: []string{"t"}}
+	chained.
+		Where("a = ?", 1).
+		And("b = ?", 2).
+		Or("c = ?", 3).
+		WhereIn("d in ?", 4, 5).
+		OrNotIn("e not in ?", 6).
+		Expr(func(q *Query) {
+			q.Where("f = ?", 7).Or("g = ?", 8)
+		}).
+		OrExpr(func(q *Query) {
+			q.AndIn("h in ?", 9).AndNotIn("i not in ?", 10).OrIn("j in ?", 11)
+		})
+
+	sequential := &Query{dialect: dialect, from: []string{"t"}}
+	AppendWhere(sequential, "a = ?", 1)
+	AppendWhere(sequential, "b = ?", 2)
+	AppendWhere(sequential, "c = ?", 3)
+	SetLastWhereAsOr(sequential)
+	AppendIn(sequential, "d in ?", 4, 5)
+	AppendNotIn(sequential, "e not in ?", 6)
+	SetLastInAsOr(sequential)
+	AppendWhereLeftParen(sequential)
+	AppendWhere(sequential, "f = ?", 7)
+	AppendWhere(sequential, "g = ?", 8)
+	SetLastWhereAsOr(sequential)
+	AppendWhereRightParen(sequential)
+	AppendWhereLeftParen(sequential)
+	AppendIn(sequential, "h in ?", 9)
+	AppendNotIn(sequential, "i not in ?", 10)
+	AppendIn(sequential, "j in ?", 11)
+	SetLastInAsOr(sequential)
+	AppendWhereRightParen(sequential)
+	SetLastWhereAsOr(sequential)
+
+	if !reflect.DeepEqual(chained.where, sequential.where) {
+		t.Errorf("where clauses differ:\n%#v\n%#v", chained.where, sequential.where)
+	}
+
+	chainedSQL, chainedArgs, err := buildQuery(chained)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sequentialSQL, sequentialArgs, err := buildQuery(sequential)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if chainedSQL != sequentialSQL {
+		t.Errorf("sql differs:\n%s\n%s", chainedSQL, sequentialSQL)
+	}
+	if !reflect.DeepEqual(chainedArgs, sequentialArgs) {
+		t.Errorf("args differ:\n%#v\n%#v", chainedArgs, sequentialArgs)
+	}
+}
+
+func TestQueryChainingInSlice(t *testing.T) {
+	t.Parallel()
+
+	dialect := &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}
+
+	q := &Query{dialect: dialect, from: []string{"t"}}
+	q.WhereIn("a in ?", []int{1, 2}).AndNotIn("b not in ?", []string{"x"}).OrIn("c in ?", []int64{})
+
+	want := `SELECT * FROM "t" WHERE ("a" IN ($1,$2)) AND ("b" NOT IN ($3)) OR (1=0);`
+	got, args, err := buildQuery(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("want:\n%s\ngot:\n%s", want, got)
+	}
+	if wantArgs := []interface{}{1, 2, "x"}; !reflect.DeepEqual(args, wantArgs) {
+		t.Errorf("want args: %#v, got: %#v", wantArgs, args)
+	}
+}
@@ -1,8 +1,14 @@
 package queries
 
 import (
+	"errors"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
 )
 
 func TestSetLimit(t *testing.T) {
@@ -333,6 +339,51 @@ func TestSetCount(t *testing.T) {
 	}
 }
 
+func TestSetExists(t *testing.T) {
+	t.Parallel()
+
+	q := &Query{}
+	SetExists(q)
+
+	if q.exists != true {
+		t.Errorf("got false")
+	}
+}
+
+func TestEnsureSelect(t *testing.T) {
+	t.Parallel()
+
+	q := &Query{}
+	EnsureSelect(q, "orders.customer_id")
+	if len(q.selectCols) != 0 {
+		t.Errorf("a query selecting everything should be left alone: %#v", q.selectCols)
+	}
+
+	tests := []struct {
+		Sel  []string
+		Col  string
+		Want []string
+	}{
+		{[]string{"id", "total"}, "orders.customer_id", []string{"id", "total", "orders.customer_id"}},
+		{[]string{"id", "customer_id"}, "orders.customer_id", []string{"id", "customer_id"}},
+		{[]string{"id", `"orders"."customer_id"`}, "orders.customer_id", []string{"id", `"orders"."customer_id"`}},
+		{[]string{"orders.*"}, "orders.customer_id", []string{"orders.*"}},
+		{[]string{"*"}, "orders.customer_id", []string{"*"}},
+		{[]string{"orders.id"}, "public.orders.customer_id", []string{"orders.id", "public.orders.customer_id"}},
+		{[]string{"orders.customer_id"}, "public.orders.customer_id", []string{"orders.customer_id"}},
+		{[]string{"customers.customer_id"}, "orders.customer_id", []string{"customers.customer_id", "orders.customer_id"}},
+	}
+
+	for i, test := range tests {
+		q := &Query{selectCols: append([]string(nil), test.Sel...)}
+		EnsureSelect(q, test.Col)
+
+		if !reflect.DeepEqual(q.selectCols, test.Want) {
+			t.Errorf("%d) want: %#v, got: %#v", i, test.Want, q.selectCols)
+		}
+	}
+}
+
 func TestSetDistinct(t *testing.T) {
 	t.Parallel()
 
@@ -355,6 +406,58 @@ func TestSetUpdate(t *testing.T) {
 	}
 }
 
+func TestSetInsert(t *testing.T) {
+	t.Parallel()
+
+	q := &Query{}
+	SetInsert(q, []string{"a", "b"}, []interface{}{1, 2}, []interface{}{3, 4})
+
+	if !reflect.DeepEqual(q.insertCols, []string{"a", "b"}) {
+		t.Errorf("Wrong insert columns, got %v", q.insertCols)
+	}
+	if !reflect.DeepEqual(q.insertRows, [][]interface{}{{1, 2}, {3, 4}}) {
+		t.Errorf("Wrong insert rows, got %v", q.insertRows)
+	}
+
+	q.from = []string{"t"}
+	q.dialect = &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true}
+	SetInsert(q, []string{"a", "b"}, []interface{}{1})
+	if _, _, err := buildQuery(q); err == nil {
+		t.Error("expected an error for a row with the wrong number of values")
+	}
+
+	SetInsert(q, []string{"a", "b"})
+	if _, _, err := buildQuery(q); err == nil {
+		t.Error("expected an error for an insert without rows")
+	}
+}
+
+func TestSetConflict(t *testing.T) {
+	t.Parallel()
+
+	q := &Query{}
+	SetConflict(q, []string{"email"}, "deleted_at IS NULL AND tenant_id = ?", 5)
+
+	if !reflect.DeepEqual(q.conflict.columns, []string{"email"}) {
+		t.Errorf("Wrong conflict columns, got %v", q.conflict.columns)
+	}
+	if q.conflict.where != "deleted_at IS NULL AND tenant_id = ?" || len(q.conflict.args) != 1 || q.conflict.args[0] != 5 {
+		t.Errorf("Wrong conflict predicate, got %#v", q.conflict)
+	}
+}
+
+func TestSetUpdateExpr(t *testing.T) {
+	t.Parallel()
+
+	q := &Query{}
+	SetUpdateExpr(q, "views", "views + ?", 1)
+
+	expr := q.updateExpr["views"]
+	if expr.clause != "views + ?" || len(expr.args) != 1 || expr.args[0] != 1 {
+		t.Errorf("Wrong update expr, got %#v", q.updateExpr)
+	}
+}
+
 func TestSetDelete(t *testing.T) {
 	t.Parallel()
 
@@ -598,6 +701,32 @@ func TestAppendFullOuterJoin(t *testing.T) {
 	}
 }
 
+func TestAppendCrossJoin(t *testing.T) {
+	t.Parallel()
+
+	q := &Query{}
+	AppendCrossJoin(q, "generate_series(1, ?) s", 3)
+	AppendCrossJoin(q, "sizes")
+
+	if len(q.joins) != 2 {
+		t.Errorf("Expected len 2, got %d", len(q.joins))
+	}
+
+	if q.joins[0].kind != JoinCross || q.joins[0].clause != "generate_series(1, ?) s" {
+		t.Errorf("Got invalid crossJoin: %#v", q.joins)
+	}
+	if q.joins[1].kind != JoinCross || q.joins[1].clause != "sizes" {
+		t.Errorf("Got invalid crossJoin: %#v", q.joins)
+	}
+
+	if len(q.joins[0].args) != 1 || q.joins[0].args[0] != 3 {
+		t.Errorf("Invalid args values, got %#v", q.joins[0].args)
+	}
+	if len(q.joins[1].args) != 0 {
+		t.Errorf("Expected len 0, got %d", len(q.joins[1].args))
+	}
+}
+
 func TestAppendWith(t *testing.T) {
 	t.Parallel()
 
@@ -641,6 +770,21 @@ func TestAppendWith(t *testing.T) {
 	}
 }
 
+func TestSetWith(t *testing.T) {
+	t.Parallel()
+
+	q := &Query{}
+	AppendWith(q, "cte_0 AS (SELECT * FROM other_t0)")
+	SetWith(q, "cte_1 AS (SELECT * FROM other_t1 WHERE thing=?)", 3)
+
+	if len(q.withs) != 1 {
+		t.Fatalf("Expected len 1, got %d", len(q.withs))
+	}
+	if q.withs[0].clause != "cte_1 AS (SELECT * FROM other_t1 WHERE thing=?)" || q.withs[0].args[0] != 3 {
+		t.Errorf("Got invalid with: %#v", q.withs)
+	}
+}
+
 func TestSetComment(t *testing.T) {
 	t.Parallel()
 
@@ -651,3 +795,156 @@ func TestSetComment(t *testing.T) {
 		t.Errorf("Got invalid comment: %s", q.comment)
 	}
 }
+
+func TestSetMerge(t *testing.T) {
+	t.Parallel()
+
+	q := &Query{}
+	SetMerge(q, "src", "t.id = src.id", 5)
+	AppendMergeWhenMatched(q, "UPDATE SET a = src.a")
+	AppendMergeWhenNotMatched(q, "INSERT (id) VALUES (?)", 10)
+
+	if q.merge.using != "src" || q.merge.on != "t.id = src.id" || q.merge.args[0] != 5 {
+		t.Errorf("merge was wrong: %#v", q.merge)
+	}
+	if len(q.merge.whens) != 2 {
+		t.Fatalf("Expected len 2, got %d", len(q.merge.whens))
+	}
+	if !q.merge.whens[0].matched || q.merge.whens[0].clause != "UPDATE SET a = src.a" {
+		t.Errorf("when matched was wrong: %#v", q.merge.whens[0])
+	}
+	if q.merge.whens[1].matched || q.merge.whens[1].args[0] != 10 {
+		t.Errorf("when not matched was wrong: %#v", q.merge.whens[1])
+	}
+
+	q = &Query{from: []string{"t"}, dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseMergeClause: true}}
+	AppendMergeWhenMatched(q, "DELETE")
+	if _, _, err := buildQuery(q); err == nil {
+		t.Error("expected an error for a merge action without SetMerge")
+	}
+}
+
+func TestAddPreStatement(t *testing.T) {
+	t.Parallel()
+
+	q := &Query{
+		from:    []string{"t"},
+		dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true},
+	}
+	AddPreStatement(q, "SET LOCAL work_mem = '256MB'")
+	AddPreStatement(q, "SET LOCAL statement_timeout = '5s'")
+
+	if sql, _ := BuildQuery(q); sql != `SELECT * FROM "t";` {
+		t.Errorf("pre statements should not be part of the query: %s", sql)
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SET LOCAL work_mem = '256MB'`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`SET LOCAL statement_timeout = '5s'`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SELECT \* FROM "t";`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := q.Query(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows.Close()
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAddPreStatementErrors(t *testing.T) {
+	t.Parallel()
+
+	newQuery := func() *Query {
+		q := &Query{
+			from:    []string{"t"},
+			dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true},
+		}
+		AddPreStatement(q, "SET LOCAL work_mem = '256MB'")
+		return q
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Nothing may run on a pool where the pre statements could end up on
+	// another connection than the query
+	if _, err := newQuery().Query(db); err == nil {
+		t.Error("expected an error running pre statements on a *sql.DB")
+	}
+	var id int
+	if err := newQuery().QueryRow(db).Scan(&id); err == nil {
+		t.Error("expected the row to return an error running pre statements on a *sql.DB")
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SET LOCAL work_mem = '256MB'`).WillReturnError(errors.New("permission denied"))
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = newQuery().QueryRow(tx).Scan(&id)
+	if err == nil || !strings.Contains(err.Error(), "permission denied") {
+		t.Errorf("expected the row to return the pre statement's error, got: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetWhereTimeRange(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		From, To       time.Time
+		IncFrom, IncTo bool
+		Clause         string
+		Args           []interface{}
+	}{
+		{From: from, To: to, IncFrom: true, IncTo: false, Clause: "created_at >= ? AND created_at < ?", Args: []interface{}{from, to}},
+		{From: from, To: to, IncFrom: false, IncTo: true, Clause: "created_at > ? AND created_at <= ?", Args: []interface{}{from, to}},
+		{To: to, IncFrom: true, IncTo: false, Clause: "created_at < ?", Args: []interface{}{to}},
+		{From: from, IncFrom: true, IncTo: true, Clause: "created_at >= ?", Args: []interface{}{from}},
+	}
+
+	for i, test := range tests {
+		q := &Query{}
+		SetWhereTimeRange(q, "created_at", test.From, test.To, test.IncFrom, test.IncTo)
+
+		if len(q.where) != 1 {
+			t.Fatalf("%d) expected 1 where, got %d", i, len(q.where))
+		}
+		if q.where[0].clause != test.Clause {
+			t.Errorf("%d) want clause %q, got %q", i, test.Clause, q.where[0].clause)
+		}
+		if !reflect.DeepEqual(q.where[0].args, test.Args) {
+			t.Errorf("%d) want args %v, got %v", i, test.Args, q.where[0].args)
+		}
+	}
+
+	q := &Query{}
+	SetWhereTimeRange(q, "created_at", time.Time{}, time.Time{}, true, false)
+	if len(q.where) != 0 {
+		t.Errorf("expected no where for an unbounded range, got %#v", q.where)
+	}
+}
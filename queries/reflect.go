@@ -5,6 +5,7 @@ import (
 	"context"
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
@@ -76,6 +77,13 @@ func (q *Query) BindG(ctx context.Context, obj interface{}) error {
 //   - If the ",bind" option is specified on a struct field and that field
 //     is a struct itself, it will be recursed into to look for fields for
 //     binding.
+//   - Pointers to structs that are recursed into are allocated if they're nil.
+//     Embedded structs follow the same rules, so a joined query can be bound
+//     to a struct embedding both models with their table names as prefixes.
+//   - Fields that aren't a sql.Scanner and are a map, a struct other than
+//     time.Time, or a slice other than []byte are decoded from the column's
+//     json, like a postgres jsonb column. A null sets them to their zero
+//     value and invalid json is an error.
 //
 // Example usage:
 //
@@ -150,8 +158,12 @@ func (q *Query) Bind(ctx context.Context, exec boil.Executor, obj interface{}) e
 		return errors.Wrap(err, "error from rows in bind")
 	}
 
-	if len(q.load) != 0 {
-		return eagerLoad(ctx, exec, q.load, q.loadMods, obj, bkind)
+	toLoad := q.load
+	if q.loadAll {
+		toLoad = append(allRelationships(structType), toLoad...)
+	}
+	if len(toLoad) != 0 {
+		return eagerLoad(ctx, exec, toLoad, q.loadMods, obj, bkind)
 	}
 
 	return nil
@@ -234,6 +246,8 @@ func bind(rows *sql.Rows, obj interface{}, structType, sliceType reflect.Type, b
 		oneStruct = reflect.Indirect(reflect.New(structType))
 	}
 
+	jsonFields := jsonFieldsFromMapping(structType, mapping)
+
 	foundOne := false
 Rows:
 	for rows.Next() {
@@ -253,6 +267,7 @@ Rows:
 		if err != nil {
 			return err
 		}
+		wrapJSONFields(pointers, jsonFields, cols)
 
 		if err := rows.Scan(pointers...); err != nil {
 			return errors.Wrap(err, "failed to bind pointers to obj")
@@ -312,6 +327,90 @@ func PtrsFromMapping(val reflect.Value, mapping []uint64) []interface{} {
 	return ptrs
 }
 
+var (
+	scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+	timeType    = reflect.TypeOf(time.Time{})
+)
+
+// jsonFieldsFromMapping reports which of the fields in the mapping are
+// decoded from json rather than scanned: maps, structs and slices other
+// than []byte that aren't a sql.Scanner. It returns nil if there are none.
+func jsonFieldsFromMapping(structType reflect.Type, mapping []uint64) []bool {
+	var fields []bool
+	ptrs := PtrsFromMapping(reflect.Indirect(reflect.New(structType)), mapping)
+	for i, ptr := range ptrs {
+		if !isJSONField(reflect.TypeOf(ptr)) {
+			continue
+		}
+		if fields == nil {
+			fields = make([]bool, len(ptrs))
+		}
+		fields[i] = true
+	}
+	return fields
+}
+
+// isJSONField checks if a pointer to a field is one to decode from json
+func isJSONField(typ reflect.Type) bool {
+	if typ.Implements(scannerType) {
+		return false
+	}
+
+	typ = typ.Elem()
+	switch typ.Kind() {
+	case reflect.Map:
+		return true
+	case reflect.Struct:
+		return typ != timeType
+	case reflect.Slice:
+		return typ.Elem().Kind() != reflect.Uint8
+	}
+	return false
+}
+
+// wrapJSONFields replaces the pointers to json fields with a jsonScanner
+func wrapJSONFields(ptrs []interface{}, fields []bool, cols []string) {
+	for i, isJSON := range fields {
+		if isJSON {
+			ptrs[i] = jsonScanner{column: cols[i], dst: ptrs[i]}
+		}
+	}
+}
+
+// jsonScanner decodes the json in a column, like a postgres jsonb, into
+// the field dst points to. A null sets the field to its zero value.
+type jsonScanner struct {
+	column string
+	dst    interface{}
+}
+
+// Scan decodes src into dst
+func (j jsonScanner) Scan(src interface{}) error {
+	var b []byte
+	switch val := src.(type) {
+	case []byte:
+		b = val
+	case string:
+		b = []byte(val)
+	case nil:
+	default:
+		return errors.Errorf("cannot decode %T from column %s as json", src, j.column)
+	}
+
+	// Start from the zero value so a struct reused between rows doesn't
+	// share maps or keep keys from the previous row
+	field := reflect.ValueOf(j.dst).Elem()
+	field.Set(reflect.Zero(field.Type()))
+	if src == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(b, j.dst); err != nil {
+		return errors.Wrapf(err, "column %s does not hold valid json", j.column)
+	}
+	return nil
+}
+
 // ValuesFromMapping expects to be passed an addressable struct and a mapping
 // of where to find things. It pulls the pointers out referred to by the mapping.
 func ValuesFromMapping(val reflect.Value, mapping []uint64) []interface{} {
@@ -343,6 +442,12 @@ func ptrFromMapping(val reflect.Value, mapping uint64, addressOf bool) reflect.V
 
 		val = val.Field(int(v))
 		if val.Kind() == reflect.Ptr {
+			// Nil pointers to structs that are being bound to, for example
+			// User *models.User `boil:"users,bind"`, are allocated so their
+			// fields can be set
+			if val.IsNil() && addressOf && val.Type().Elem().Kind() == reflect.Struct && val.CanSet() {
+				val.Set(reflect.New(val.Type().Elem()))
+			}
 			val = reflect.Indirect(val)
 		}
 	}
@@ -405,31 +510,29 @@ func getBoilTag(field reflect.StructField) (name string, recurse bool) {
 	return nameFragment, true
 }
 
-var (
-	mappingCachesMu sync.Mutex
-	mappingCaches   = make(map[reflect.Type]*mappingCache)
-)
+// mappingCaches holds a *mappingCache per struct type bound to, so the
+// struct is only reflected over once per type
+var mappingCaches sync.Map
 
 func getMappingCache(typ reflect.Type) *mappingCache {
-	mappingCachesMu.Lock()
-	defer mappingCachesMu.Unlock()
-
-	cache := mappingCaches[typ]
-	if cache != nil {
-		return cache
+	if cache, ok := mappingCaches.Load(typ); ok {
+		return cache.(*mappingCache)
 	}
 
-	cache = newMappingCache(typ)
-	mappingCaches[typ] = cache
-
-	return cache
+	cache, _ := mappingCaches.LoadOrStore(typ, newMappingCache(typ))
+	return cache.(*mappingCache)
 }
 
+// mappingCache holds the column to field mappings of a struct type, keyed
+// by the list of columns
 type mappingCache struct {
 	typ reflect.Type
 
-	mu          sync.Mutex
-	structMap   map[string]uint64
+	structMap map[string]uint64
+
+	// colMappings is a plain map since indexing it with string(key) doesn't
+	// allocate, unlike a sync.Map
+	mu          sync.RWMutex
 	colMappings map[string][]uint64
 }
 
@@ -452,10 +555,9 @@ func (b *mappingCache) mapping(cols []string) ([]uint64, error) {
 
 	key := buf.Bytes()
 
-	b.mu.Lock()
-	defer b.mu.Unlock()
-
+	b.mu.RLock()
 	mapping := b.colMappings[string(key)]
+	b.mu.RUnlock()
 	if mapping != nil {
 		return mapping, nil
 	}
@@ -465,7 +567,9 @@ func (b *mappingCache) mapping(cols []string) ([]uint64, error) {
 		return nil, err
 	}
 
+	b.mu.Lock()
 	b.colMappings[string(key)] = mapping
+	b.mu.Unlock()
 
 	return mapping, nil
 }
@@ -478,9 +582,11 @@ func (b *mappingCache) mapping(cols []string) ([]uint64, error) {
 // We're focused on basic types + []byte. Since we're really only interested in things
 // that are typically used for primary keys in a database.
 //
-// Choosing not to use the DefaultParameterConverter here because sqlboiler doesn't generate
-// pointer columns.
+// Choosing not to use the DefaultParameterConverter here, pointers like the nullable
+// columns sqlboiler can generate as *string are compared by the value they point to.
 func Equal(a, b interface{}) bool {
+	a, b = derefPointer(a), derefPointer(b)
+
 	if (a == nil && b != nil) || (a != nil && b == nil) {
 		return false
 	}
@@ -549,6 +655,12 @@ func Assign(dst, src interface{}) {
 	scan, isDstScanner := dst.(sql.Scanner)
 	val, isSrcValuer := src.(driver.Valuer)
 
+	// Nullable columns can be generated as pointers like *string
+	if !isDstScanner && reflect.TypeOf(dst).Elem().Kind() == reflect.Ptr || isPointer(src) {
+		assignPointer(dst, src)
+		return
+	}
+
 	switch {
 	case isDstScanner && isSrcValuer:
 		val, err := val.Value()
@@ -584,6 +696,76 @@ func Assign(dst, src interface{}) {
 	}
 }
 
+// assignPointer assigns src to dst when either of them is a nullable column
+// generated as a pointer, a nil pointer is assigned as null.
+func assignPointer(dst, src interface{}) {
+	if val, ok := src.(driver.Valuer); ok {
+		var err error
+		if src, err = val.Value(); err != nil {
+			panic(fmt.Sprintf("tried to call value on %T but got err: %+v", val, err))
+		}
+	}
+	src = derefPointer(src)
+
+	if scan, ok := dst.(sql.Scanner); ok {
+		if err := scan.Scan(upgradeNumericTypes(src)); err != nil {
+			panic(fmt.Sprintf("tried to call Scan on %T with %#v but got err: %+v", dst, src, err))
+		}
+		return
+	}
+
+	dstVal := reflect.ValueOf(dst).Elem()
+	if src == nil {
+		dstVal.Set(reflect.Zero(dstVal.Type()))
+		return
+	}
+
+	typ := dstVal.Type()
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	val := reflect.ValueOf(src)
+	if !val.Type().ConvertibleTo(typ) {
+		panic(fmt.Sprintf("tried to assign %T to %T", src, dst))
+	}
+	val = val.Convert(typ)
+
+	if dstVal.Kind() == reflect.Ptr {
+		ptr := reflect.New(typ)
+		ptr.Elem().Set(val)
+		val = ptr
+	}
+	dstVal.Set(val)
+}
+
+// isPointer checks if i is a pointer that isn't a driver.Valuer
+func isPointer(i interface{}) bool {
+	if i == nil {
+		return false
+	}
+	if _, ok := i.(driver.Valuer); ok {
+		return false
+	}
+
+	return reflect.TypeOf(i).Kind() == reflect.Ptr
+}
+
+// derefPointer returns what a pointer that isn't a driver.Valuer points to,
+// or nil for a nil pointer. Anything else is returned as is.
+func derefPointer(i interface{}) interface{} {
+	if !isPointer(i) {
+		return i
+	}
+
+	val := reflect.ValueOf(i)
+	if val.IsNil() {
+		return nil
+	}
+
+	return val.Elem().Interface()
+}
+
 func upgradeNumericTypes(i interface{}) interface{} {
 	switch t := i.(type) {
 	case int:
@@ -9,9 +9,12 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
 	"github.com/volatiletech/sqlboiler/v4/drivers"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -80,6 +83,37 @@ func TestBindStruct(t *testing.T) {
 	}
 }
 
+func TestBindG(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	boil.SetDB(db)
+	defer boil.SetDB(nil)
+
+	var testResults []struct {
+		ID int
+	}
+
+	ret := sqlmock.NewRows([]string{"id"})
+	ret.AddRow(driver.Value(int64(35)))
+	mock.ExpectQuery(`select id from fun where id = \$1`).WithArgs(35).WillReturnRows(ret)
+
+	// The G variants run against the db registered with boil.SetDB
+	if err = RawG("select id from fun where id = $1", 35).BindG(context.Background(), &testResults); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(testResults) != 1 || testResults[0].ID != 35 {
+		t.Errorf("wrong results: %#v", testResults)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestBindSlice(t *testing.T) {
 	t.Parallel()
 
@@ -130,6 +164,62 @@ func TestBindSlice(t *testing.T) {
 	}
 }
 
+func TestBindDeleteReturning(t *testing.T) {
+	t.Parallel()
+
+	testResults := []*struct {
+		ID   int
+		Name string `boil:"test"`
+	}{}
+
+	query := &Query{
+		from:      []string{"fun"},
+		delete:    true,
+		where:     []where{{clause: "id < ?", args: []interface{}{40}}},
+		returning: []string{"*"},
+		dialect:   &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true, UseReturningClause: true},
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Error(err)
+	}
+
+	ret := sqlmock.NewRows([]string{"id", "test"})
+	ret.AddRow(driver.Value(int64(35)), driver.Value("pat"))
+	ret.AddRow(driver.Value(int64(12)), driver.Value("cat"))
+	mock.ExpectQuery(`DELETE FROM "fun" WHERE \(id < \$1\) RETURNING \*;`).WithArgs(40).WillReturnRows(ret)
+	mock.ExpectQuery(`DELETE FROM "fun" WHERE \(id < \$1\) RETURNING \*;`).WithArgs(40).WillReturnRows(sqlmock.NewRows([]string{"id", "test"}))
+
+	err = query.Bind(nil, db, &testResults)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(testResults) != 2 {
+		t.Fatal("wrong number of results:", len(testResults))
+	}
+	if id, name := testResults[0].ID, testResults[0].Name; id != 35 || name != "pat" {
+		t.Error("wrong first row:", id, name)
+	}
+	if id, name := testResults[1].ID, testResults[1].Name; id != 12 || name != "cat" {
+		t.Error("wrong second row:", id, name)
+	}
+
+	// Nothing deleted is not an error for a slice
+	testResults = testResults[:0]
+	if err = query.Bind(nil, db, &testResults); err != nil {
+		t.Error(err)
+	}
+	if len(testResults) != 0 {
+		t.Error("wrong number of results:", len(testResults))
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestBindPtrSlice(t *testing.T) {
 	t.Parallel()
 
@@ -189,6 +279,60 @@ func testMakeMapping(byt ...byte) uint64 {
 	return x
 }
 
+func TestMappingCache(t *testing.T) {
+	t.Parallel()
+
+	type mappingCacheStruct struct {
+		ID   int64  `boil:"id"`
+		Name string `boil:"name"`
+		Age  int    `boil:"age"`
+	}
+
+	typ := reflect.TypeOf(mappingCacheStruct{})
+	cache := getMappingCache(typ)
+	if getMappingCache(typ) != cache {
+		t.Error("want the same cache for the same type")
+	}
+	if getMappingCache(reflect.TypeOf(benchPilot{})) == cache {
+		t.Error("want a different cache for a different type")
+	}
+
+	colSets := [][]string{
+		{"id", "name", "age"},
+		{"age", "id"},
+		{"name"},
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, cols := range colSets {
+				if _, err := getMappingCache(typ).mapping(cols); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, cols := range colSets {
+		want, err := BindMapping(typ, MakeStructMapping(typ), cols)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := cache.mapping(cols)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%v) want: %v, got: %v", cols, want, got)
+		}
+	}
+}
+
 func TestMakeStructMapping(t *testing.T) {
 	t.Parallel()
 
@@ -532,6 +676,70 @@ func TestBind_InnerJoin(t *testing.T) {
 	}
 }
 
+type BindUser struct {
+	ID   int
+	Name string
+}
+
+type BindOrder struct {
+	ID    int
+	Total int
+}
+
+func TestBind_EmbeddedStructs(t *testing.T) {
+	t.Parallel()
+
+	testResults := []*struct {
+		*BindUser `boil:"users,bind"`
+		BindOrder `boil:"orders,bind"`
+	}{}
+
+	query := &Query{
+		dialect:    &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true},
+		selectCols: []string{"users.id", "users.name", "orders.id", "orders.total"},
+		from:       []string{"users"},
+		joins:      []join{{kind: JoinInner, clause: "orders on orders.user_id = users.id"}},
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Error(err)
+	}
+
+	ret := sqlmock.NewRows([]string{"users.id", "users.name", "orders.id", "orders.total"})
+	ret.AddRow(driver.Value(int64(1)), driver.Value("pat"), driver.Value(int64(10)), driver.Value(int64(100)))
+	ret.AddRow(driver.Value(int64(2)), driver.Value("cat"), driver.Value(int64(11)), driver.Value(int64(200)))
+	mock.ExpectQuery(`SELECT "users"."id" as "users.id", "users"."name" as "users.name", "orders"."id" as "orders.id", "orders"."total" as "orders.total" FROM "users" INNER JOIN orders on orders.user_id = users.id;`).WillReturnRows(ret)
+
+	err = query.Bind(nil, db, &testResults)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(testResults) != 2 {
+		t.Fatal("wrong number of results:", len(testResults))
+	}
+	if u := testResults[0].BindUser; u == nil || u.ID != 1 || u.Name != "pat" {
+		t.Errorf("wrong user: %#v", u)
+	}
+	if o := testResults[0].BindOrder; o.ID != 10 || o.Total != 100 {
+		t.Errorf("wrong order: %#v", o)
+	}
+	if u := testResults[1].BindUser; u == nil || u.ID != 2 || u.Name != "cat" {
+		t.Errorf("wrong user: %#v", u)
+	}
+	if o := testResults[1].BindOrder; o.ID != 11 || o.Total != 200 {
+		t.Errorf("wrong order: %#v", o)
+	}
+	if testResults[0].BindUser == testResults[1].BindUser {
+		t.Error("each row should have its own user")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestBind_InnerJoinSelect(t *testing.T) {
 	t.Parallel()
 
@@ -588,6 +796,98 @@ func TestBind_InnerJoinSelect(t *testing.T) {
 	}
 }
 
+func TestBindJSON(t *testing.T) {
+	t.Parallel()
+
+	type manifest struct {
+		Cargo  string   `json:"cargo"`
+		Crates []string `json:"crates"`
+	}
+
+	testResults := []struct {
+		ID       int
+		Tags     map[string]interface{}
+		Manifest manifest
+		Raw      []byte
+	}{}
+
+	query := &Query{
+		from:    []string{"jets"},
+		dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true},
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ret := sqlmock.NewRows([]string{"id", "tags", "manifest", "raw"})
+	ret.AddRow(int64(1), []byte(`{"wing":"left","seats":2}`), []byte(`{"cargo":"mail","crates":["a","b"]}`), []byte(`{"x":1}`))
+	ret.AddRow(int64(2), `{"wing":"right"}`, nil, []byte(`[]`))
+	mock.ExpectQuery(`SELECT \* FROM "jets";`).WillReturnRows(ret)
+
+	if err = query.Bind(nil, db, &testResults); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(testResults) != 2 {
+		t.Fatal("wrong number of results:", len(testResults))
+	}
+
+	if tags := testResults[0].Tags; !reflect.DeepEqual(tags, map[string]interface{}{"wing": "left", "seats": float64(2)}) {
+		t.Error("wrong tags:", tags)
+	}
+	if m := testResults[0].Manifest; !reflect.DeepEqual(m, manifest{Cargo: "mail", Crates: []string{"a", "b"}}) {
+		t.Error("wrong manifest:", m)
+	}
+	if raw := string(testResults[0].Raw); raw != `{"x":1}` {
+		t.Error("want []byte left alone, got:", raw)
+	}
+
+	// The second row gets a map of its own and a null manifest is zeroed
+	if tags := testResults[1].Tags; !reflect.DeepEqual(tags, map[string]interface{}{"wing": "right"}) {
+		t.Error("wrong tags:", tags)
+	}
+	if m := testResults[1].Manifest; !reflect.DeepEqual(m, manifest{}) {
+		t.Error("wrong manifest:", m)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBindJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	var testResult struct {
+		ID   int
+		Tags map[string]interface{}
+	}
+
+	query := &Query{
+		from:    []string{"jets"},
+		dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true},
+	}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ret := sqlmock.NewRows([]string{"id", "tags"})
+	ret.AddRow(int64(1), []byte(`{"wing":`))
+	mock.ExpectQuery(`SELECT \* FROM "jets";`).WillReturnRows(ret)
+
+	err = query.Bind(nil, db, &testResult)
+	if err == nil {
+		t.Fatal("want an error for invalid json")
+	}
+	if !strings.Contains(err.Error(), "column tags does not hold valid json") {
+		t.Error("want the column in the error, got:", err)
+	}
+}
+
 func TestEqual(t *testing.T) {
 	t.Parallel()
 
@@ -607,6 +907,11 @@ func TestEqual(t *testing.T) {
 		{A: "hello", B: sql.NullString{Valid: false}, Want: false},
 		{A: now, B: now, Want: true},
 		{A: now, B: now.Add(time.Hour), Want: false},
+		{A: intPtr(5), B: int(5), Want: true},
+		{A: int64(5), B: intPtr(5), Want: true},
+		{A: intPtr(5), B: intPtr(6), Want: false},
+		{A: (*int)(nil), B: int(5), Want: false},
+		{A: &now, B: now, Want: true},
 	}
 
 	for i, test := range tests {
@@ -616,6 +921,59 @@ func TestEqual(t *testing.T) {
 	}
 }
 
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestAssignPointer(t *testing.T) {
+	t.Parallel()
+
+	var ptr *int
+	Assign(&ptr, 5)
+	if ptr == nil || *ptr != 5 {
+		t.Errorf("want 5, got %v", ptr)
+	}
+
+	src := intPtr(6)
+	Assign(&ptr, src)
+	if ptr == nil || *ptr != 6 || ptr == src {
+		t.Errorf("want a copy of 6, got %v", ptr)
+	}
+
+	Assign(&ptr, sql.NullInt64{Int64: 7, Valid: true})
+	if ptr == nil || *ptr != 7 {
+		t.Errorf("want 7, got %v", ptr)
+	}
+
+	Assign(&ptr, sql.NullInt64{})
+	if ptr != nil {
+		t.Errorf("want nil, got %v", *ptr)
+	}
+
+	var i int
+	Assign(&i, intPtr(8))
+	if i != 8 {
+		t.Errorf("want 8, got %d", i)
+	}
+
+	var ni sql.NullInt64
+	Assign(&ni, intPtr(9))
+	if !ni.Valid || ni.Int64 != 9 {
+		t.Errorf("want 9, got %#v", ni)
+	}
+
+	Assign(&ni, (*int)(nil))
+	if ni.Valid {
+		t.Errorf("want null, got %#v", ni)
+	}
+
+	var str *string
+	Assign(&str, sql.NullString{String: "hello", Valid: true})
+	if str == nil || *str != "hello" {
+		t.Errorf("want hello, got %v", str)
+	}
+}
+
 func TestAssignBytes(t *testing.T) {
 	t.Parallel()
 
@@ -883,3 +1241,104 @@ func TestUnTitleCase(t *testing.T) {
 		}
 	}
 }
+
+type benchPilot struct {
+	ID        int64     `boil:"id"`
+	Name      string    `boil:"name"`
+	Email     string    `boil:"email"`
+	Rating    float64   `boil:"rating"`
+	Active    bool      `boil:"active"`
+	CreatedAt time.Time `boil:"created_at"`
+	UpdatedAt time.Time `boil:"updated_at"`
+}
+
+var benchPilotColumns = []string{"id", "name", "email", "rating", "active", "created_at", "updated_at"}
+
+func benchPilotResult(n int) boiltest.Result {
+	now := time.Now()
+	res := boiltest.Result{Columns: benchPilotColumns}
+	for i := 0; i < n; i++ {
+		res.Rows = append(res.Rows, []interface{}{int64(i), "Ann", "ann@example.com", 4.5, true, now, now})
+	}
+	return res
+}
+
+func BenchmarkBind(b *testing.B) {
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	ctx := context.Background()
+
+	b.Run("struct", func(b *testing.B) {
+		res := benchPilotResult(1)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			exec.Expect(res)
+			var p benchPilot
+			if err := Raw("select * from pilots").Bind(ctx, exec, &p); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	for _, n := range []int{10, 100} {
+		res := benchPilotResult(n)
+
+		b.Run(fmt.Sprintf("slice/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				exec.Expect(res)
+				var p []benchPilot
+				if err := Raw("select * from pilots").Bind(ctx, exec, &p); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("ptrslice/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				exec.Expect(res)
+				var p []*benchPilot
+				if err := Raw("select * from pilots").Bind(ctx, exec, &p); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkBindMapping compares the per type mapping cache that Bind uses
+// to building the mapping for every call
+func BenchmarkBindMapping(b *testing.B) {
+	typ := reflect.TypeOf(benchPilot{})
+
+	b.Run("cached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := getMappingCache(typ).mapping(benchPilotColumns); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("cached-parallel", func(b *testing.B) {
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				if _, err := getMappingCache(typ).mapping(benchPilotColumns); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	})
+
+	b.Run("uncached", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := BindMapping(typ, MakeStructMapping(typ), benchPilotColumns); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,168 @@
+package queries
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+var (
+	typeInterface   = reflect.TypeOf((*interface{})(nil)).Elem()
+	typeBytes       = reflect.TypeOf([]byte(nil))
+	typeRawBytes    = reflect.TypeOf(sql.RawBytes(nil))
+	typeTime        = reflect.TypeOf(time.Time{})
+	typeNullInt64   = reflect.TypeOf(sql.NullInt64{})
+	typeNullInt32   = reflect.TypeOf(sql.NullInt32{})
+	typeNullFloat64 = reflect.TypeOf(sql.NullFloat64{})
+	typeNullBool    = reflect.TypeOf(sql.NullBool{})
+	typeNullString  = reflect.TypeOf(sql.NullString{})
+	typeNullTime    = reflect.TypeOf(sql.NullTime{})
+)
+
+// ScanRows reads the rows into a slice of values per row, for results
+// whose shape isn't known ahead of time, like those of raw queries. As with
+// Bind the caller owns the rows and MUST close them and check rows.Err.
+//
+// The scan destinations are picked from the column types the driver reports:
+// integers come back as int64, floats as float64, then bool, string,
+// time.Time and []byte. NULLs come back as nil. Columns the driver doesn't
+// describe are returned as the driver gave them.
+//
+// To read the rows into structs use Bind instead.
+func ScanRows(rows *sql.Rows) (columns []string, results [][]interface{}, err error) {
+	colTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get column types")
+	}
+
+	columns = make([]string, len(colTypes))
+	scanTypes := make([]reflect.Type, len(colTypes))
+	for i, ct := range colTypes {
+		columns[i] = ct.Name()
+		scanTypes[i] = scanDestType(ct.ScanType())
+	}
+
+	for rows.Next() {
+		ptrs := make([]interface{}, len(scanTypes))
+		for i, typ := range scanTypes {
+			ptrs[i] = reflect.New(typ).Interface()
+		}
+
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, nil, errors.Wrap(err, "failed to scan row")
+		}
+
+		row := make([]interface{}, len(ptrs))
+		for i, ptr := range ptrs {
+			row[i] = scannedValue(ptr)
+		}
+		results = append(results, row)
+	}
+
+	return columns, results, nil
+}
+
+// ScanRows executes the query and reads the rows into a slice of values per
+// row. Also see documentation for ScanRows()
+func (q *Query) ScanRows(ctx context.Context, exec boil.Executor) (columns []string, results [][]interface{}, err error) {
+	var rows *sql.Rows
+	if ctx != nil {
+		rows, err = q.QueryContext(ctx, exec.(boil.ContextExecutor))
+	} else {
+		rows, err = q.Query(exec)
+	}
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "scan rows failed to execute query")
+	}
+	defer rows.Close()
+
+	columns, results, err = ScanRows(rows)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, nil, errors.Wrap(err, "scan rows failed to iterate rows")
+	}
+
+	return columns, results, nil
+}
+
+// scanDestType picks the nullable type to scan a column of the given scan
+// type into
+func scanDestType(typ reflect.Type) reflect.Type {
+	if typ == nil || typ == typeInterface {
+		return typeInterface
+	}
+
+	switch typ {
+	case typeNullInt64, typeNullInt32:
+		return typeNullInt64
+	case typeNullFloat64:
+		return typeNullFloat64
+	case typeNullBool:
+		return typeNullBool
+	case typeNullString:
+		return typeNullString
+	case typeNullTime, typeTime:
+		return typeNullTime
+	case typeBytes, typeRawBytes:
+		return typeBytes
+	}
+
+	if typ.Kind() == reflect.Ptr {
+		return scanDestType(typ.Elem())
+	}
+
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return typeNullInt64
+	case reflect.Float32, reflect.Float64:
+		return typeNullFloat64
+	case reflect.Bool:
+		return typeNullBool
+	case reflect.String:
+		return typeNullString
+	}
+
+	return typeInterface
+}
+
+// scannedValue unwraps the value scanned into ptr, NULLs become nil
+func scannedValue(ptr interface{}) interface{} {
+	switch v := ptr.(type) {
+	case *sql.NullInt64:
+		if v.Valid {
+			return v.Int64
+		}
+	case *sql.NullFloat64:
+		if v.Valid {
+			return v.Float64
+		}
+	case *sql.NullBool:
+		if v.Valid {
+			return v.Bool
+		}
+	case *sql.NullString:
+		if v.Valid {
+			return v.String
+		}
+	case *sql.NullTime:
+		if v.Valid {
+			return v.Time
+		}
+	case *[]byte:
+		if *v != nil {
+			return *v
+		}
+	case *interface{}:
+		return *v
+	}
+
+	return nil
+}
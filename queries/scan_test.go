@@ -0,0 +1,95 @@
+package queries
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil/boiltest"
+)
+
+func TestScanRows(t *testing.T) {
+	t.Parallel()
+
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	now := time.Date(2020, 5, 6, 7, 8, 9, 0, time.UTC)
+	exec.Expect(boiltest.Result{
+		Columns: []string{"id", "name", "rating", "active", "created_at", "photo", "unknown"},
+		Rows: [][]interface{}{
+			{int64(1), "Ann", 4.5, true, now, []byte("png"), nil},
+			{int64(2), nil, nil, nil, nil, nil, nil},
+		},
+	})
+
+	q := Raw("select * from pilots")
+	columns, results, err := q.ScanRows(context.Background(), exec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantColumns := []string{"id", "name", "rating", "active", "created_at", "photo", "unknown"}
+	if !reflect.DeepEqual(columns, wantColumns) {
+		t.Errorf("want columns: %v, got: %v", wantColumns, columns)
+	}
+
+	want := [][]interface{}{
+		{int64(1), "Ann", 4.5, true, now, []byte("png"), nil},
+		{int64(2), nil, nil, nil, nil, nil, nil},
+	}
+	if !reflect.DeepEqual(results, want) {
+		t.Errorf("want:\n%#v\ngot:\n%#v", want, results)
+	}
+}
+
+func TestScanRowsNoRows(t *testing.T) {
+	t.Parallel()
+
+	exec := boiltest.NewExecutor()
+	defer exec.Close()
+
+	exec.Expect(boiltest.Result{Columns: []string{"id"}})
+
+	columns, results, err := Raw("select id from pilots").ScanRows(nil, exec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(columns, []string{"id"}) {
+		t.Errorf("want the columns, got: %v", columns)
+	}
+	if results != nil {
+		t.Errorf("want no results, got: %#v", results)
+	}
+}
+
+func TestScanDestType(t *testing.T) {
+	t.Parallel()
+
+	var i8 int8
+	tests := []struct {
+		In   reflect.Type
+		Want reflect.Type
+	}{
+		{nil, typeInterface},
+		{typeInterface, typeInterface},
+		{reflect.TypeOf(int32(0)), typeNullInt64},
+		{reflect.TypeOf(&i8), typeNullInt64},
+		{reflect.TypeOf(uint64(0)), typeNullInt64},
+		{reflect.TypeOf(float32(0)), typeNullFloat64},
+		{reflect.TypeOf(false), typeNullBool},
+		{reflect.TypeOf(""), typeNullString},
+		{typeTime, typeNullTime},
+		{typeNullInt32, typeNullInt64},
+		{typeNullString, typeNullString},
+		{typeRawBytes, typeBytes},
+		{reflect.TypeOf(struct{}{}), typeInterface},
+	}
+
+	for i, test := range tests {
+		if got := scanDestType(test.In); got != test.Want {
+			t.Errorf("%d) want: %v, got: %v", i, test.Want, got)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package queries
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+var (
+	rgxColumnRef = regexp.MustCompile(`(?i)(?:^|[\s(,])((?:["` + "`" + `]?[a-z_][a-z0-9_]*["` + "`" + `]?\.)?["` + "`" + `]?[a-z_][a-z0-9_]*["` + "`" + `]?)\s*(?:=|<>|!=|<=|>=|<|>|\sNOT\s+IN\b|\sIN\b|\sIS\b|\sNOT\s+LIKE\b|\sLIKE\b|\sILIKE\b|\sBETWEEN\b)`)
+
+	rgxCTEName = regexp.MustCompile(`^(?i)\s*(?:RECURSIVE\s+)?["` + "`" + `]?([a-z_][a-z0-9_]*)`)
+
+	columnRefKeywords = map[string]struct{}{
+		"and": {}, "or": {}, "not": {}, "null": {}, "true": {}, "false": {},
+	}
+)
+
+// ValidateQuery checks the columns referenced by the query's select and
+// where clauses against the columns registered with
+// boil.RegisterTableColumns. The columns of tables that were never
+// registered, raw queries, and expressions it cannot make sense of are
+// skipped, so this is a best-effort check meant to catch typos rather than
+// a SQL parser.
+//
+// Unqualified column names are only checked when the query has no joins,
+// since they could otherwise belong to any of the joined tables.
+//
+// Once any table has been registered the names in the FROM clause must also
+// be either a registered table or a CTE declared on the query with a WITH
+// clause (see AppendWith).
+func ValidateQuery(q *Query) error {
+	if len(q.from) == 0 {
+		return nil
+	}
+
+	ctes := make(map[string]struct{})
+	for _, w := range q.withs {
+		for _, name := range cteNames(w.clause) {
+			ctes[name] = struct{}{}
+		}
+	}
+
+	checkFrom := boil.HasRegisteredTables()
+	tables := make(map[string]string)
+	var fromTables []string
+	for _, f := range q.from {
+		alias, name, ok := parseFromClause(strings.Split(f, " "))
+		if !ok {
+			continue
+		}
+		name = unqualifiedName(name)
+		if _, isCTE := ctes[name]; checkFrom && !isCTE && !boil.IsTableRegistered(name) {
+			return errors.Errorf("%q is not a registered table or a declared CTE", name)
+		}
+		tables[name] = name
+		if len(alias) != 0 {
+			tables[alias] = name
+		}
+		fromTables = append(fromTables, name)
+	}
+	for _, j := range q.joins {
+		alias, name, ok := parseFromClause(strings.Split(j.clause, " "))
+		if !ok {
+			continue
+		}
+		name = unqualifiedName(name)
+		tables[name] = name
+		if len(alias) != 0 {
+			tables[alias] = name
+		}
+	}
+
+	checkUnqualified := len(q.joins) == 0
+	check := func(ref string) error {
+		var table, column string
+		if i := strings.LastIndexByte(ref, '.'); i >= 0 {
+			table, column = stripQuotes(ref[:i]), stripQuotes(ref[i+1:])
+			if column == "*" {
+				return nil
+			}
+			name, ok := tables[table]
+			if !ok {
+				return nil
+			}
+			if registered, exists := boil.TableHasColumn(name, column); registered && !exists {
+				return errors.Errorf("column %q does not exist in table %q", column, name)
+			}
+			return nil
+		}
+
+		column = stripQuotes(ref)
+		if _, ok := columnRefKeywords[strings.ToLower(column)]; ok || !checkUnqualified {
+			return nil
+		}
+
+		anyRegistered := false
+		for _, name := range fromTables {
+			registered, exists := boil.TableHasColumn(name, column)
+			if exists {
+				return nil
+			}
+			anyRegistered = anyRegistered || registered
+		}
+		if anyRegistered {
+			return errors.Errorf("column %q does not exist in table(s) %s", column, strings.Join(fromTables, ", "))
+		}
+		return nil
+	}
+
+	for _, col := range q.selectCols {
+		col = strings.TrimSpace(col)
+		if !rgxIdentifier.MatchString(col) && !strings.HasSuffix(col, ".*") {
+			continue
+		}
+		if err := check(col); err != nil {
+			return err
+		}
+	}
+
+	for _, w := range q.where {
+		for _, match := range rgxColumnRef.FindAllStringSubmatch(w.clause, -1) {
+			if err := check(match[1]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// cteNames returns the names of the CTEs declared by a WITH clause, one
+// clause can declare several of them separated by commas
+func cteNames(clause string) []string {
+	var names []string
+	for _, cte := range splitTopLevel(clause, ',') {
+		if m := rgxCTEName.FindStringSubmatch(cte); m != nil {
+			names = append(names, m[1])
+		}
+	}
+	return names
+}
+
+// validateStrict runs ValidateQuery if boil.StrictMode is enabled
+func validateStrict(q *Query) error {
+	if !boil.StrictMode {
+		return nil
+	}
+	return ValidateQuery(q)
+}
+
+func unqualifiedName(name string) string {
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		name = name[i+1:]
+	}
+	return stripQuotes(name)
+}
+
+func stripQuotes(s string) string {
+	return strings.Trim(s, "\"`[]")
+}
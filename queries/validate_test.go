@@ -0,0 +1,134 @@
+package queries
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+func TestValidateQuery(t *testing.T) {
+	t.Parallel()
+
+	boil.RegisterTableColumns("validate_users", []string{"id", "name", "email"})
+	boil.RegisterTableColumns("validate_videos", []string{"id", "user_id", "title"})
+
+	tests := []struct {
+		q   *Query
+		err bool
+	}{
+		{q: &Query{from: []string{"validate_users"}, where: []where{{clause: "id=?"}}}},
+		{q: &Query{from: []string{"validate_users"}, where: []where{{clause: "nmae = ?"}}}, err: true},
+		{q: &Query{from: []string{`"validate_users"`}, where: []where{{clause: `"validate_users"."email" IS NULL`}}}},
+		{q: &Query{from: []string{`"validate_users"`}, where: []where{{clause: `"validate_users"."emial" IS NULL`}}}, err: true},
+		{q: &Query{from: []string{"validate_users as u"}, where: []where{{clause: "u.id > ? AND u.nam LIKE ?"}}}, err: true},
+		{q: &Query{from: []string{"validate_users"}, where: []where{{kind: whereKindIn, clause: "id NOT IN ?"}}}},
+		{q: &Query{from: []string{"validate_users"}, where: []where{{kind: whereKindIn, clause: "ids IN ?"}}}, err: true},
+		{q: &Query{from: []string{"validate_users"}, selectCols: []string{`"validate_users".*`, "name"}}},
+		{q: &Query{from: []string{"validate_users"}, selectCols: []string{"naem"}}, err: true},
+		{q: &Query{from: []string{"validate_users"}, selectCols: []string{"count(*) as naem"}}},
+		// The columns of CTEs are not checked
+		{q: &Query{
+			withs: []argClause{{clause: "validate_recent AS (SELECT * FROM validate_users)"}},
+			from:  []string{"validate_recent"},
+			where: []where{{clause: "whatever = ?"}},
+		}},
+		{q: &Query{
+			withs: []argClause{{clause: `RECURSIVE "validate_tree"(id) AS (SELECT 1)`}},
+			from:  []string{"validate_users", "validate_tree"},
+		}},
+		{q: &Query{
+			withs: []argClause{{clause: `validate_a AS (SELECT 1, 2), "validate_b"(x, y) AS (SELECT * FROM validate_a)`}},
+			from:  []string{"validate_a", "validate_b"},
+		}},
+		{q: &Query{
+			withs: []argClause{
+				{clause: "validate_a AS (SELECT 1)"},
+				{clause: "validate_b AS (SELECT 2), validate_c AS (SELECT 3)"},
+			},
+			from: []string{"validate_c"},
+		}},
+		// Names in FROM must be registered or declared
+		{q: &Query{from: []string{"validate_unknown"}, where: []where{{clause: "whatever = ?"}}}, err: true},
+		{q: &Query{
+			withs: []argClause{{clause: "validate_recent AS (SELECT * FROM validate_users)"}},
+			from:  []string{"validate_recnet"},
+		}, err: true},
+		{q: &Query{
+			withs: []argClause{{clause: "validate_a AS (SELECT 1), validate_b AS (SELECT 2)"}},
+			from:  []string{"validate_c"},
+		}, err: true},
+		// Unqualified columns are ambiguous with joins, qualified ones are checked
+		{q: &Query{
+			from:  []string{"validate_users"},
+			joins: []join{{clause: "validate_videos on validate_videos.user_id = validate_users.id"}},
+			where: []where{{clause: "title = ?"}},
+		}},
+		{q: &Query{
+			from:  []string{"validate_users"},
+			joins: []join{{clause: "validate_videos v on v.user_id = validate_users.id"}},
+			where: []where{{clause: "v.titel = ?"}},
+		}, err: true},
+		{q: Raw("select nope from validate_users")},
+	}
+
+	for i, test := range tests {
+		err := ValidateQuery(test.q)
+		if test.err && err == nil {
+			t.Errorf("%d) expected an error", i)
+		} else if !test.err && err != nil {
+			t.Errorf("%d) unexpected error: %v", i, err)
+		}
+	}
+}
+
+func TestStrictMode(t *testing.T) {
+	// Not parallel, this test toggles global state
+	boil.RegisterTableColumns("strict_users", []string{"id", "name"})
+	defer boil.UnregisterTableColumns("strict_users")
+
+	boil.StrictMode = true
+	defer func() { boil.StrictMode = false }()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	q := &Query{
+		from:    []string{"strict_users"},
+		where:   []where{{clause: "nmae = ?", args: []interface{}{"bob"}}},
+		dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true},
+	}
+
+	if _, err = q.Query(db); err == nil {
+		t.Error("expected an error for an unknown column in strict mode")
+	}
+
+	cte := &Query{
+		withs:   []argClause{{clause: "recent AS (SELECT * FROM strict_users)"}},
+		from:    []string{"recnet"},
+		dialect: &drivers.Dialect{LQ: '"', RQ: '"', UseIndexPlaceholders: true},
+	}
+	if _, err = cte.Query(db); err == nil {
+		t.Error("expected an error for an undeclared cte in strict mode")
+	}
+
+	var id int
+	if err = q.QueryRow(db).Scan(&id); err == nil {
+		t.Error("expected QueryRow's row to return an error in strict mode")
+	}
+
+	boil.StrictMode = false
+	mock.ExpectQuery(`SELECT \* FROM "strict_users" WHERE \(nmae = \$1\);`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	rows, err := q.Query(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows.Close()
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
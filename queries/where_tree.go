@@ -0,0 +1,154 @@
+package queries
+
+import "github.com/friendsofgo/errors"
+
+type whereNodeKind int
+
+const (
+	whereNodeLeaf whereNodeKind = iota
+	whereNodeAnd
+	whereNodeOr
+	whereNodeGroup
+)
+
+// whereNode is a node in the tree representation of a query's where
+// clauses. Leaves hold a single where expression, AND and OR nodes join
+// their children together, and group nodes are parenthesized expressions.
+//
+// The tree always has the same shape: an OR node's children are AND
+// nodes, an AND node's children are leaves and groups, and a group has a
+// single OR node as its child. AND and OR nodes with a single child are
+// written out as just the child.
+type whereNode struct {
+	kind     whereNodeKind
+	where    where
+	children []*whereNode
+}
+
+// whereTree is built up as the where query mods are applied to a query,
+// respecting the usual precedence of AND over OR and the groups created
+// with left and right parens. This means appending a, OR b, c produces
+// the tree OR(a, AND(b, c)) which is what the database would do with it
+// as well.
+//
+// This lets the Append* and SetLast*AsOr functions keep working as simple
+// appends.
+type whereTree struct {
+	root *whereNode
+	// open are the groups that were not closed by a right paren yet, the
+	// innermost one last
+	open []*whereNode
+	// last is the leaf or group that was added or closed last, it's the
+	// one SetLastWhereAsOr applies to
+	last *whereNode
+	// err is the first error found building the tree, it's returned when
+	// the query is built since query mods can't return errors
+	err error
+}
+
+func newWhereTree() *whereTree {
+	return &whereTree{root: &whereNode{kind: whereNodeOr}}
+}
+
+// buildWhereTree builds a tree from a list of wheres, for queries whose
+// wheres were set directly rather than appended.
+func buildWhereTree(wheres []where) *whereTree {
+	tree := newWhereTree()
+	for _, w := range wheres {
+		tree.add(w)
+	}
+	return tree
+}
+
+// appendWhere adds w to both the wheres of the query and its tree
+func appendWhere(q *Query, w where) {
+	if q.whereTree == nil {
+		q.whereTree = buildWhereTree(q.where)
+	}
+
+	q.where = append(q.where, w)
+	q.whereTree.add(w)
+}
+
+// add puts w into the tree. The separator of the first expression in a
+// group is meaningless and ignored.
+func (t *whereTree) add(w where) {
+	switch w.kind {
+	case whereKindRightParen:
+		if len(t.open) == 0 {
+			if t.err == nil {
+				t.err = errors.New("unbalanced parens in where query expr, found ) without matching (")
+			}
+			return
+		}
+		t.last = t.open[len(t.open)-1]
+		t.open = t.open[:len(t.open)-1]
+	case whereKindLeftParen:
+		group := &whereNode{kind: whereNodeGroup, where: w, children: []*whereNode{{kind: whereNodeOr}}}
+		t.addChild(t.current(), group, w.orSeparator)
+		t.open = append(t.open, group)
+		t.last = group
+	default:
+		leaf := &whereNode{kind: whereNodeLeaf, where: w}
+		t.addChild(t.current(), leaf, w.orSeparator)
+		t.last = leaf
+	}
+}
+
+// setLastOr makes the last leaf or group added to the tree OR'd with what
+// comes before it instead of AND'd
+func (t *whereTree) setLastOr() {
+	if t.last == nil {
+		return
+	}
+
+	// A group that was just opened is in the group that contains it
+	or := t.current()
+	if len(t.open) != 0 && t.last == t.open[len(t.open)-1] {
+		or = t.parent()
+	}
+
+	and := or.children[len(or.children)-1]
+	if len(and.children) < 2 {
+		return
+	}
+	and.children = and.children[:len(and.children)-1]
+	or.children = append(or.children, &whereNode{kind: whereNodeAnd, children: []*whereNode{t.last}})
+}
+
+// check returns the error found building the tree, if any
+func (t *whereTree) check() error {
+	if t.err != nil {
+		return t.err
+	}
+	if len(t.open) != 0 {
+		return errors.New("unbalanced parens in where query expr, found ( without matching )")
+	}
+	return nil
+}
+
+// current returns the OR node of the innermost open group
+func (t *whereTree) current() *whereNode {
+	if len(t.open) == 0 {
+		return t.root
+	}
+	return t.open[len(t.open)-1].children[0]
+}
+
+// parent returns the OR node of the group containing the innermost open
+// group
+func (t *whereTree) parent() *whereNode {
+	if len(t.open) < 2 {
+		return t.root
+	}
+	return t.open[len(t.open)-2].children[0]
+}
+
+func (t *whereTree) addChild(or, child *whereNode, orSeparator bool) {
+	if len(or.children) == 0 || orSeparator {
+		or.children = append(or.children, &whereNode{kind: whereNodeAnd})
+	}
+
+	and := or.children[len(or.children)-1]
+	and.children = append(and.children, child)
+}
@@ -0,0 +1,173 @@
+package queries
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+)
+
+// whereTreeString prints a tree in a compact form for comparisons
+func whereTreeString(node *whereNode) string {
+	switch node.kind {
+	case whereNodeLeaf:
+		return node.where.clause
+	case whereNodeGroup:
+		return "(" + whereTreeString(node.children[0]) + ")"
+	}
+
+	if len(node.children) == 1 {
+		return whereTreeString(node.children[0])
+	}
+
+	parts := make([]string, len(node.children))
+	for i, c := range node.children {
+		parts[i] = whereTreeString(c)
+	}
+	if node.kind == whereNodeOr {
+		return "OR[" + strings.Join(parts, " ") + "]"
+	}
+	return "AND[" + strings.Join(parts, " ") + "]"
+}
+
+func TestBuildWhereTree(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		In  []where
+		Out string
+	}{
+		{
+			In:  []where{{clause: "a"}},
+			Out: "a",
+		},
+		{
+			In:  []where{{clause: "a"}, {clause: "b"}, {clause: "c"}},
+			Out: "AND[a b c]",
+		},
+		{
+			In:  []where{{clause: "a"}, {clause: "b", orSeparator: true}, {clause: "c"}},
+			Out: "OR[a AND[b c]]",
+		},
+		{
+			In: []where{
+				{clause: "a"},
+				{kind: whereKindLeftParen},
+				{clause: "b"},
+				{clause: "c", orSeparator: true},
+				{kind: whereKindRightParen},
+			},
+			Out: "AND[a (OR[b c])]",
+		},
+		{
+			// The separator on the first expression in a group is ignored
+			In: []where{
+				{kind: whereKindLeftParen, orSeparator: true},
+				{clause: "a", orSeparator: true},
+				{kind: whereKindLeftParen},
+				{clause: "b"},
+				{kind: whereKindRightParen},
+				{kind: whereKindRightParen},
+			},
+			Out: "(AND[a (b)])",
+		},
+	}
+
+	for i, test := range tests {
+		if got := whereTreeString(buildWhereTree(test.In).root); got != test.Out {
+			t.Errorf("%d) want: %s, got: %s", i, test.Out, got)
+		}
+	}
+}
+
+func TestBuildWhereTreeUnbalanced(t *testing.T) {
+	t.Parallel()
+
+	tests := [][]where{
+		{{kind: whereKindLeftParen}, {clause: "a"}},
+		{{clause: "a"}, {kind: whereKindRightParen}},
+	}
+
+	for i, test := range tests {
+		if err := buildWhereTree(test).check(); err == nil {
+			t.Errorf("%d) expected an error", i)
+		}
+	}
+}
+
+func TestWhereTreeAppend(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Mods func(q *Query)
+		Out  string
+	}{
+		{
+			Mods: func(q *Query) {
+				AppendWhere(q, "a")
+				AppendWhere(q, "b")
+				SetLastWhereAsOr(q)
+				AppendWhere(q, "c")
+			},
+			Out: "OR[a AND[b c]]",
+		},
+		{
+			Mods: func(q *Query) {
+				AppendWhere(q, "a")
+				AppendWhereLeftParen(q)
+				AppendWhere(q, "b")
+				AppendWhere(q, "c")
+				SetLastWhereAsOr(q)
+				AppendWhereRightParen(q)
+				SetLastWhereAsOr(q)
+			},
+			Out: "OR[a (OR[b c])]",
+		},
+		{
+			Mods: func(q *Query) {
+				AppendWhere(q, "a")
+				AppendWhereLeftParen(q)
+				SetLastWhereAsOr(q)
+				AppendWhere(q, "b")
+				AppendWhereRightParen(q)
+				AppendWhere(q, "c")
+			},
+			Out: "OR[a AND[(b) c]]",
+		},
+	}
+
+	for i, test := range tests {
+		q := &Query{}
+		test.Mods(q)
+		if got := whereTreeString(q.whereTree.root); got != test.Out {
+			t.Errorf("%d) want: %s, got: %s", i, test.Out, got)
+		}
+		if got := whereTreeString(buildWhereTree(q.where).root); got != test.Out {
+			t.Errorf("%d) rebuilt want: %s, got: %s", i, test.Out, got)
+		}
+	}
+}
+
+func TestBuildQueryUnbalancedParens(t *testing.T) {
+	t.Parallel()
+
+	q := &Query{dialect: &drivers.Dialect{LQ: '"', RQ: '"'}, from: []string{"t"}}
+	AppendWhere(q, "a=?", 1)
+	AppendWhereRightParen(q)
+
+	if _, _, err := buildQuery(q); err == nil {
+		t.Error("expected an error for a ) without a matching (")
+	}
+
+	q = &Query{dialect: &drivers.Dialect{LQ: '"', RQ: '"'}, from: []string{"t"}}
+	AppendWhereLeftParen(q)
+	AppendWhere(q, "a=?", 1)
+
+	if _, err := q.Exec(nil); err == nil {
+		t.Error("expected an error for a ( without a matching )")
+	}
+	var one int
+	if err := q.QueryRow(nil).Scan(&one); err == nil {
+		t.Error("expected the row to return an error for a ( without a matching )")
+	}
+}
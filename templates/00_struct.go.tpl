@@ -10,14 +10,8 @@ type {{$alias.UpSingular}} struct {
 	{{end -}}
 	{{if ignore $orig_tbl_name $orig_col_name $.TagIgnore -}}
 	{{$colAlias}} {{$column.Type}} `{{generateIgnoreTags $.Tags}}boil:"{{$column.Name}}" json:"-" toml:"-" yaml:"-"`
-	{{else if eq $.StructTagCasing "title" -}}
-	{{$colAlias}} {{$column.Type}} `{{generateTags $.Tags $column.Name}}boil:"{{$column.Name}}" json:"{{$column.Name | titleCase}}{{if $column.Nullable}},omitempty{{end}}" toml:"{{$column.Name | titleCase}}" yaml:"{{$column.Name | titleCase}}{{if $column.Nullable}},omitempty{{end}}"`
-	{{else if eq $.StructTagCasing "camel" -}}
-	{{$colAlias}} {{$column.Type}} `{{generateTags $.Tags $column.Name}}boil:"{{$column.Name}}" json:"{{$column.Name | camelCase}}{{if $column.Nullable}},omitempty{{end}}" toml:"{{$column.Name | camelCase}}" yaml:"{{$column.Name | camelCase}}{{if $column.Nullable}},omitempty{{end}}"`
-	{{else if eq $.StructTagCasing "alias" -}}
-	{{$colAlias}} {{$column.Type}} `{{generateTags $.Tags $colAlias}}boil:"{{$column.Name}}" json:"{{$colAlias}}{{if $column.Nullable}},omitempty{{end}}" toml:"{{$colAlias}}" yaml:"{{$colAlias}}{{if $column.Nullable}},omitempty{{end}}"`
 	{{else -}}
-	{{$colAlias}} {{$column.Type}} `{{generateTags $.Tags $column.Name}}boil:"{{$column.Name}}" json:"{{$column.Name}}{{if $column.Nullable}},omitempty{{end}}" toml:"{{$column.Name}}" yaml:"{{$column.Name}}{{if $column.Nullable}},omitempty{{end}}"`
+	{{$colAlias}} {{$column.Type}} `{{range $tag := $.Tags}}{{$tag}}:"{{tagName ($.TagCase $tag) $column.Name $colAlias}}" {{end}}boil:"{{$column.Name}}" json:"{{tagName ($.TagCase "json") $column.Name $colAlias}}{{if $column.Nullable}},omitempty{{end}}" toml:"{{tagName ($.TagCase "toml") $column.Name $colAlias}}" yaml:"{{tagName ($.TagCase "yaml") $column.Name $colAlias}}{{if $column.Nullable}},omitempty{{end}}"`
 	{{end -}}
 	{{end -}}
 	{{- if .Table.IsJoinTable -}}
@@ -27,6 +21,8 @@ type {{$alias.UpSingular}} struct {
 	{{end -}}
 }
 
+// {{$alias.UpSingular}}Columns holds the names of the columns of the {{.Table.Name}} table,
+// use them instead of string literals so renamed columns fail to compile.
 var {{$alias.UpSingular}}Columns = struct {
 	{{range $column := .Table.Columns -}}
 	{{- $colAlias := $alias.Column $column.Name -}}
@@ -105,6 +101,23 @@ var {{$alias.UpSingular}}Rels = struct {
 	{{- $relAlias := $.Aliases.ManyRelationship .ForeignTable .Name .JoinTable .JoinLocalFKeyName -}}
 	{{$relAlias.Local}} string
 	{{end -}}{{/* range tomany */}}
+
+	{{range .Relationships -}}
+	{{- if eq .Table $.Table.Name -}}
+	{{.Name}} string
+	{{end -}}
+	{{- end -}}{{/* range config relationships */}}
+
+	{{range $poly := .Polymorphic -}}
+	{{- if eq $poly.Table $.Table.Name -}}
+	{{- range $owner, $type := $poly.Types -}}
+	{{titleCase $poly.Name}}{{($.Aliases.Table $owner).UpSingular}} string
+	{{end -}}
+	{{- end -}}
+	{{- if index $poly.Types $.Table.Name -}}
+	{{($.Aliases.Table $poly.Table).UpPlural}} string
+	{{end -}}
+	{{- end -}}{{/* range polymorphic */}}
 }{
 	{{range .Table.FKeys -}}
 	{{- $relAlias := $alias.Relationship .Name -}}
@@ -121,6 +134,25 @@ var {{$alias.UpSingular}}Rels = struct {
 	{{- $relAlias := $.Aliases.ManyRelationship .ForeignTable .Name .JoinTable .JoinLocalFKeyName -}}
 	{{$relAlias.Local}}: "{{$relAlias.Local}}",
 	{{end -}}{{/* range tomany */}}
+
+	{{range .Relationships -}}
+	{{- if eq .Table $.Table.Name -}}
+	{{.Name}}: "{{.Name}}",
+	{{end -}}
+	{{- end -}}{{/* range config relationships */}}
+
+	{{range $poly := .Polymorphic -}}
+	{{- if eq $poly.Table $.Table.Name -}}
+	{{- range $owner, $type := $poly.Types -}}
+	{{- $relName := printf "%s%s" (titleCase $poly.Name) ($.Aliases.Table $owner).UpSingular -}}
+	{{$relName}}: "{{$relName}}",
+	{{end -}}
+	{{- end -}}
+	{{- if index $poly.Types $.Table.Name -}}
+	{{- $relName := ($.Aliases.Table $poly.Table).UpPlural -}}
+	{{$relName}}: "{{$relName}}",
+	{{end -}}
+	{{- end -}}{{/* range polymorphic */}}
 }
 
 // {{$alias.DownSingular}}R is where relationships are stored.
@@ -142,6 +174,27 @@ type {{$alias.DownSingular}}R struct {
 	{{- $relAlias := $.Aliases.ManyRelationship .ForeignTable .Name .JoinTable .JoinLocalFKeyName -}}
 	{{$relAlias.Local}} {{printf "%sSlice" $ftable.UpSingular}} `{{generateTags $.Tags $relAlias.Local}}boil:"{{$relAlias.Local}}" json:"{{$relAlias.Local}}" toml:"{{$relAlias.Local}}" yaml:"{{$relAlias.Local}}"`
 	{{end -}}{{/* range tomany */}}
+
+	{{range .Relationships -}}
+	{{- if eq .Table $.Table.Name -}}
+	{{- $ftable := $.Aliases.Table .ForeignTable -}}
+	{{.Name}} {{if .ToMany}}{{printf "%sSlice" $ftable.UpSingular}}{{else}}*{{$ftable.UpSingular}}{{end}} `{{generateTags $.Tags .Name}}boil:"{{.Name}}" json:"{{.Name}}" toml:"{{.Name}}" yaml:"{{.Name}}"`
+	{{end -}}
+	{{- end -}}{{/* range config relationships */}}
+
+	{{range $poly := .Polymorphic -}}
+	{{- if eq $poly.Table $.Table.Name -}}
+	{{- range $owner, $type := $poly.Types -}}
+	{{- $otable := $.Aliases.Table $owner -}}
+	{{- $relName := printf "%s%s" (titleCase $poly.Name) $otable.UpSingular -}}
+	{{$relName}} *{{$otable.UpSingular}} `{{generateTags $.Tags $relName}}boil:"{{$relName}}" json:"{{$relName}}" toml:"{{$relName}}" yaml:"{{$relName}}"`
+	{{end -}}
+	{{- end -}}
+	{{- if index $poly.Types $.Table.Name -}}
+	{{- $ftable := $.Aliases.Table $poly.Table -}}
+	{{$ftable.UpPlural}} {{printf "%sSlice" $ftable.UpSingular}} `{{generateTags $.Tags $ftable.UpPlural}}boil:"{{$ftable.UpPlural}}" json:"{{$ftable.UpPlural}}" toml:"{{$ftable.UpPlural}}" yaml:"{{$ftable.UpPlural}}"`
+	{{end -}}
+	{{- end -}}{{/* range polymorphic */}}
 }
 
 // NewStruct creates a new relationship struct
@@ -8,7 +8,11 @@ var (
 	{{end -}}
 	{{$alias.DownSingular}}ColumnsWithoutDefault = []string{{"{"}}{{.Table.Columns | filterColumnsByDefault false | columnNames | stringMap .StringFuncs.quoteWrap | join ","}}{{"}"}}
 	{{$alias.DownSingular}}ColumnsWithDefault    = []string{{"{"}}{{.Table.Columns | filterColumnsByDefault true | columnNames | stringMap .StringFuncs.quoteWrap | join ","}}{{"}"}}
+	{{- if not .Table.IsView}}
 	{{$alias.DownSingular}}PrimaryKeyColumns     = []string{{"{"}}{{.Table.PKey.Columns | stringMap .StringFuncs.quoteWrap | join ", "}}{{"}"}}
+	// {{$alias.DownSingular}}ImmutableColumns are left out of the inferred update columns of an upsert
+	{{$alias.DownSingular}}ImmutableColumns      = []string{{"{"}}{{if and (not .NoAutoTimestamps) (containsAny (.Table.Columns | columnNames) .AutoColumns.Created)}}{{.AutoColumns.Created | quoteWrap}}{{end}}{{"}"}}
+	{{- end}}
 )
 
 type (
@@ -25,7 +29,13 @@ type (
 	}
 )
 
-// Cache for insert, update and upsert
+{{if .Table.IsView -}}
+var (
+	{{$alias.DownSingular}}Type = reflect.TypeOf(&{{$alias.UpSingular}}{})
+	{{$alias.DownSingular}}Mapping = queries.MakeStructMapping({{$alias.DownSingular}}Type)
+)
+{{- else -}}
+// Cache for find, insert, update and upsert
 var (
 	{{$alias.DownSingular}}Type = reflect.TypeOf(&{{$alias.UpSingular}}{})
 	{{$alias.DownSingular}}Mapping = queries.MakeStructMapping({{$alias.DownSingular}}Type)
@@ -36,7 +46,10 @@ var (
 	{{$alias.DownSingular}}UpdateCache = make(map[string]updateCache)
 	{{$alias.DownSingular}}UpsertCacheMut sync.RWMutex
 	{{$alias.DownSingular}}UpsertCache = make(map[string]insertCache)
+	{{$alias.DownSingular}}FindCacheMut sync.RWMutex
+	{{$alias.DownSingular}}FindCache = make(map[string]string)
 )
+{{- end}}
 
 var (
 	// Force time package dependency for automated UpdatedAt/CreatedAt.
@@ -44,5 +57,16 @@ var (
 	// Force qmhelper dependency for where clause generation (which doesn't
 	// always happen)
 	_ = qmhelper.Where
+	{{- if .Table.IsView}}
+	// Force the dependencies of the write methods views don't have
+	_ = fmt.Sprintf
+	_ = strings.Join
+	_ = sync.NewCond
+	_ = strmangle.TitleCase
+	{{- end}}
+	{{- if .AddValidation}}
+	// Force utf8 dependency for tables without length limited columns
+	_ = utf8.RuneCountInString
+	{{- end}}
 )
 {{end -}}
@@ -1,17 +1,21 @@
 {{- if not .NoHooks -}}
 {{- $alias := .Aliases.Table .Table.Name}}
 
+{{if not .Table.IsView -}}
 var {{$alias.DownSingular}}BeforeInsertHooks []{{$alias.UpSingular}}Hook
 var {{$alias.DownSingular}}BeforeUpdateHooks []{{$alias.UpSingular}}Hook
 var {{$alias.DownSingular}}BeforeDeleteHooks []{{$alias.UpSingular}}Hook
 var {{$alias.DownSingular}}BeforeUpsertHooks []{{$alias.UpSingular}}Hook
 
 var {{$alias.DownSingular}}AfterInsertHooks []{{$alias.UpSingular}}Hook
+{{end -}}
 var {{$alias.DownSingular}}AfterSelectHooks []{{$alias.UpSingular}}Hook
+{{- if not .Table.IsView}}
 var {{$alias.DownSingular}}AfterUpdateHooks []{{$alias.UpSingular}}Hook
 var {{$alias.DownSingular}}AfterDeleteHooks []{{$alias.UpSingular}}Hook
 var {{$alias.DownSingular}}AfterUpsertHooks []{{$alias.UpSingular}}Hook
-
+{{- end}}
+{{if not .Table.IsView}}
 // doBeforeInsertHooks executes all "before insert" hooks.
 func (o *{{$alias.UpSingular}}) doBeforeInsertHooks({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (err error) {
 	{{if not .NoContext -}}
@@ -96,7 +100,7 @@ func (o *{{$alias.UpSingular}}) doAfterInsertHooks({{if .NoContext}}exec boil.Ex
 
 	return nil
 }
-
+{{end}}
 // doAfterSelectHooks executes all "after Select" hooks.
 func (o *{{$alias.UpSingular}}) doAfterSelectHooks({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (err error) {
 	{{if not .NoContext -}}
@@ -113,7 +117,7 @@ func (o *{{$alias.UpSingular}}) doAfterSelectHooks({{if .NoContext}}exec boil.Ex
 
 	return nil
 }
-
+{{if not .Table.IsView}}
 // doAfterUpdateHooks executes all "after Update" hooks.
 func (o *{{$alias.UpSingular}}) doAfterUpdateHooks({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (err error) {
 	{{if not .NoContext -}}
@@ -164,10 +168,11 @@ func (o *{{$alias.UpSingular}}) doAfterUpsertHooks({{if .NoContext}}exec boil.Ex
 
 	return nil
 }
-
+{{end}}
 // Add{{$alias.UpSingular}}Hook registers your hook function for all future operations.
 func Add{{$alias.UpSingular}}Hook(hookPoint boil.HookPoint, {{$alias.DownSingular}}Hook {{$alias.UpSingular}}Hook) {
 	switch hookPoint {
+		{{- if not $.Table.IsView}}
 		case boil.BeforeInsertHook:
 			{{$alias.DownSingular}}BeforeInsertHooks = append({{$alias.DownSingular}}BeforeInsertHooks, {{$alias.DownSingular}}Hook)
 		case boil.BeforeUpdateHook:
@@ -178,14 +183,17 @@ func Add{{$alias.UpSingular}}Hook(hookPoint boil.HookPoint, {{$alias.DownSingula
 			{{$alias.DownSingular}}BeforeUpsertHooks = append({{$alias.DownSingular}}BeforeUpsertHooks, {{$alias.DownSingular}}Hook)
 		case boil.AfterInsertHook:
 			{{$alias.DownSingular}}AfterInsertHooks = append({{$alias.DownSingular}}AfterInsertHooks, {{$alias.DownSingular}}Hook)
+		{{- end}}
 		case boil.AfterSelectHook:
 			{{$alias.DownSingular}}AfterSelectHooks = append({{$alias.DownSingular}}AfterSelectHooks, {{$alias.DownSingular}}Hook)
+		{{- if not $.Table.IsView}}
 		case boil.AfterUpdateHook:
 			{{$alias.DownSingular}}AfterUpdateHooks = append({{$alias.DownSingular}}AfterUpdateHooks, {{$alias.DownSingular}}Hook)
 		case boil.AfterDeleteHook:
 			{{$alias.DownSingular}}AfterDeleteHooks = append({{$alias.DownSingular}}AfterDeleteHooks, {{$alias.DownSingular}}Hook)
 		case boil.AfterUpsertHook:
 			{{$alias.DownSingular}}AfterUpsertHooks = append({{$alias.DownSingular}}AfterUpsertHooks, {{$alias.DownSingular}}Hook)
+		{{- end}}
 	}
 }
 {{- end}}
@@ -113,6 +113,66 @@ func (q {{$alias.DownSingular}}Query) All({{if .NoContext}}exec boil.Executor{{e
 	return o, nil
 }
 
+// {{$alias.UpSingular}}Iterator binds the {{$alias.UpSingular}} records of a query one at a time, see Iterate.
+type {{$alias.UpSingular}}Iterator struct {
+	*queries.Iterator
+	{{if not .NoHooks -}}
+	{{if not .NoContext}}
+	ctx  context.Context
+	exec boil.ContextExecutor
+	{{- else}}
+	exec boil.Executor
+	{{- end}}
+	err  error
+	{{- end}}
+}
+
+{{if not .NoHooks -}}
+// Next binds the next {{$alias.UpSingular}} record, it returns false when there are no more
+// records or an error happened, check Err to tell them apart.
+func (it *{{$alias.UpSingular}}Iterator) Next() bool {
+	if it.err != nil || !it.Iterator.Next() {
+		return false
+	}
+
+	if err := it.Value().doAfterSelectHooks({{if not .NoContext}}it.ctx, {{end -}} it.exec); err != nil {
+		it.err = err
+		_ = it.Iterator.Close()
+		return false
+	}
+
+	return true
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *{{$alias.UpSingular}}Iterator) Err() error {
+	if it.err != nil {
+		return it.err
+	}
+	return it.Iterator.Err()
+}
+
+{{end -}}
+
+// Value returns the {{$alias.UpSingular}} bound by the last call to Next
+func (it *{{$alias.UpSingular}}Iterator) Value() *{{$alias.UpSingular}} {
+	o, _ := it.Iterator.Value().(*{{$alias.UpSingular}})
+	return o
+}
+
+// Iterate executes the query and returns an iterator over the {{$alias.UpSingular}} records,
+// which binds them one at a time instead of loading them all like All does.
+// The iterator closes the rows when Next returns false, Close it if you stop
+// before that.
+func (q {{$alias.DownSingular}}Query) Iterate({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (*{{$alias.UpSingular}}Iterator, error) {
+	it, err := q.Query.Iterate({{if .NoContext}}nil{{else}}ctx{{end}}, exec, &{{$alias.UpSingular}}{})
+	if err != nil {
+		return nil, errors.Wrap(err, "{{.PkgName}}: failed to iterate {{.Table.Name}} rows")
+	}
+
+	return &{{$alias.UpSingular}}Iterator{Iterator: it{{if not .NoHooks}}, {{if not .NoContext}}ctx: ctx, {{end}}exec: exec{{end}}}, nil
+}
+
 {{if .AddGlobal -}}
 // CountG returns the count of all {{$alias.UpSingular}} records in the query, and panics on error.
 func (q {{$alias.DownSingular}}Query) CountG({{if not .NoContext}}ctx context.Context{{end}}) (int64, error) {
@@ -202,20 +262,19 @@ func (q {{$alias.DownSingular}}Query) ExistsP({{if .NoContext}}exec boil.Executo
 
 // Exists checks if the row exists in the table.
 func (q {{$alias.DownSingular}}Query) Exists({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) (bool, error) {
-	var count int64
+	var exists bool
 
 	queries.SetSelect(q.Query, nil)
-	queries.SetCount(q.Query)
-	queries.SetLimit(q.Query, 1)
+	queries.SetExists(q.Query)
 
 	{{if .NoContext -}}
-	err := q.Query.QueryRow(exec).Scan(&count)
+	err := q.Query.QueryRow(exec).Scan(&exists)
 	{{else -}}
-	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&exists)
 	{{end -}}
 	if err != nil {
 		return false, errors.Wrap(err, "{{.PkgName}}: failed to check if {{.Table.Name}} exists")
 	}
 
-	return count > 0, nil
+	return exists, nil
 }
@@ -4,13 +4,13 @@
 		{{- $ltable := $.Aliases.Table $rel.Table -}}
 		{{- $ftable := $.Aliases.Table $rel.ForeignTable -}}
 		{{- $relAlias := $ftable.Relationship $rel.Name -}}
-		{{- $canSoftDelete := (getTable $.Tables $rel.ForeignTable).CanSoftDelete }}
+		{{- $canSoftDelete := ((getTable $.Tables $rel.ForeignTable).CanSoftDeleteColumn $.AutoColumns.Deleted) }}
 // {{$relAlias.Local}} pointed to by the foreign key.
 func (o *{{$ltable.UpSingular}}) {{$relAlias.Local}}(mods ...qm.QueryMod) ({{$ftable.DownSingular}}Query) {
 	queryMods := []qm.QueryMod{
 		qm.Where("{{$rel.ForeignColumn | $.Quotes}} = ?", o.{{$ltable.Column $rel.Column}}),
         {{if and $.AddSoftDeletes $canSoftDelete -}}
-        qmhelper.WhereIsNull("deleted_at"),
+        qmhelper.WhereNotDeleted("{{$.AutoColumns.Deleted}}"),
         {{- end}}
 	}
 
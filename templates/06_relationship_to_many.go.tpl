@@ -5,7 +5,7 @@
 		{{- $ftable := $.Aliases.Table $rel.ForeignTable -}}
 		{{- $relAlias := $.Aliases.ManyRelationship $rel.ForeignTable $rel.Name $rel.JoinTable $rel.JoinLocalFKeyName -}}
 		{{- $schemaForeignTable := .ForeignTable | $.SchemaTable -}}
-		{{- $canSoftDelete := (getTable $.Tables .ForeignTable).CanSoftDelete }}
+		{{- $canSoftDelete := ((getTable $.Tables .ForeignTable).CanSoftDeleteColumn $.AutoColumns.Deleted) }}
 // {{$relAlias.Local}} retrieves all the {{.ForeignTable | singular}}'s {{$ftable.UpPlural}} with an executor
 {{- if not (eq $relAlias.Local $ftable.UpPlural)}} via {{$rel.ForeignColumn}} column{{- end}}.
 func (o *{{$ltable.UpSingular}}) {{$relAlias.Local}}(mods ...qm.QueryMod) {{$ftable.DownSingular}}Query {
@@ -24,7 +24,7 @@ func (o *{{$ltable.UpSingular}}) {{$relAlias.Local}}(mods ...qm.QueryMod) {{$fta
 	queryMods = append(queryMods,
 		qm.Where("{{$schemaForeignTable}}.{{$rel.ForeignColumn | $.Quotes}}=?", o.{{$ltable.Column $rel.Column}}),
 		{{if and $.AddSoftDeletes $canSoftDelete -}}
-		qmhelper.WhereIsNull("{{$schemaForeignTable}}.{{"deleted_at" | $.Quotes}}"),
+		qmhelper.WhereNotDeleted("{{$schemaForeignTable}}.{{$.AutoColumns.Deleted | $.Quotes}}"),
 		{{- end}}
 	)
 		{{end}}
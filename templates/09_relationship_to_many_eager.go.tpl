@@ -9,7 +9,7 @@
 		{{- $usesPrimitives := usesPrimitives $.Tables $rel.Table $rel.Column $rel.ForeignTable $rel.ForeignColumn -}}
 		{{- $arg := printf "maybe%s" $ltable.UpSingular -}}
 		{{- $schemaForeignTable := $rel.ForeignTable | $.SchemaTable -}}
-		{{- $canSoftDelete := (getTable $.Tables $rel.ForeignTable).CanSoftDelete }}
+		{{- $canSoftDelete := ((getTable $.Tables $rel.ForeignTable).CanSoftDeleteColumn $.AutoColumns.Deleted) }}
 // Load{{$relAlias.Local}} allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func ({{$ltable.DownSingular}}L) Load{{$relAlias.Local}}({{if $.NoContext}}e boil.Executor{{else}}ctx context.Context, e boil.ContextExecutor{{end}}, singular bool, {{$arg}} interface{}, mods queries.Applicator) error {
@@ -57,26 +57,35 @@ func ({{$ltable.DownSingular}}L) Load{{$relAlias.Local}}({{if $.NoContext}}e boi
 			{{- $schemaJoinTable := .JoinTable | $.SchemaTable -}}
 			{{- $foreignTable := getTable $.Tables .ForeignTable -}}
 	query := NewQuery(
-		qm.Select("{{$foreignTable.Columns | columnNames | prefixStringSlice (print $schemaForeignTable ".") | join ", "}}, {{id 0 | $.Quotes}}.{{.JoinLocalColumn | $.Quotes}}"),
 		qm.From("{{$schemaForeignTable}}"),
 		qm.InnerJoin("{{$schemaJoinTable}} as {{id 0 | $.Quotes}} on {{$schemaForeignTable}}.{{.ForeignColumn | $.Quotes}} = {{id 0 | $.Quotes}}.{{.JoinForeignColumn | $.Quotes}}"),
 		qm.WhereIn("{{id 0 | $.Quotes}}.{{.JoinLocalColumn | $.Quotes}} in ?", args...),
 		{{if and $.AddSoftDeletes $canSoftDelete -}}
-		qmhelper.WhereIsNull("{{$schemaForeignTable}}.{{"deleted_at" | $.Quotes}}"),
+		qmhelper.WhereNotDeleted("{{$schemaForeignTable}}.{{$.AutoColumns.Deleted | $.Quotes}}"),
 		{{- end}}
 	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+	if len(queries.GetSelect(query)) == 0 {
+		queries.SetSelect(query, []string{"{{$foreignTable.Columns | columnNames | prefixStringSlice (print $schemaForeignTable ".") | join ", "}}"})
+	}
+	// The join column is always last so the loaded objects can be matched up
+	queries.AppendSelect(query, "{{id 0 | $.Quotes}}.{{.JoinLocalColumn | $.Quotes}}")
 		{{else -}}
 	query := NewQuery(
 	    qm.From(`{{if $.Dialect.UseSchema}}{{$.Schema}}.{{end}}{{.ForeignTable}}`),
 	    qm.WhereIn(`{{if $.Dialect.UseSchema}}{{$.Schema}}.{{end}}{{.ForeignTable}}.{{.ForeignColumn}} in ?`, args...),
 	    {{if and $.AddSoftDeletes $canSoftDelete -}}
-	    qmhelper.WhereIsNull(`{{if $.Dialect.UseSchema}}{{$.Schema}}.{{end}}{{.ForeignTable}}.deleted_at`),
+	    qmhelper.WhereNotDeleted(`{{if $.Dialect.UseSchema}}{{$.Schema}}.{{end}}{{.ForeignTable}}.{{$.AutoColumns.Deleted}}`),
 	    {{- end}}
     )
-		{{end -}}
 	if mods != nil {
 		mods.Apply(query)
+		// A load selecting its own columns still needs the one it's matched on
+		queries.EnsureSelect(query, `{{if $.Dialect.UseSchema}}{{$.Schema}}.{{end}}{{.ForeignTable}}.{{.ForeignColumn}}`)
 	}
+		{{end}}
 
 	{{if $.NoContext -}}
 	results, err := query.Query(e)
@@ -89,15 +98,30 @@ func ({{$ltable.DownSingular}}L) Load{{$relAlias.Local}}({{if $.NoContext}}e boi
 
 	var resultSlice []*{{$ftable.UpSingular}}
 	{{if .ToJoinTable -}}
-	{{- $foreignTable := getTable $.Tables .ForeignTable -}}
 	{{- $joinTable := getTable $.Tables .JoinTable -}}
 	{{- $localCol := $joinTable.GetColumn .JoinLocalColumn}}
+	cols, err := results.Columns()
+	if err != nil {
+		return errors.Wrap(err, "failed to get columns of eager loaded {{.ForeignTable}}")
+	}
+	// The join makes columns selected with their table come back as
+	// "table.column", only the column is needed to bind them
+	cols = cols[:len(cols)-1]
+	for i, c := range cols {
+		cols[i] = c[strings.LastIndexByte(c, '.')+1:]
+	}
+	mapping, err := queries.BindMapping({{$ftable.DownSingular}}Type, {{$ftable.DownSingular}}Mapping, cols)
+	if err != nil {
+		return err
+	}
+
 	var localJoinCols []{{$localCol.Type}}
 	for results.Next() {
 		one := new({{$ftable.UpSingular}})
 		var localJoinCol {{$localCol.Type}}
 
-		err = results.Scan({{$foreignTable.Columns | columnNames | stringMap (aliasCols $ftable) | prefixStringSlice "&one." | join ", "}}, &localJoinCol)
+		ptrs := queries.PtrsFromMapping(reflect.Indirect(reflect.ValueOf(one)), mapping)
+		err = results.Scan(append(ptrs, &localJoinCol)...)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan eager loaded results for {{.ForeignTable}}")
 		}
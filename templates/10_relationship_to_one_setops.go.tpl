@@ -122,7 +122,6 @@ func (o *{{$ltable.UpSingular}}) Set{{$rel.Foreign}}({{if $.NoContext}}exec boil
 	return nil
 }
 
-		{{- if .Nullable}}
 {{if $.AddGlobal -}}
 // Remove{{$rel.Foreign}}G relationship.
 // Sets o.R.{{$rel.Foreign}} to nil.
@@ -160,13 +159,18 @@ func (o *{{$ltable.UpSingular}}) Remove{{$rel.Foreign}}GP({{if not $.NoContext}}
 
 {{end -}}
 
+{{if .Nullable -}}
 // Remove{{$rel.Foreign}} relationship.
 // Sets o.R.{{$rel.Foreign}} to nil.
 // Removes o from all passed in related items' relationships struct (Optional).
 func (o *{{$ltable.UpSingular}}) Remove{{$rel.Foreign}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, related *{{$ftable.UpSingular}}) error {
 	var err error
 
+	{{if isPointer ((getTable $.Tables $fkey.Table).GetColumn $fkey.Column).Type -}}
+	o.{{$col}} = nil
+	{{else -}}
 	queries.SetScanner(&o.{{$col}}, nil)
+	{{end -}}
 	{{if $.NoContext -}}
 	if {{if not $.NoRowsAffected}}_, {{end -}} err = o.Update(exec, boil.Whitelist("{{.Column}}")); err != nil {
 	{{else -}}
@@ -205,6 +209,13 @@ func (o *{{$ltable.UpSingular}}) Remove{{$rel.Foreign}}({{if $.NoContext}}exec b
 
 	return nil
 }
+{{else -}}
+// Remove{{$rel.Foreign}} always returns an error, {{.Table}}.{{.Column}} can't be
+// null so the {{$ltable.DownSingular}} can't be left without its {{$rel.Foreign}}.
+// Set another one with Set{{$rel.Foreign}} or delete the {{$ltable.DownSingular}} instead.
+func (o *{{$ltable.UpSingular}}) Remove{{$rel.Foreign}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, related *{{$ftable.UpSingular}}) error {
+	return errors.New("{{$.PkgName}}: cannot remove the {{$rel.Foreign}} of a {{$ltable.DownSingular}}, {{.Table}}.{{.Column}} is not nullable")
+}
 {{end -}}{{/* if foreignkey nullable */}}
 {{- end -}}{{/* range */}}
 {{- end -}}{{/* join table */}}
@@ -117,7 +117,6 @@ func (o *{{$ltable.UpSingular}}) Set{{$relAlias.Local}}({{if $.NoContext}}exec b
 	return nil
 }
 
-		{{- if .ForeignColumnNullable}}
 {{if $.AddGlobal -}}
 // Remove{{$relAlias.Local}}G relationship.
 // Sets o.R.{{$relAlias.Local}} to nil.
@@ -155,13 +154,18 @@ func (o *{{$ltable.UpSingular}}) Remove{{$relAlias.Local}}GP({{if not $.NoContex
 
 {{end -}}
 
+{{if .ForeignColumnNullable -}}
 // Remove{{$relAlias.Local}} relationship.
 // Sets o.R.{{$relAlias.Local}} to nil.
 // Removes o from all passed in related items' relationships struct (Optional).
 func (o *{{$ltable.UpSingular}}) Remove{{$relAlias.Local}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, related *{{$ftable.UpSingular}}) error {
 	var err error
 
+	{{if isPointer ((getTable $.Tables $rel.ForeignTable).GetColumn $rel.ForeignColumn).Type -}}
+	related.{{$fcol}} = nil
+	{{else -}}
 	queries.SetScanner(&related.{{$fcol}}, nil)
+	{{end -}}
 	if {{if not $.NoRowsAffected}}_, {{end -}} err = related.Update({{if not $.NoContext}}ctx, {{end -}} exec, boil.Whitelist("{{.ForeignColumn}}")); err != nil {
 		return errors.Wrap(err, "failed to update local table")
 	}
@@ -176,6 +180,13 @@ func (o *{{$ltable.UpSingular}}) Remove{{$relAlias.Local}}({{if $.NoContext}}exe
 	related.R.{{$relAlias.Foreign}} = nil
 	return nil
 }
+{{else -}}
+// Remove{{$relAlias.Local}} always returns an error, {{.ForeignTable}}.{{.ForeignColumn}} can't be
+// null so the {{$ftable.DownSingular}} can't be left without its {{$relAlias.Foreign}}.
+// Give it another one with its Set{{$relAlias.Foreign}} or delete the {{$ftable.DownSingular}} instead.
+func (o *{{$ltable.UpSingular}}) Remove{{$relAlias.Local}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, related *{{$ftable.UpSingular}}) error {
+	return errors.New("{{$.PkgName}}: cannot remove the {{$relAlias.Local}} of a {{$ltable.DownSingular}}, {{.ForeignTable}}.{{.ForeignColumn}} is not nullable")
+}
 {{end -}}{{/* if foreignkey nullable */}}
 {{- end -}}{{/* range */}}
 {{- end -}}{{/* join table */}}
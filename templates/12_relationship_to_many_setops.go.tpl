@@ -259,7 +259,11 @@ func (o *{{$ltable.UpSingular}}) Set{{$relAlias.Local}}({{if $.NoContext}}exec b
 	{{else -}}
 	if o.R != nil {
 		for _, rel := range o.R.{{$relAlias.Local}} {
+			{{if isPointer ((getTable $.Tables $rel.ForeignTable).GetColumn $rel.ForeignColumn).Type -}}
+			rel.{{$fcol}} = nil
+			{{else -}}
 			queries.SetScanner(&rel.{{$fcol}}, nil)
+			{{end -}}
 			if rel.R == nil {
 				continue
 			}
@@ -273,6 +277,7 @@ func (o *{{$ltable.UpSingular}}) Set{{$relAlias.Local}}({{if $.NoContext}}exec b
 
 	return o.Add{{$relAlias.Local}}({{if not $.NoContext}}ctx, {{end -}} exec, insert, related...)
 }
+			{{- end -}}{{- /* if nullable foreign key */}}
 
 {{if $.AddGlobal -}}
 // Remove{{$relAlias.Local}}G relationships from objects passed in.
@@ -311,6 +316,7 @@ func (o *{{$ltable.UpSingular}}) Remove{{$relAlias.Local}}GP({{if not $.NoContex
 
 {{end -}}
 
+{{if (or .ForeignColumnNullable .ToJoinTable) -}}
 // Remove{{$relAlias.Local}} relationships from objects passed in.
 // Removes related items from R.{{$relAlias.Local}} (uses pointer comparison, removal does not keep order)
 // Sets related.R.{{$relAlias.Foreign}}.
@@ -349,7 +355,11 @@ func (o *{{$ltable.UpSingular}}) Remove{{$relAlias.Local}}({{if $.NoContext}}exe
 	}
 	{{else -}}
 	for _, rel := range related {
+		{{if isPointer ((getTable $.Tables $rel.ForeignTable).GetColumn $rel.ForeignColumn).Type -}}
+		rel.{{$fcol}} = nil
+		{{else -}}
 		queries.SetScanner(&rel.{{$fcol}}, nil)
+		{{end -}}
 		{{if not .ToJoinTable -}}
 		if rel.R != nil {
 			rel.R.{{$relAlias.Foreign}} = nil
@@ -385,7 +395,14 @@ func (o *{{$ltable.UpSingular}}) Remove{{$relAlias.Local}}({{if $.NoContext}}exe
 
 	return nil
 }
-
+{{else -}}
+// Remove{{$relAlias.Local}} always returns an error, {{.ForeignTable}}.{{.ForeignColumn}} can't be
+// null so the {{$ftable.DownPlural}} can't be left without their {{$relAlias.Foreign}}.
+// Add them to another {{$ltable.DownSingular}} with Add{{$relAlias.Local}} or delete them instead.
+func (o *{{$ltable.UpSingular}}) Remove{{$relAlias.Local}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, related ...*{{$ftable.UpSingular}}) error {
+	return errors.New("{{$.PkgName}}: cannot remove {{$relAlias.Local}} of a {{$ltable.DownSingular}}, {{.ForeignTable}}.{{.ForeignColumn}} is not nullable")
+}
+{{end}}
 				{{if .ToJoinTable -}}
 func remove{{$relAlias.Local}}From{{$relAlias.Foreign}}Slice(o *{{$ltable.UpSingular}}, related []*{{$ftable.UpSingular}}) {
 	for _, rel := range related {
@@ -411,6 +428,5 @@ func remove{{$relAlias.Local}}From{{$relAlias.Foreign}}Slice(o *{{$ltable.UpSing
 	}
 }
 				{{end -}}{{- /* if ToJoinTable */ -}}
-			{{- end -}}{{- /* if nullable foreign key */ -}}
 	{{- end -}}{{- /* range relationships */ -}}
 {{- end -}}{{- /* if IsJoinTable */ -}}
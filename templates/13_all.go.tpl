@@ -1,10 +1,10 @@
 {{- $alias := .Aliases.Table .Table.Name}}
 {{- $schemaTable := .Table.Name | .SchemaTable}}
-{{- $canSoftDelete := .Table.CanSoftDelete }}
+{{- $canSoftDelete := .Table.CanSoftDeleteColumn .AutoColumns.Deleted }}
 // {{$alias.UpPlural}} retrieves all the records using an executor.
 func {{$alias.UpPlural}}(mods ...qm.QueryMod) {{$alias.DownSingular}}Query {
     {{if and .AddSoftDeletes $canSoftDelete -}}
-    mods = append(mods, qm.From("{{$schemaTable}}"), qmhelper.WhereIsNull("{{$schemaTable}}.{{"deleted_at" | $.Quotes}}"))
+    mods = append(mods, qm.From("{{$schemaTable}}"), qmhelper.WhereNotDeleted("{{$schemaTable}}.{{$.AutoColumns.Deleted | $.Quotes}}"))
     {{else -}}
 	mods = append(mods, qm.From("{{$schemaTable}}"))
 	{{end -}}
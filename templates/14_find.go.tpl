@@ -1,8 +1,9 @@
+{{- if not .Table.IsView -}}
 {{- $alias := .Aliases.Table .Table.Name -}}
 {{- $colDefs := sqlColDefinitions .Table.Columns .Table.PKey.Columns -}}
 {{- $pkNames := $colDefs.Names | stringMap (aliasCols $alias) | stringMap .StringFuncs.camelCase | stringMap .StringFuncs.replaceReserved -}}
 {{- $pkArgs := joinSlices " " $pkNames $colDefs.Types | join ", " -}}
-{{- $canSoftDelete := .Table.CanSoftDelete }}
+{{- $canSoftDelete := .Table.CanSoftDeleteColumn .AutoColumns.Deleted }}
 {{if .AddGlobal -}}
 // Find{{$alias.UpSingular}}G retrieves a single record by ID.
 func Find{{$alias.UpSingular}}G({{if not .NoContext}}ctx context.Context, {{end -}} {{$pkArgs}}, selectCols ...string) (*{{$alias.UpSingular}}, error) {
@@ -42,13 +43,24 @@ func Find{{$alias.UpSingular}}GP({{if not .NoContext}}ctx context.Context, {{end
 func Find{{$alias.UpSingular}}({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, {{$pkArgs}}, selectCols ...string) (*{{$alias.UpSingular}}, error) {
 	{{$alias.DownSingular}}Obj := &{{$alias.UpSingular}}{}
 
-	sel := "*"
-	if len(selectCols) > 0 {
-		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	key := strings.Join(selectCols, ",")
+	{{$alias.DownSingular}}FindCacheMut.RLock()
+	query, cached := {{$alias.DownSingular}}FindCache[key]
+	{{$alias.DownSingular}}FindCacheMut.RUnlock()
+
+	if !cached {
+		sel := "*"
+		if len(selectCols) > 0 {
+			sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+		}
+		query = fmt.Sprintf(
+			"select %s from {{.Table.Name | .SchemaTable}} where {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}{{if and .AddSoftDeletes $canSoftDelete}} and {{$.AutoColumns.Deleted | $.Quotes}} is null{{end}}", sel,
+		)
+
+		{{$alias.DownSingular}}FindCacheMut.Lock()
+		{{$alias.DownSingular}}FindCache[key] = query
+		{{$alias.DownSingular}}FindCacheMut.Unlock()
 	}
-	query := fmt.Sprintf(
-		"select %s from {{.Table.Name | .SchemaTable}} where {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}{{if and .AddSoftDeletes $canSoftDelete}} and {{"deleted_at" | $.Quotes}} is null{{end}}", sel,
-	)
 
 	q := queries.Raw(query, {{$pkNames | join ", "}})
 
@@ -62,3 +74,83 @@ func Find{{$alias.UpSingular}}({{if .NoContext}}exec boil.Executor{{else}}ctx co
 
 	return {{$alias.DownSingular}}Obj, nil
 }
+
+{{- /* Only columns unique on their own are marked unique, composite unique keys get no finder */ -}}
+{{- range $col := .Table.Columns -}}
+{{- if and $col.Unique (not (and (eq (len $.Table.PKey.Columns) 1) (eq (index $.Table.PKey.Columns 0) $col.Name))) -}}
+{{- $colAlias := $alias.Column $col.Name -}}
+{{- $argName := call $.StringFuncs.replaceReserved (camelCase $colAlias)}}
+
+{{if $.AddGlobal -}}
+// Find{{$alias.UpSingular}}By{{$colAlias}}G retrieves a single record by its unique {{$col.Name}} column.
+func Find{{$alias.UpSingular}}By{{$colAlias}}G({{if not $.NoContext}}ctx context.Context, {{end -}} {{$argName}} {{$col.Type}}, selectCols ...string) (*{{$alias.UpSingular}}, error) {
+	return Find{{$alias.UpSingular}}By{{$colAlias}}({{if $.NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, {{$argName}}, selectCols...)
+}
+
+{{end -}}
+
+{{if $.AddPanic -}}
+// Find{{$alias.UpSingular}}By{{$colAlias}}P retrieves a single record by its unique {{$col.Name}} column with an executor, and panics on error.
+func Find{{$alias.UpSingular}}By{{$colAlias}}P({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, {{$argName}} {{$col.Type}}, selectCols ...string) *{{$alias.UpSingular}} {
+	retobj, err := Find{{$alias.UpSingular}}By{{$colAlias}}({{if not $.NoContext}}ctx, {{end -}} exec, {{$argName}}, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+{{end -}}
+
+{{if and $.AddGlobal $.AddPanic -}}
+// Find{{$alias.UpSingular}}By{{$colAlias}}GP retrieves a single record by its unique {{$col.Name}} column, and panics on error.
+func Find{{$alias.UpSingular}}By{{$colAlias}}GP({{if not $.NoContext}}ctx context.Context, {{end -}} {{$argName}} {{$col.Type}}, selectCols ...string) *{{$alias.UpSingular}} {
+	retobj, err := Find{{$alias.UpSingular}}By{{$colAlias}}({{if $.NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}, {{$argName}}, selectCols...)
+	if err != nil {
+		panic(boil.WrapErr(err))
+	}
+
+	return retobj
+}
+
+{{end -}}
+
+// Find{{$alias.UpSingular}}By{{$colAlias}} retrieves a single record by its unique {{$col.Name}} column with an executor.
+// If selectCols is empty all columns will be returned.
+func Find{{$alias.UpSingular}}By{{$colAlias}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, {{$argName}} {{$col.Type}}, selectCols ...string) (*{{$alias.UpSingular}}, error) {
+	{{$alias.DownSingular}}Obj := &{{$alias.UpSingular}}{}
+
+	key := "{{$col.Name}}:" + strings.Join(selectCols, ",")
+	{{$alias.DownSingular}}FindCacheMut.RLock()
+	query, cached := {{$alias.DownSingular}}FindCache[key]
+	{{$alias.DownSingular}}FindCacheMut.RUnlock()
+
+	if !cached {
+		sel := "*"
+		if len(selectCols) > 0 {
+			sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+		}
+		query = fmt.Sprintf(
+			"select %s from {{$.Table.Name | $.SchemaTable}} where {{$col.Name | $.Quotes}}={{if $.Dialect.UseIndexPlaceholders}}$1{{else}}?{{end}}{{if and $.AddSoftDeletes $canSoftDelete}} and {{$.AutoColumns.Deleted | $.Quotes}} is null{{end}}", sel,
+		)
+
+		{{$alias.DownSingular}}FindCacheMut.Lock()
+		{{$alias.DownSingular}}FindCache[key] = query
+		{{$alias.DownSingular}}FindCacheMut.Unlock()
+	}
+
+	q := queries.Raw(query, {{$argName}})
+
+	err := q.Bind({{if not $.NoContext}}ctx{{else}}nil{{end}}, exec, {{$alias.DownSingular}}Obj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "{{$.PkgName}}: unable to select from {{$.Table.Name}}")
+	}
+
+	return {{$alias.DownSingular}}Obj, nil
+}
+{{- end -}}
+{{- end -}}
+{{end -}}{{/* if not IsView */}}
@@ -1,3 +1,4 @@
+{{- if not .Table.IsView -}}
 {{- $alias := .Aliases.Table .Table.Name}}
 {{- $schemaTable := .Table.Name | .SchemaTable}}
 {{if .AddGlobal -}}
@@ -217,3 +218,4 @@ CacheNoHooks:
 	return nil
 	{{- end}}
 }
+{{end -}}{{/* if not IsView */}}
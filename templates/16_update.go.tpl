@@ -1,5 +1,7 @@
+{{- if not .Table.IsView -}}
 {{- $alias := .Aliases.Table .Table.Name -}}
-{{- $schemaTable := .Table.Name | .SchemaTable}}
+{{- $schemaTable := .Table.Name | .SchemaTable -}}
+{{- $lockCol := index .OptimisticLock .Table.Name}}
 {{if .AddGlobal -}}
 // UpdateG a single {{$alias.UpSingular}} record using the global executor.
 // See Update for more documentation.
@@ -44,6 +46,10 @@ func (o *{{$alias.UpSingular}}) UpdateGP({{if not .NoContext}}ctx context.Contex
 // Update uses an executor to update the {{$alias.UpSingular}}.
 // See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
 // Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+{{- if $lockCol}}
+// Update increments {{$lockCol}} and only succeeds if the row still has the version
+// the object was loaded with, otherwise ErrOptimisticLock is returned.
+{{- end}}
 func (o *{{$alias.UpSingular}}) Update({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, columns boil.Columns) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
 	{{- template "timestamp_update_helper" . -}}
 
@@ -69,16 +75,22 @@ func (o *{{$alias.UpSingular}}) Update({{if .NoContext}}exec boil.Executor{{else
 		{{end}}
 		{{if not .NoAutoTimestamps}}
 		if !columns.IsWhitelist() {
-			wl = strmangle.SetComplement(wl, []string{"created_at"})
+			wl = strmangle.SetComplement(wl, []string{"{{.AutoColumns.Created}}"})
 		}
 		{{end -}}
+		{{if $lockCol -}}
+		wl = append(strmangle.SetComplement(wl, []string{"{{$lockCol}}"}), "{{$lockCol}}")
+		{{end -}}
 		if len(wl) == 0 {
 			return {{if not .NoRowsAffected}}0, {{end -}} errors.New("{{.PkgName}}: unable to update {{.Table.Name}}, could not build whitelist")
 		}
 
-		cache.query = fmt.Sprintf("UPDATE {{$schemaTable}} SET %s WHERE %s",
+		cache.query = fmt.Sprintf("UPDATE {{$schemaTable}} SET %s WHERE %s{{if $lockCol}} AND %s{{end}}",
 			strmangle.SetParamNames("{{.LQ}}", "{{.RQ}}", {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, wl),
 			strmangle.WhereClause("{{.LQ}}", "{{.RQ}}", {{if .Dialect.UseIndexPlaceholders}}len(wl)+1{{else}}0{{end}}, {{$alias.DownSingular}}PrimaryKeyColumns),
+			{{- if $lockCol}}
+			strmangle.WhereClause("{{.LQ}}", "{{.RQ}}", {{if .Dialect.UseIndexPlaceholders}}len(wl)+len({{$alias.DownSingular}}PrimaryKeyColumns)+1{{else}}0{{end}}, []string{"{{$lockCol}}"}),
+			{{- end}}
 		)
 		cache.valueMapping, err = queries.BindMapping({{$alias.DownSingular}}Type, {{$alias.DownSingular}}Mapping, append(wl, {{$alias.DownSingular}}PrimaryKeyColumns...))
 		if err != nil {
@@ -86,7 +98,15 @@ func (o *{{$alias.UpSingular}}) Update({{if .NoContext}}exec boil.Executor{{else
 		}
 	}
 
+	{{if $lockCol -}}
+	lockVersion := o.{{$alias.Column $lockCol}}
+	o.{{$alias.Column $lockCol}}++
+
+	{{end -}}
 	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+	{{- if $lockCol}}
+	values = append(values, lockVersion)
+	{{- end}}
 
 	{{if .NoContext -}}
 	if boil.DebugMode {
@@ -101,7 +121,7 @@ func (o *{{$alias.UpSingular}}) Update({{if .NoContext}}exec boil.Executor{{else
 	}
 	{{end -}}
 
-	{{if .NoRowsAffected -}}
+	{{if and .NoRowsAffected (not $lockCol) -}}
 		{{if .NoContext -}}
 	_, err = exec.Exec(cache.query, values...)
 		{{else -}}
@@ -116,13 +136,23 @@ func (o *{{$alias.UpSingular}}) Update({{if .NoContext}}exec boil.Executor{{else
 		{{end -}}
 	{{end -}}
 	if err != nil {
+		{{- if $lockCol}}
+		o.{{$alias.Column $lockCol}} = lockVersion
+		{{- end}}
 		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: unable to update {{.Table.Name}} row")
 	}
 
-	{{if not .NoRowsAffected -}}
+	{{if or (not .NoRowsAffected) $lockCol -}}
 	rowsAff, err := result.RowsAffected()
 	if err != nil {
-		return 0, errors.Wrap(err, "{{.PkgName}}: failed to get rows affected by update for {{.Table.Name}}")
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: failed to get rows affected by update for {{.Table.Name}}")
+	}
+
+	{{end -}}
+	{{if $lockCol -}}
+	if rowsAff == 0 {
+		o.{{$alias.Column $lockCol}} = lockVersion
+		return {{if not .NoRowsAffected}}0, {{end -}} ErrOptimisticLock
 	}
 
 	{{end -}}
@@ -305,3 +335,4 @@ func (o {{$alias.UpSingular}}Slice) UpdateAll({{if .NoContext}}exec boil.Executo
 
 	return {{if not .NoRowsAffected}}rowsAff, {{end -}} nil
 }
+{{end -}}{{/* if not IsView */}}
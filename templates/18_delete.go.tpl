@@ -1,6 +1,7 @@
+{{- if not .Table.IsView -}}
 {{- $alias := .Aliases.Table .Table.Name -}}
 {{- $schemaTable := .Table.Name | .SchemaTable -}}
-{{- $canSoftDelete := .Table.CanSoftDelete -}}
+{{- $canSoftDelete := .Table.CanSoftDeleteColumn .AutoColumns.Deleted -}}
 {{- $soft := and .AddSoftDeletes $canSoftDelete }}
 {{if .AddGlobal -}}
 // DeleteG deletes a single {{$alias.UpSingular}} record.
@@ -68,8 +69,13 @@ func (o *{{$alias.UpSingular}}) Delete({{if .NoContext}}exec boil.Executor{{else
 		sql = "DELETE FROM {{$schemaTable}} WHERE {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}"
 	} else {
 		currTime := time.Now().In(boil.GetLocation())
-		o.DeletedAt = null.TimeFrom(currTime)
-		wl := []string{"deleted_at"}
+		{{if isPointer (.Table.GetColumn .AutoColumns.Deleted).Type -}}
+		o.{{$alias.Column .AutoColumns.Deleted}} = new(time.Time)
+		*o.{{$alias.Column .AutoColumns.Deleted}} = currTime
+		{{else -}}
+		o.{{$alias.Column .AutoColumns.Deleted}} = null.TimeFrom(currTime)
+		{{end -}}
+		wl := []string{"{{.AutoColumns.Deleted}}"}
 		sql = fmt.Sprintf("UPDATE {{$schemaTable}} SET %s WHERE {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 2 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}",
 			strmangle.SetParamNames("{{.LQ}}", "{{.RQ}}", {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, wl),
 		)
@@ -132,7 +138,7 @@ func (o *{{$alias.UpSingular}}) Delete({{if .NoContext}}exec boil.Executor{{else
 }
 
 {{if .AddGlobal -}}
-func (q {{$alias.DownSingular}}Query) DeleteAllG({{if not .NoContext}}ctx context.Context{{end}}{{if $soft}}, hardDelete bool{{end}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+func (q {{$alias.DownSingular}}Query) DeleteAllG({{if not .NoContext}}ctx context.Context{{if $soft}}, hardDelete bool{{end}}{{else}}{{if $soft}}hardDelete bool{{end}}{{end}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
 	return q.DeleteAll({{if .NoContext}}boil.GetDB(){{else}}ctx, boil.GetContextDB(){{end}}{{if $soft}}, hardDelete{{end}})
 }
 
@@ -164,7 +170,7 @@ func (q {{$alias.DownSingular}}Query) DeleteAll({{if .NoContext}}exec boil.Execu
 		queries.SetDelete(q.Query)
 	} else {
 		currTime := time.Now().In(boil.GetLocation())
-		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+		queries.SetUpdate(q.Query, M{"{{.AutoColumns.Deleted}}": currTime})
 	}
 	{{else -}}
 	queries.SetDelete(q.Query)
@@ -269,9 +275,14 @@ func (o {{$alias.UpSingular}}Slice) DeleteAll({{if .NoContext}}exec boil.Executo
 		for _, obj := range o {
 			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), {{$alias.DownSingular}}PrimaryKeyMapping)
 			args = append(args, pkeyArgs...)
-			obj.DeletedAt = null.TimeFrom(currTime)
+			{{if isPointer ($.Table.GetColumn $.AutoColumns.Deleted).Type -}}
+			obj.{{$alias.Column $.AutoColumns.Deleted}} = new(time.Time)
+			*obj.{{$alias.Column $.AutoColumns.Deleted}} = currTime
+			{{else -}}
+			obj.{{$alias.Column $.AutoColumns.Deleted}} = null.TimeFrom(currTime)
+			{{end -}}
 		}
-		wl := []string{"deleted_at"}
+		wl := []string{"{{.AutoColumns.Deleted}}"}
 		sql = fmt.Sprintf("UPDATE {{$schemaTable}} SET %s WHERE " +
 			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), {{if .Dialect.UseIndexPlaceholders}}2{{else}}0{{end}}, {{$alias.DownSingular}}PrimaryKeyColumns, len(o)),
 			strmangle.SetParamNames("{{.LQ}}", "{{.RQ}}", {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, wl),
@@ -339,3 +350,71 @@ func (o {{$alias.UpSingular}}Slice) DeleteAll({{if .NoContext}}exec boil.Executo
 
 	return {{if not .NoRowsAffected}}rowsAff, {{end -}} nil
 }
+
+{{if $soft -}}
+// SoftDelete marks a single {{$alias.UpSingular}} record as deleted by setting
+// its {{.AutoColumns.Deleted}} column, it is the same as calling Delete with hardDelete false.
+func (o *{{$alias.UpSingular}}) SoftDelete({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	return o.Delete({{if not .NoContext}}ctx, {{end -}} exec, false)
+}
+
+// Restore undoes a soft delete of a single {{$alias.UpSingular}} record by clearing
+// its {{.AutoColumns.Deleted}} column.
+// Restore will match against the primary key column to find the record to restore.
+func (o *{{$alias.UpSingular}}) Restore({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) {{if .NoRowsAffected}}error{{else}}(int64, error){{end -}} {
+	if o == nil {
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.New("{{.PkgName}}: no {{$alias.UpSingular}} provided for restore")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), {{$alias.DownSingular}}PrimaryKeyMapping)
+	sql := "UPDATE {{$schemaTable}} SET {{.AutoColumns.Deleted | .Quotes}} = NULL WHERE {{if .Dialect.UseIndexPlaceholders}}{{whereClause .LQ .RQ 1 .Table.PKey.Columns}}{{else}}{{whereClause .LQ .RQ 0 .Table.PKey.Columns}}{{end}}"
+
+	{{if .NoContext -}}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	{{else -}}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	{{end -}}
+
+	{{if .NoRowsAffected -}}
+		{{if .NoContext -}}
+	_, err := exec.Exec(sql, args...)
+		{{else -}}
+	_, err := exec.ExecContext(ctx, sql, args...)
+		{{end -}}
+	{{else -}}
+		{{if .NoContext -}}
+	result, err := exec.Exec(sql, args...)
+		{{else -}}
+	result, err := exec.ExecContext(ctx, sql, args...)
+		{{end -}}
+	{{end -}}
+	if err != nil {
+		return {{if not .NoRowsAffected}}0, {{end -}} errors.Wrap(err, "{{.PkgName}}: unable to restore {{.Table.Name}}")
+	}
+
+	{{if isPointer (.Table.GetColumn .AutoColumns.Deleted).Type -}}
+	o.{{$alias.Column .AutoColumns.Deleted}} = nil
+	{{else -}}
+	o.{{$alias.Column .AutoColumns.Deleted}} = null.Time{}
+	{{end -}}
+
+	{{if not .NoRowsAffected -}}
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "{{.PkgName}}: failed to get rows affected by restore for {{.Table.Name}}")
+	}
+
+	return rowsAff, nil
+	{{- else -}}
+	return nil
+	{{- end}}
+}
+{{end -}}
+{{end -}}{{/* if not IsView */}}
@@ -1,6 +1,7 @@
+{{- if not .Table.IsView -}}
 {{- $alias := .Aliases.Table .Table.Name -}}
 {{- $schemaTable := .Table.Name | .SchemaTable -}}
-{{- $canSoftDelete := .Table.CanSoftDelete }}
+{{- $canSoftDelete := .Table.CanSoftDeleteColumn .AutoColumns.Deleted }}
 {{if .AddGlobal -}}
 // ReloadG refetches the object from the database using the primary keys.
 func (o *{{$alias.UpSingular}}) ReloadG({{if not .NoContext}}ctx context.Context{{end}}) error {
@@ -35,6 +36,7 @@ func (o *{{$alias.UpSingular}}) ReloadGP({{if not .NoContext}}ctx context.Contex
 
 // Reload refetches the object from the database
 // using the primary keys with an executor.
+// It returns sql.ErrNoRows if the row no longer exists.
 func (o *{{$alias.UpSingular}}) Reload({{if .NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}) error {
 	ret, err := Find{{$alias.UpSingular}}({{if not .NoContext}}ctx, {{end -}} exec, {{.Table.PKey.Columns | stringMap (aliasCols $alias) | prefixStringSlice "o." | join ", "}})
 	if err != nil {
@@ -98,7 +100,7 @@ func (o *{{$alias.UpSingular}}Slice) ReloadAll({{if .NoContext}}exec boil.Execut
 
 	sql := "SELECT {{$schemaTable}}.* FROM {{$schemaTable}} WHERE " +
 		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), {{if .Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, {{$alias.DownSingular}}PrimaryKeyColumns, len(*o)){{if and .AddSoftDeletes $canSoftDelete}} +
-		"and {{"deleted_at" | $.Quotes}} is null"
+		"and {{$.AutoColumns.Deleted | $.Quotes}} is null"
 		{{- end}}
 
 	q := queries.Raw(sql, args...)
@@ -112,3 +114,4 @@ func (o *{{$alias.UpSingular}}Slice) ReloadAll({{if .NoContext}}exec boil.Execut
 
 	return nil
 }
+{{end -}}{{/* if not IsView */}}
@@ -2,17 +2,22 @@
 	{{- if not .NoAutoTimestamps -}}
 	{{- $alias := .Aliases.Table .Table.Name -}}
 	{{- $colNames := .Table.Columns | columnNames -}}
-	{{if containsAny $colNames "created_at" "updated_at"}}
+	{{if containsAny $colNames .AutoColumns.Created .AutoColumns.Updated}}
 		{{if not .NoContext -}}
 	if !boil.TimestampsAreSkipped(ctx) {
 		{{end -}}
 		currTime := time.Now().In(boil.GetLocation())
 		{{range $ind, $col := .Table.Columns}}
 		    {{- $colAlias := $alias.Column $col.Name -}}
-			{{- if eq $col.Name "created_at" -}}
+			{{- if eq $col.Name $.AutoColumns.Created -}}
 				{{- if eq $col.Type "time.Time" }}
 		if o.{{$colAlias}}.IsZero() {
 			o.{{$colAlias}} = currTime
+		}
+				{{- else if eq $col.Type "*time.Time"}}
+		if o.{{$colAlias}} == nil || o.{{$colAlias}}.IsZero() {
+			o.{{$colAlias}} = new(time.Time)
+			*o.{{$colAlias}} = currTime
 		}
 				{{- else}}
 		if queries.MustTime(o.{{$colAlias}}).IsZero() {
@@ -20,10 +25,15 @@
 		}
 				{{- end -}}
 			{{- end -}}
-			{{- if eq $col.Name "updated_at" -}}
+			{{- if eq $col.Name $.AutoColumns.Updated -}}
 				{{- if eq $col.Type "time.Time"}}
 		if o.{{$colAlias}}.IsZero() {
 			o.{{$colAlias}} = currTime
+		}
+				{{- else if eq $col.Type "*time.Time"}}
+		if o.{{$colAlias}} == nil || o.{{$colAlias}}.IsZero() {
+			o.{{$colAlias}} = new(time.Time)
+			*o.{{$colAlias}} = currTime
 		}
 				{{- else}}
 		if queries.MustTime(o.{{$colAlias}}).IsZero() {
@@ -42,16 +52,19 @@
 	{{- if not .NoAutoTimestamps -}}
 	{{- $alias := .Aliases.Table .Table.Name -}}
 	{{- $colNames := .Table.Columns | columnNames -}}
-	{{if containsAny $colNames "updated_at"}}
+	{{if containsAny $colNames .AutoColumns.Updated}}
 		{{if not .NoContext -}}
 	if !boil.TimestampsAreSkipped(ctx) {
 		{{end -}}
 		currTime := time.Now().In(boil.GetLocation())
 		{{range $ind, $col := .Table.Columns}}
 	        {{- $colAlias := $alias.Column $col.Name -}}
-			{{- if eq $col.Name "updated_at" -}}
+			{{- if eq $col.Name $.AutoColumns.Updated -}}
 				{{- if eq $col.Type "time.Time"}}
 		o.{{$colAlias}} = currTime
+				{{- else if eq $col.Type "*time.Time"}}
+		o.{{$colAlias}} = new(time.Time)
+		*o.{{$colAlias}} = currTime
 				{{- else}}
 		queries.SetScanner(&o.{{$colAlias}}, currTime)
 				{{- end -}}
@@ -67,17 +80,22 @@
 	{{- if not .NoAutoTimestamps -}}
 	{{- $alias := .Aliases.Table .Table.Name -}}
 	{{- $colNames := .Table.Columns | columnNames -}}
-	{{if containsAny $colNames "created_at" "updated_at"}}
+	{{if containsAny $colNames .AutoColumns.Created .AutoColumns.Updated}}
 		{{if not .NoContext -}}
 	if !boil.TimestampsAreSkipped(ctx) {
 		{{end -}}
 	currTime := time.Now().In(boil.GetLocation())
 		{{range $ind, $col := .Table.Columns}}
 		    {{- $colAlias := $alias.Column $col.Name -}}
-			{{- if eq $col.Name "created_at" -}}
+			{{- if eq $col.Name $.AutoColumns.Created -}}
 				{{- if eq $col.Type "time.Time"}}
 	if o.{{$colAlias}}.IsZero() {
 		o.{{$colAlias}} = currTime
+	}
+				{{- else if eq $col.Type "*time.Time"}}
+	if o.{{$colAlias}} == nil || o.{{$colAlias}}.IsZero() {
+		o.{{$colAlias}} = new(time.Time)
+		*o.{{$colAlias}} = currTime
 	}
 				{{- else}}
 	if queries.MustTime(o.{{$colAlias}}).IsZero() {
@@ -85,9 +103,12 @@
 	}
 				{{- end -}}
 			{{- end -}}
-			{{- if eq $col.Name "updated_at" -}}
+			{{- if eq $col.Name $.AutoColumns.Updated -}}
 				{{- if eq $col.Type "time.Time"}}
 	o.{{$colAlias}} = currTime
+				{{- else if eq $col.Type "*time.Time"}}
+	o.{{$colAlias}} = new(time.Time)
+	*o.{{$colAlias}} = currTime
 				{{- else}}
 	queries.SetScanner(&o.{{$colAlias}}, currTime)
 				{{- end -}}
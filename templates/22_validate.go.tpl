@@ -0,0 +1,45 @@
+{{- if and .AddValidation (not .Table.IsView) -}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+// Validate checks that the required columns of the {{$alias.UpSingular}} are set and that its
+// strings fit in their columns. Required columns are non-nullable columns without a
+// default, only string, []byte and time.Time columns are checked since the zero value
+// of other types is a valid value.
+func (o *{{$alias.UpSingular}}) Validate() error {
+	{{- range $column := .Table.Columns}}
+	{{- $colAlias := $alias.Column $column.Name}}
+	{{- $auto := and (not $.NoAutoTimestamps) (or (eq $column.Name $.AutoColumns.Created) (eq $column.Name $.AutoColumns.Updated))}}
+	{{- if and (not $column.Nullable) (not $column.Default) (not $auto)}}
+	{{- if eq $column.Type "string"}}
+	if len(o.{{$colAlias}}) == 0 {
+		return errors.New("{{$.PkgName}}: {{$.Table.Name}}.{{$column.Name}} is required")
+	}
+	{{- else if eq $column.Type "[]byte"}}
+	if o.{{$colAlias}} == nil {
+		return errors.New("{{$.PkgName}}: {{$.Table.Name}}.{{$column.Name}} is required")
+	}
+	{{- else if eq $column.Type "time.Time"}}
+	if o.{{$colAlias}}.IsZero() {
+		return errors.New("{{$.PkgName}}: {{$.Table.Name}}.{{$column.Name}} is required")
+	}
+	{{- end}}
+	{{- end}}
+	{{- with $column.MaxLength}}
+	{{- if eq $column.Type "string"}}
+	if utf8.RuneCountInString(o.{{$colAlias}}) > {{.}} {
+		return errors.New("{{$.PkgName}}: {{$.Table.Name}}.{{$column.Name}} is longer than {{.}} characters")
+	}
+	{{- else if eq $column.Type "null.String"}}
+	if o.{{$colAlias}}.Valid && utf8.RuneCountInString(o.{{$colAlias}}.String) > {{.}} {
+		return errors.New("{{$.PkgName}}: {{$.Table.Name}}.{{$column.Name}} is longer than {{.}} characters")
+	}
+	{{- else if eq $column.Type "*string"}}
+	if o.{{$colAlias}} != nil && utf8.RuneCountInString(*o.{{$colAlias}}) > {{.}} {
+		return errors.New("{{$.PkgName}}: {{$.Table.Name}}.{{$column.Name}} is longer than {{.}} characters")
+	}
+	{{- end}}
+	{{- end}}
+	{{- end}}
+
+	return nil
+}
+{{- end}}
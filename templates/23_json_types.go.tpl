@@ -0,0 +1,34 @@
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{- range $column := .Table.Columns -}}
+{{- $jsonType := index $.JSONTypes (printf "%s.%s" $.Table.Name $column.Name) -}}
+{{- if $jsonType.Type}}
+// {{$column.Type}} is the {{$jsonType.Type}} stored as JSON in {{$.Table.Name}}.{{$column.Name}}
+type {{$column.Type}} {{$jsonType.Type}}
+
+// Scan unmarshals the JSON of the column, a null is the zero value.
+func (j *{{$column.Type}}) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case nil:
+		var zero {{$column.Type}}
+		*j = zero
+		return nil
+	case []byte:
+		return json.Unmarshal(src, j)
+	case string:
+		return json.Unmarshal([]byte(src), j)
+	}
+
+	return errors.Errorf("{{$.PkgName}}: cannot scan %T into {{$column.Type}}", src)
+}
+
+// Value marshals the {{$column.Type}} to JSON{{if $column.Nullable}}, the zero value is a null{{end}}.
+func (j {{$column.Type}}) Value() (driver.Value, error) {
+	{{- if $column.Nullable}}
+	if reflect.ValueOf(j).IsZero() {
+		return nil, nil
+	}
+	{{- end}}
+	return json.Marshal(j)
+}
+{{end -}}
+{{- end -}}
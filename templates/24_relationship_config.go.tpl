@@ -0,0 +1,193 @@
+{{- if .Table.IsJoinTable -}}
+{{- else -}}
+	{{- range $rel := .Relationships -}}
+	{{- if eq $rel.Table $.Table.Name -}}
+		{{- $ltable := $.Aliases.Table $rel.Table -}}
+		{{- $ftable := $.Aliases.Table $rel.ForeignTable -}}
+		{{- $foreignTable := getTable $.Tables $rel.ForeignTable -}}
+		{{- $pkey := index $.Table.PKey.Columns 0 -}}
+		{{- $col := $ltable.Column $pkey -}}
+		{{- $colType := ($.Table.GetColumn $pkey).Type -}}
+		{{- $arg := printf "maybe%s" $ltable.UpSingular -}}
+		{{- $schemaTable := $rel.Table | $.SchemaTable -}}
+		{{- $schemaForeignTable := $rel.ForeignTable | $.SchemaTable -}}
+		{{- $canSoftDelete := $foreignTable.CanSoftDeleteColumn $.AutoColumns.Deleted }}
+// {{$rel.Name}} retrieves the {{$rel.ForeignTable}} of the {{$rel.Name}} relationship in the config,
+// those that match its join condition.
+func (o *{{$ltable.UpSingular}}) {{$rel.Name}}(mods ...qm.QueryMod) {{$ftable.DownSingular}}Query {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("{{$schemaTable}} on " + {{printf "%q" $rel.On}}),
+		qm.Where("{{$schemaTable}}.{{$pkey | $.Quotes}}=?", o.{{$col}}),
+		{{if and $.AddSoftDeletes $canSoftDelete -}}
+		qmhelper.WhereNotDeleted("{{$schemaForeignTable}}.{{$.AutoColumns.Deleted | $.Quotes}}"),
+		{{- end}}
+	)
+
+	query := {{$ftable.UpPlural}}(queryMods...)
+	queries.SetFrom(query.Query, "{{$schemaForeignTable}}")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"{{$schemaForeignTable}}.*"})
+	}
+
+	return query
+}
+
+// Load{{$rel.Name}} allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for the {{$rel.Name}} relationship in
+// the config, the objects are matched up by joining on its condition.
+func ({{$ltable.DownSingular}}L) Load{{$rel.Name}}({{if $.NoContext}}e boil.Executor{{else}}ctx context.Context, e boil.ContextExecutor{{end}}, singular bool, {{$arg}} interface{}, mods queries.Applicator) error {
+	var slice []*{{$ltable.UpSingular}}
+	var object *{{$ltable.UpSingular}}
+
+	if singular {
+		object = {{$arg}}.(*{{$ltable.UpSingular}})
+	} else {
+		slice = *{{$arg}}.(*[]*{{$ltable.UpSingular}})
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &{{$ltable.DownSingular}}R{}
+		}
+		args = append(args, object.{{$col}})
+	} else {
+		Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &{{$ltable.DownSingular}}R{}
+			}
+
+			for _, a := range args {
+				{{if isPrimitive $colType -}}
+				if a == obj.{{$col}} {
+				{{else -}}
+				if queries.Equal(a, obj.{{$col}}) {
+				{{end -}}
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.{{$col}})
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From("{{$schemaForeignTable}}"),
+		qm.InnerJoin("{{$schemaTable}} on " + {{printf "%q" $rel.On}}),
+		qm.WhereIn("{{$schemaTable}}.{{$pkey | $.Quotes}} in ?", args...),
+		{{if and $.AddSoftDeletes $canSoftDelete -}}
+		qmhelper.WhereNotDeleted("{{$schemaForeignTable}}.{{$.AutoColumns.Deleted | $.Quotes}}"),
+		{{- end}}
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+	if len(queries.GetSelect(query)) == 0 {
+		queries.SetSelect(query, []string{"{{$foreignTable.Columns | columnNames | prefixStringSlice (print $schemaForeignTable ".") | join ", "}}"})
+	}
+	// The local primary key is always last so the loaded objects can be matched up
+	queries.AppendSelect(query, "{{$schemaTable}}.{{$pkey | $.Quotes}}")
+
+	{{if $.NoContext -}}
+	results, err := query.Query(e)
+	{{else -}}
+	results, err := query.QueryContext(ctx, e)
+	{{end -}}
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load {{$rel.ForeignTable}}")
+	}
+
+	cols, err := results.Columns()
+	if err != nil {
+		return errors.Wrap(err, "failed to get columns of eager loaded {{$rel.ForeignTable}}")
+	}
+	// The join makes columns selected with their table come back as
+	// "table.column", only the column is needed to bind them
+	cols = cols[:len(cols)-1]
+	for i, c := range cols {
+		cols[i] = c[strings.LastIndexByte(c, '.')+1:]
+	}
+	mapping, err := queries.BindMapping({{$ftable.DownSingular}}Type, {{$ftable.DownSingular}}Mapping, cols)
+	if err != nil {
+		return err
+	}
+
+	var resultSlice []*{{$ftable.UpSingular}}
+	var localCols []{{$colType}}
+	for results.Next() {
+		one := new({{$ftable.UpSingular}})
+		var localCol {{$colType}}
+
+		ptrs := queries.PtrsFromMapping(reflect.Indirect(reflect.ValueOf(one)), mapping)
+		err = results.Scan(append(ptrs, &localCol)...)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for {{$rel.ForeignTable}}")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localCols = append(localCols, localCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on {{$rel.ForeignTable}}")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for {{$rel.ForeignTable}}")
+	}
+
+	{{if not $.NoHooks -}}
+	if len({{$ftable.DownSingular}}AfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks({{if $.NoContext}}e{{else}}ctx, e{{end -}}); err != nil {
+				return err
+			}
+		}
+	}
+
+	{{end -}}
+	if singular {
+		{{if $rel.ToMany -}}
+		object.R.{{$rel.Name}} = resultSlice
+		{{else -}}
+		if len(resultSlice) != 0 {
+			object.R.{{$rel.Name}} = resultSlice[0]
+		}
+		{{end -}}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localCol := localCols[i]
+		for _, local := range slice {
+			{{if isPrimitive $colType -}}
+			if local.{{$col}} == localCol {
+			{{else -}}
+			if queries.Equal(local.{{$col}}, localCol) {
+			{{end -}}
+				{{if $rel.ToMany -}}
+				local.R.{{$rel.Name}} = append(local.R.{{$rel.Name}}, foreign)
+				{{else -}}
+				local.R.{{$rel.Name}} = foreign
+				{{end -}}
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+	{{end -}}{{/* if table */}}
+	{{- end -}}{{/* range relationships */}}
+{{- end -}}{{/* if IsJoinTable */}}
@@ -0,0 +1,526 @@
+{{- if .Table.IsJoinTable -}}
+{{- else -}}
+	{{- range $poly := .Polymorphic -}}
+	{{- if eq $poly.Table $.Table.Name -}}
+		{{- $ltable := $.Aliases.Table $poly.Table -}}
+		{{- $arg := printf "maybe%s" $ltable.UpSingular -}}
+		{{- $typeCol := $.Table.GetColumn $poly.TypeColumn -}}
+		{{- $typeField := $ltable.Column $poly.TypeColumn -}}
+		{{- $idCol := $.Table.GetColumn $poly.IDColumn -}}
+		{{- $idField := $ltable.Column $poly.IDColumn -}}
+		{{- $schemaTable := $poly.Table | $.SchemaTable -}}
+		{{- $typeIs := "queries.Equal(%s.%s, %q)" -}}
+		{{- if eq $typeCol.Type "string" -}}
+			{{- $typeIs = "%s.%s == %q" -}}
+		{{- end -}}
+		{{- range $owner, $typeName := $poly.Types -}}
+			{{- $otable := $.Aliases.Table $owner -}}
+			{{- $ownerTable := getTable $.Tables $owner -}}
+			{{- $pkey := index $ownerTable.PKey.Columns 0 -}}
+			{{- $pkeyField := $otable.Column $pkey -}}
+			{{- $usesPrimitives := and (isPrimitive $idCol.Type) (isPrimitive ($ownerTable.GetColumn $pkey).Type) -}}
+			{{- $relName := printf "%s%s" (titleCase $poly.Name) $otable.UpSingular -}}
+			{{- $backName := $ltable.UpPlural -}}
+			{{- $schemaOwner := $owner | $.SchemaTable -}}
+			{{- $canSoftDelete := $ownerTable.CanSoftDeleteColumn $.AutoColumns.Deleted }}
+// {{$relName}} pointed to by the {{$poly.Name}} polymorphic association, it finds
+// nothing unless the {{$ltable.DownSingular}} belongs to a {{$otable.DownSingular}}.
+func (o *{{$ltable.UpSingular}}) {{$relName}}(mods ...qm.QueryMod) ({{$otable.DownSingular}}Query) {
+	queryMods := []qm.QueryMod{
+		qm.Where("{{$pkey | $.Quotes}} = ?", o.{{$idField}}),
+		{{if and $.AddSoftDeletes $canSoftDelete -}}
+		qmhelper.WhereNotDeleted("{{$.AutoColumns.Deleted}}"),
+		{{- end}}
+	}
+	if !({{printf $typeIs "o" $typeField $typeName}}) {
+		queryMods = append(queryMods, qm.Where("1 = 0"))
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := {{$otable.UpPlural}}(queryMods...)
+	queries.SetFrom(query.Query, "{{$schemaOwner}}")
+
+	return query
+}
+
+// Load{{$relName}} allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for the {{$poly.Name}} polymorphic
+// association, only the objects that belong to a {{$otable.DownSingular}} are loaded.
+func ({{$ltable.DownSingular}}L) Load{{$relName}}({{if $.NoContext}}e boil.Executor{{else}}ctx context.Context, e boil.ContextExecutor{{end}}, singular bool, {{$arg}} interface{}, mods queries.Applicator) error {
+	var slice []*{{$ltable.UpSingular}}
+	var object *{{$ltable.UpSingular}}
+
+	if singular {
+		object = {{$arg}}.(*{{$ltable.UpSingular}})
+	} else {
+		slice = *{{$arg}}.(*[]*{{$ltable.UpSingular}})
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &{{$ltable.DownSingular}}R{}
+		}
+		{{if $usesPrimitives -}}
+		if {{printf $typeIs "object" $typeField $typeName}} {
+		{{else -}}
+		if {{printf $typeIs "object" $typeField $typeName}} && !queries.IsNil(object.{{$idField}}) {
+		{{end -}}
+			args = append(args, object.{{$idField}})
+		}
+	} else {
+		Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &{{$ltable.DownSingular}}R{}
+			}
+			{{if $usesPrimitives -}}
+			if !({{printf $typeIs "obj" $typeField $typeName}}) {
+			{{else -}}
+			if !({{printf $typeIs "obj" $typeField $typeName}}) || queries.IsNil(obj.{{$idField}}) {
+			{{end -}}
+				continue
+			}
+
+			for _, a := range args {
+				{{if $usesPrimitives -}}
+				if a == obj.{{$idField}} {
+				{{else -}}
+				if queries.Equal(a, obj.{{$idField}}) {
+				{{end -}}
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.{{$idField}})
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From("{{$schemaOwner}}"),
+		qm.WhereIn("{{$schemaOwner}}.{{$pkey | $.Quotes}} in ?", args...),
+		{{if and $.AddSoftDeletes $canSoftDelete -}}
+		qmhelper.WhereNotDeleted("{{$schemaOwner}}.{{$.AutoColumns.Deleted | $.Quotes}}"),
+		{{- end}}
+	)
+	if mods != nil {
+		mods.Apply(query)
+		// A load selecting its own columns still needs the one it's matched on
+		queries.EnsureSelect(query, "{{$schemaOwner}}.{{$pkey | $.Quotes}}")
+	}
+
+	{{if $.NoContext -}}
+	results, err := query.Query(e)
+	{{else -}}
+	results, err := query.QueryContext(ctx, e)
+	{{end -}}
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load {{$otable.UpSingular}}")
+	}
+
+	var resultSlice []*{{$otable.UpSingular}}
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice {{$otable.UpSingular}}")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for {{$owner}}")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for {{$owner}}")
+	}
+
+	{{if not $.NoHooks -}}
+	if len({{$otable.DownSingular}}AfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks({{if $.NoContext}}e{{else}}ctx, e{{end}}); err != nil {
+				return err
+			}
+		}
+	}
+	{{- end}}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.{{$relName}} = foreign
+		{{if not $.NoBackReferencing -}}
+		if foreign.R == nil {
+			foreign.R = &{{$otable.DownSingular}}R{}
+		}
+		foreign.R.{{$backName}} = append(foreign.R.{{$backName}}, object)
+		{{end -}}
+		return nil
+	}
+
+	for _, local := range slice {
+		if !({{printf $typeIs "local" $typeField $typeName}}) {
+			continue
+		}
+
+		for _, foreign := range resultSlice {
+			{{if $usesPrimitives -}}
+			if local.{{$idField}} == foreign.{{$pkeyField}} {
+			{{else -}}
+			if queries.Equal(local.{{$idField}}, foreign.{{$pkeyField}}) {
+			{{end -}}
+				local.R.{{$relName}} = foreign
+				{{if not $.NoBackReferencing -}}
+				if foreign.R == nil {
+					foreign.R = &{{$otable.DownSingular}}R{}
+				}
+				foreign.R.{{$backName}} = append(foreign.R.{{$backName}}, local)
+				{{end -}}
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// Set{{$relName}} of the {{$ltable.DownSingular}} to the related item, setting both
+// the {{$poly.TypeColumn}} and {{$poly.IDColumn}} columns.
+// Sets o.R.{{$relName}} to related, the other owners of o.R to nil.
+// Adds o to related.R.{{$backName}}.
+func (o *{{$ltable.UpSingular}}) Set{{$relName}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, insert bool, related *{{$otable.UpSingular}}) error {
+	var err error
+	if insert {
+		if err = related.Insert({{if not $.NoContext}}ctx, {{end -}} exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE {{$schemaTable}} SET %s WHERE %s",
+		strmangle.SetParamNames("{{$.LQ}}", "{{$.RQ}}", {{if $.Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, []string{{"{"}}"{{$poly.TypeColumn}}", "{{$poly.IDColumn}}"{{"}"}}),
+		strmangle.WhereClause("{{$.LQ}}", "{{$.RQ}}", {{if $.Dialect.UseIndexPlaceholders}}3{{else}}0{{end}}, {{$ltable.DownSingular}}PrimaryKeyColumns),
+	)
+	values := []interface{}{{"{"}}{{printf "%q" $typeName}}, related.{{$pkeyField}}, o.{{$.Table.PKey.Columns | stringMap (aliasCols $ltable) | join ", o."}}{{"}"}}
+
+	{{if $.NoContext -}}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	{{else -}}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	{{end -}}
+
+	{{if $.NoContext -}}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+	{{- else -}}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+	{{- end}}
+
+	{{if eq $typeCol.Type "string" -}}
+	o.{{$typeField}} = {{printf "%q" $typeName}}
+	{{else -}}
+	queries.Assign(&o.{{$typeField}}, {{printf "%q" $typeName}})
+	{{end -}}
+	{{if $usesPrimitives -}}
+	o.{{$idField}} = related.{{$pkeyField}}
+	{{else -}}
+	queries.Assign(&o.{{$idField}}, related.{{$pkeyField}})
+	{{end -}}
+
+	if o.R == nil {
+		o.R = &{{$ltable.DownSingular}}R{
+			{{$relName}}: related,
+		}
+	} else {
+		o.R.{{$relName}} = related
+		{{- range $other, $otherType := $poly.Types}}{{if ne $other $owner}}
+		o.R.{{titleCase $poly.Name}}{{($.Aliases.Table $other).UpSingular}} = nil
+		{{- end}}{{end}}
+	}
+
+	if related.R == nil {
+		related.R = &{{$otable.DownSingular}}R{
+			{{$backName}}: {{$ltable.UpSingular}}Slice{{"{"}}o{{"}"}},
+		}
+	} else {
+		related.R.{{$backName}} = append(related.R.{{$backName}}, o)
+	}
+
+	return nil
+}
+
+		{{end -}}{{/* range types */}}
+	{{- end -}}{{/* if polymorphic table */}}
+
+	{{- $typeName := index $poly.Types $.Table.Name -}}
+	{{- if $typeName -}}
+		{{- $ltable := $.Aliases.Table $.Table.Name -}}
+		{{- $ftable := $.Aliases.Table $poly.Table -}}
+		{{- $polyTable := getTable $.Tables $poly.Table -}}
+		{{- $arg := printf "maybe%s" $ltable.UpSingular -}}
+		{{- $pkey := index $.Table.PKey.Columns 0 -}}
+		{{- $col := $ltable.Column $pkey -}}
+		{{- $typeCol := $polyTable.GetColumn $poly.TypeColumn -}}
+		{{- $typeField := $ftable.Column $poly.TypeColumn -}}
+		{{- $idCol := $polyTable.GetColumn $poly.IDColumn -}}
+		{{- $idField := $ftable.Column $poly.IDColumn -}}
+		{{- $usesPrimitives := and (isPrimitive $idCol.Type) (isPrimitive ($.Table.GetColumn $pkey).Type) -}}
+		{{- $relName := $ftable.UpPlural -}}
+		{{- $backName := printf "%s%s" (titleCase $poly.Name) $ltable.UpSingular -}}
+		{{- $schemaPolyTable := $poly.Table | $.SchemaTable -}}
+		{{- $canSoftDelete := $polyTable.CanSoftDeleteColumn $.AutoColumns.Deleted }}
+// {{$relName}} retrieves all the {{$poly.Table}} that belong to the {{$ltable.DownSingular}} through the
+// {{$poly.Name}} polymorphic association.
+func (o *{{$ltable.UpSingular}}) {{$relName}}(mods ...qm.QueryMod) {{$ftable.DownSingular}}Query {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("{{$schemaPolyTable}}.{{$poly.TypeColumn | $.Quotes}}=?", {{printf "%q" $typeName}}),
+		qm.Where("{{$schemaPolyTable}}.{{$poly.IDColumn | $.Quotes}}=?", o.{{$col}}),
+		{{if and $.AddSoftDeletes $canSoftDelete -}}
+		qmhelper.WhereNotDeleted("{{$schemaPolyTable}}.{{$.AutoColumns.Deleted | $.Quotes}}"),
+		{{- end}}
+	)
+
+	query := {{$ftable.UpPlural}}(queryMods...)
+	queries.SetFrom(query.Query, "{{$schemaPolyTable}}")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"{{$schemaPolyTable}}.*"})
+	}
+
+	return query
+}
+
+// Load{{$relName}} allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for the {{$poly.Name}} polymorphic
+// association, the {{$poly.Table}} are loaded by their type and id.
+func ({{$ltable.DownSingular}}L) Load{{$relName}}({{if $.NoContext}}e boil.Executor{{else}}ctx context.Context, e boil.ContextExecutor{{end}}, singular bool, {{$arg}} interface{}, mods queries.Applicator) error {
+	var slice []*{{$ltable.UpSingular}}
+	var object *{{$ltable.UpSingular}}
+
+	if singular {
+		object = {{$arg}}.(*{{$ltable.UpSingular}})
+	} else {
+		slice = *{{$arg}}.(*[]*{{$ltable.UpSingular}})
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &{{$ltable.DownSingular}}R{}
+		}
+		args = append(args, object.{{$col}})
+	} else {
+		Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &{{$ltable.DownSingular}}R{}
+			}
+
+			for _, a := range args {
+				{{if $usesPrimitives -}}
+				if a == obj.{{$col}} {
+				{{else -}}
+				if queries.Equal(a, obj.{{$col}}) {
+				{{end -}}
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.{{$col}})
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From("{{$schemaPolyTable}}"),
+		qm.Where("{{$schemaPolyTable}}.{{$poly.TypeColumn | $.Quotes}}=?", {{printf "%q" $typeName}}),
+		qm.WhereIn("{{$schemaPolyTable}}.{{$poly.IDColumn | $.Quotes}} in ?", args...),
+		{{if and $.AddSoftDeletes $canSoftDelete -}}
+		qmhelper.WhereNotDeleted("{{$schemaPolyTable}}.{{$.AutoColumns.Deleted | $.Quotes}}"),
+		{{- end}}
+	)
+	if mods != nil {
+		mods.Apply(query)
+		// A load selecting its own columns still needs the one it's matched on
+		queries.EnsureSelect(query, "{{$schemaPolyTable}}.{{$poly.IDColumn | $.Quotes}}")
+	}
+
+	{{if $.NoContext -}}
+	results, err := query.Query(e)
+	{{else -}}
+	results, err := query.QueryContext(ctx, e)
+	{{end -}}
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load {{$poly.Table}}")
+	}
+
+	var resultSlice []*{{$ftable.UpSingular}}
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice {{$poly.Table}}")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on {{$poly.Table}}")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for {{$poly.Table}}")
+	}
+
+	{{if not $.NoHooks -}}
+	if len({{$ftable.DownSingular}}AfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks({{if $.NoContext}}e{{else}}ctx, e{{end -}}); err != nil {
+				return err
+			}
+		}
+	}
+
+	{{end -}}
+	if singular {
+		object.R.{{$relName}} = resultSlice
+		{{if not $.NoBackReferencing -}}
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &{{$ftable.DownSingular}}R{}
+			}
+			foreign.R.{{$backName}} = object
+		}
+		{{end -}}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			{{if $usesPrimitives -}}
+			if local.{{$col}} == foreign.{{$idField}} {
+			{{else -}}
+			if queries.Equal(local.{{$col}}, foreign.{{$idField}}) {
+			{{end -}}
+				local.R.{{$relName}} = append(local.R.{{$relName}}, foreign)
+				{{if not $.NoBackReferencing -}}
+				if foreign.R == nil {
+					foreign.R = &{{$ftable.DownSingular}}R{}
+				}
+				foreign.R.{{$backName}} = local
+				{{end -}}
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// Add{{$relName}} adds the given related objects to the existing relationships
+// of the {{$ltable.DownSingular}}, optionally inserting them as new records. Both the
+// {{$poly.TypeColumn}} and {{$poly.IDColumn}} columns of the related objects are set.
+// Appends related to o.R.{{$relName}}.
+// Sets related.R.{{$backName}} appropriately.
+func (o *{{$ltable.UpSingular}}) Add{{$relName}}({{if $.NoContext}}exec boil.Executor{{else}}ctx context.Context, exec boil.ContextExecutor{{end}}, insert bool, related ...*{{$ftable.UpSingular}}) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			{{if eq $typeCol.Type "string" -}}
+			rel.{{$typeField}} = {{printf "%q" $typeName}}
+			{{else -}}
+			queries.Assign(&rel.{{$typeField}}, {{printf "%q" $typeName}})
+			{{end -}}
+			{{if $usesPrimitives -}}
+			rel.{{$idField}} = o.{{$col}}
+			{{else -}}
+			queries.Assign(&rel.{{$idField}}, o.{{$col}})
+			{{end -}}
+
+			if err = rel.Insert({{if not $.NoContext}}ctx, {{end -}} exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE {{$schemaPolyTable}} SET %s WHERE %s",
+				strmangle.SetParamNames("{{$.LQ}}", "{{$.RQ}}", {{if $.Dialect.UseIndexPlaceholders}}1{{else}}0{{end}}, []string{{"{"}}"{{$poly.TypeColumn}}", "{{$poly.IDColumn}}"{{"}"}}),
+				strmangle.WhereClause("{{$.LQ}}", "{{$.RQ}}", {{if $.Dialect.UseIndexPlaceholders}}3{{else}}0{{end}}, {{$ftable.DownSingular}}PrimaryKeyColumns),
+			)
+			values := []interface{}{{"{"}}{{printf "%q" $typeName}}, o.{{$col}}, rel.{{$polyTable.PKey.Columns | stringMap (aliasCols $ftable) | join ", rel."}}{{"}"}}
+
+			{{if $.NoContext -}}
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			{{else -}}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			{{end -}}
+
+			{{if $.NoContext -}}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+			{{else -}}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			{{end -}}
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			{{if eq $typeCol.Type "string" -}}
+			rel.{{$typeField}} = {{printf "%q" $typeName}}
+			{{else -}}
+			queries.Assign(&rel.{{$typeField}}, {{printf "%q" $typeName}})
+			{{end -}}
+			{{if $usesPrimitives -}}
+			rel.{{$idField}} = o.{{$col}}
+			{{else -}}
+			queries.Assign(&rel.{{$idField}}, o.{{$col}})
+			{{end -}}
+		}
+	}
+
+	if o.R == nil {
+		o.R = &{{$ltable.DownSingular}}R{
+			{{$relName}}: related,
+		}
+	} else {
+		o.R.{{$relName}} = append(o.R.{{$relName}}, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &{{$ftable.DownSingular}}R{
+				{{$backName}}: o,
+			}
+		} else {
+			rel.R.{{$backName}} = o
+			{{- range $other, $otherType := $poly.Types}}{{if ne $other $.Table.Name}}
+			rel.R.{{titleCase $poly.Name}}{{($.Aliases.Table $other).UpSingular}} = nil
+			{{- end}}{{end}}
+		}
+	}
+
+	return nil
+}
+
+	{{end -}}{{/* if owner table */}}
+	{{- end -}}{{/* range polymorphic */}}
+{{- end -}}{{/* if IsJoinTable */}}
@@ -0,0 +1,59 @@
+{{- if .AddInterfaces -}}
+{{- $ctxArgs := "ctx context.Context, exec boil.ContextExecutor" -}}
+{{- $ctxCall := "ctx, exec" -}}
+{{- if .NoContext -}}
+	{{- $ctxArgs = "exec boil.Executor" -}}
+	{{- $ctxCall = "exec" -}}
+{{- end -}}
+{{- $rowsAffected := "(int64, error)" -}}
+{{- if .NoRowsAffected -}}
+	{{- $rowsAffected = "error" -}}
+{{- end -}}
+{{- range $table := .Tables -}}
+{{- if and (not $table.IsJoinTable) (not $table.IsView) -}}
+{{- $alias := $.Aliases.Table $table.Name -}}
+{{- $colDefs := sqlColDefinitions $table.Columns $table.PKey.Columns -}}
+{{- $pkNames := $colDefs.Names | stringMap (aliasCols $alias) | stringMap $.StringFuncs.camelCase | stringMap $.StringFuncs.replaceReserved -}}
+{{- $pkArgs := joinSlices " " $pkNames $colDefs.Types | join ", " -}}
+{{- $soft := and $.AddSoftDeletes ($table.CanSoftDeleteColumn $.AutoColumns.Deleted) }}
+// {{$alias.UpSingular}}Repository is the interface of the generated methods that read
+// and write the {{$table.Name}} table. Depend on it instead of the generated functions
+// to substitute a mock in tests.
+type {{$alias.UpSingular}}Repository interface {
+	Find({{$ctxArgs}}, {{$pkArgs}}, selectCols ...string) (*{{$alias.UpSingular}}, error)
+	Insert({{$ctxArgs}}, o *{{$alias.UpSingular}}, columns boil.Columns) error
+	Update({{$ctxArgs}}, o *{{$alias.UpSingular}}, columns boil.Columns) {{$rowsAffected}}
+	Delete({{$ctxArgs}}, o *{{$alias.UpSingular}}{{if $soft}}, hardDelete bool{{end}}) {{$rowsAffected}}
+	Reload({{$ctxArgs}}, o *{{$alias.UpSingular}}) error
+}
+
+// New{{$alias.UpSingular}}Repository returns the {{$alias.UpSingular}}Repository that calls the
+// generated methods.
+func New{{$alias.UpSingular}}Repository() {{$alias.UpSingular}}Repository {
+	return {{$alias.DownSingular}}Repository{}
+}
+
+type {{$alias.DownSingular}}Repository struct{}
+
+func ({{$alias.DownSingular}}Repository) Find({{$ctxArgs}}, {{$pkArgs}}, selectCols ...string) (*{{$alias.UpSingular}}, error) {
+	return Find{{$alias.UpSingular}}({{$ctxCall}}, {{$pkNames | join ", "}}, selectCols...)
+}
+
+func ({{$alias.DownSingular}}Repository) Insert({{$ctxArgs}}, o *{{$alias.UpSingular}}, columns boil.Columns) error {
+	return o.Insert({{$ctxCall}}, columns)
+}
+
+func ({{$alias.DownSingular}}Repository) Update({{$ctxArgs}}, o *{{$alias.UpSingular}}, columns boil.Columns) {{$rowsAffected}} {
+	return o.Update({{$ctxCall}}, columns)
+}
+
+func ({{$alias.DownSingular}}Repository) Delete({{$ctxArgs}}, o *{{$alias.UpSingular}}{{if $soft}}, hardDelete bool{{end}}) {{$rowsAffected}} {
+	return o.Delete({{$ctxCall}}{{if $soft}}, hardDelete{{end}})
+}
+
+func ({{$alias.DownSingular}}Repository) Reload({{$ctxArgs}}, o *{{$alias.UpSingular}}) error {
+	return o.Reload({{$ctxCall}})
+}
+{{end -}}
+{{- end -}}
+{{- end -}}
@@ -6,10 +6,17 @@ var dialect = drivers.Dialect{
 	UseLastInsertID:         {{.Dialect.UseLastInsertID}},
 	UseSchema:               {{.Dialect.UseSchema}},
 	UseDefaultKeyword:       {{.Dialect.UseDefaultKeyword}},
+	UseNullsOrdering:        {{.Dialect.UseNullsOrdering}},
+	UseRandFunction:         {{.Dialect.UseRandFunction}},
+	UseMatchAgainst:         {{.Dialect.UseMatchAgainst}},
+	UseBoundLimitOffset:     {{.Dialect.UseBoundLimitOffset}},
+	UseReturningClause:      {{.Dialect.UseReturningClause}},
+	UseOnConflictClause:     {{.Dialect.UseOnConflictClause}},
 	UseAutoColumns:          {{.Dialect.UseAutoColumns}},
 	UseTopClause:            {{.Dialect.UseTopClause}},
 	UseOutputClause:         {{.Dialect.UseOutputClause}},
 	UseCaseWhenExistsClause: {{.Dialect.UseCaseWhenExistsClause}},
+	UseMergeClause:          {{.Dialect.UseMergeClause}},
 }
 
 // NewQuery initializes a new Query using the passed in QueryMods
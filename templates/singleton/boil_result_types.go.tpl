@@ -0,0 +1,47 @@
+{{- $ctxArgs := "ctx context.Context, exec boil.ContextExecutor" -}}
+{{- $ctx := "ctx" -}}
+{{- if .NoContext -}}
+	{{- $ctxArgs = "exec boil.Executor" -}}
+	{{- $ctx = "nil" -}}
+{{- end -}}
+{{- range $result := .ResultTypes}}
+// {{$result.Name}} is a row of the results of a query that doesn't map to a
+// single table, its columns are declared in the result types of the config.
+type {{$result.Name}} struct {
+	{{- range $column := $result.Columns}}
+	{{- $colAlias := titleCase $column.Name}}
+	{{$colAlias}} {{$column.Type}} `{{range $tag := $.Tags}}{{$tag}}:"{{tagName ($.TagCase $tag) $column.Name $colAlias}}" {{end}}boil:"{{$column.Name}}" json:"{{tagName ($.TagCase "json") $column.Name $colAlias}}" toml:"{{tagName ($.TagCase "toml") $column.Name $colAlias}}" yaml:"{{tagName ($.TagCase "yaml") $column.Name $colAlias}}"`
+	{{- end}}
+}
+
+// {{$result.Name}}Slice is an alias for a slice of pointers to {{$result.Name}}.
+type {{$result.Name}}Slice []*{{$result.Name}}
+
+// Scan{{$result.Name}} binds the first row of the query to a {{$result.Name}},
+// it returns sql.ErrNoRows when there are no rows.
+func Scan{{$result.Name}}({{$ctxArgs}}, q *queries.Query) (*{{$result.Name}}, error) {
+	o := &{{$result.Name}}{}
+
+	err := q.Bind({{$ctx}}, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "{{$.PkgName}}: failed to scan a {{$result.Name}}")
+	}
+
+	return o, nil
+}
+
+// Scan{{$result.Name}}Slice binds all the rows of the query to {{$result.Name}}s.
+func Scan{{$result.Name}}Slice({{$ctxArgs}}, q *queries.Query) ({{$result.Name}}Slice, error) {
+	var o []*{{$result.Name}}
+
+	err := q.Bind({{$ctx}}, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "{{$.PkgName}}: failed to scan {{$result.Name}} slice")
+	}
+
+	return o, nil
+}
+{{end -}}
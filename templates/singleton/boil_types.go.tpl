@@ -5,6 +5,13 @@ type M map[string]interface{}
 // order to populate default value information. This usually happens when LastInsertId
 // fails or there was a primary key configuration that was not resolvable.
 var ErrSyncFail = errors.New("{{.PkgName}}: failed to synchronize data after insert")
+{{- if .OptimisticLock}}
+
+// ErrOptimisticLock occurs during update when the row's version column no longer
+// matches the version on the object, meaning the row was changed or deleted since
+// it was loaded.
+var ErrOptimisticLock = errors.New("{{.PkgName}}: row was changed or deleted since it was loaded")
+{{- end}}
 
 type insertCache struct {
 	query        string
@@ -72,17 +79,82 @@ It only titlecases the EnumValue portion if it's snake-cased.
 				{{$_ := oncePut $once $name}}
 			{{- end -}}
 {{- if and (gt (len $vals) 0) (isEnumNormal $vals)}}
+{{- $enumType := ""}}
+{{- if and $isNamed $.AddEnumTypes}}
+{{- $enumType = titleCase $name}}
+
+// {{$enumType}} is the type of the {{$name}} enum
+type {{$enumType}} string
+{{- end}}
+
 // Enum values for {{if $isNamed}}{{$name}}{{else}}{{$table.Name}}.{{$col.Name}}{{end}}
 const (
 	{{- range $val := $vals -}}
 	{{- $valStripped := stripWhitespace $val -}}
 	{{- if $isNamed}}{{titleCase $name}}{{else}}{{titleCase $table.Name}}{{titleCase $col.Name}}{{end -}}
-	{{if shouldTitleCaseEnum $valStripped}}{{titleCase $valStripped}}{{else}}{{$valStripped}}{{end}} = "{{$val}}"
+	{{if shouldTitleCaseEnum $valStripped}}{{titleCase $valStripped}}{{else}}{{$valStripped}}{{end}} {{if $enumType}}{{$enumType}} {{end}}= "{{$val}}"
 	{{end -}}
 )
+{{- if $enumType}}
+
+// IsValid returns an error if e is not one of the {{$name}} enum values
+func (e {{$enumType}}) IsValid() error {
+	switch e {
+	case {{range $i, $val := $vals}}{{if ne $i 0}}, {{end}}{{$valStripped := stripWhitespace $val}}{{$enumType}}{{if shouldTitleCaseEnum $valStripped}}{{titleCase $valStripped}}{{else}}{{$valStripped}}{{end}}{{end}}:
+		return nil
+	default:
+		return errors.Errorf("%q is not a valid {{$name}} value", string(e))
+	}
+}
+
+// String returns the enum value as a string
+func (e {{$enumType}}) String() string {
+	return string(e)
+}
+
+// Scan implements the sql.Scanner interface
+func (e *{{$enumType}}) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case string:
+		*e = {{$enumType}}(v)
+	case []byte:
+		*e = {{$enumType}}(v)
+	default:
+		return errors.Errorf("cannot scan type %T into {{$enumType}}", value)
+	}
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface
+func (e {{$enumType}}) Value() (driver.Value, error) {
+	return string(e), nil
+}
+{{- end}}
 {{- else}}
 // Enum values for {{if $isNamed}}{{$name}}{{else}}{{$table.Name}}.{{$col.Name}}{{end}} are not proper Go identifiers, cannot emit constants
 {{- end -}}
 		{{- end -}}
 	{{- end -}}
 {{- end -}}
+
+{{- /* MySQL SET columns get constants for their members like unnamed enums */}}
+{{- range $table := .Tables -}}
+	{{- range $col := $table.Columns -}}
+		{{- $vals := $col.SetValues -}}
+		{{- if gt (len $vals) 0 -}}
+{{- if isEnumNormal $vals}}
+
+// Set values for {{$table.Name}}.{{$col.Name}}, a value holds a comma separated list of them
+const (
+	{{- range $val := $vals -}}
+	{{- $valStripped := stripWhitespace $val -}}
+	{{titleCase $table.Name}}{{titleCase $col.Name}}{{if shouldTitleCaseEnum $valStripped}}{{titleCase $valStripped}}{{else}}{{$valStripped}}{{end}} = "{{$val}}"
+	{{end -}}
+)
+{{- else}}
+// Set values for {{$table.Name}}.{{$col.Name}} are not proper Go identifiers, cannot emit constants
+{{- end -}}
+		{{- end -}}
+	{{- end -}}
+{{- end -}}
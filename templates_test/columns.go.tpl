@@ -0,0 +1,19 @@
+{{- $alias := .Aliases.Table .Table.Name}}
+func test{{$alias.UpPlural}}Columns(t *testing.T) {
+	t.Parallel()
+
+	cols := []string{
+		{{range $column := .Table.Columns -}}
+		{{$alias.UpSingular}}Columns.{{$alias.Column $column.Name}},
+		{{end -}}
+	}
+
+	if len(cols) != len({{$alias.DownSingular}}AllColumns) {
+		t.Fatalf("want %d columns, got %d", len({{$alias.DownSingular}}AllColumns), len(cols))
+	}
+	for i, col := range cols {
+		if col != {{$alias.DownSingular}}AllColumns[i] {
+			t.Errorf("%d) want column %q, got %q", i, {{$alias.DownSingular}}AllColumns[i], col)
+		}
+	}
+}
@@ -5,7 +5,7 @@ func test{{$alias.UpPlural}}Find(t *testing.T) {
 	seed := randomize.NewSeed()
 	var err error
 	o := &{{$alias.UpSingular}}{}
-	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
@@ -24,4 +24,18 @@ func test{{$alias.UpPlural}}Find(t *testing.T) {
 	if {{$alias.DownSingular}}Found == nil {
 		t.Error("want a record, got nil")
 	}
+	{{- range $col := .Table.Columns -}}
+	{{- if and $col.Unique (not $col.Nullable) (not (and (eq (len $.Table.PKey.Columns) 1) (eq (index $.Table.PKey.Columns 0) $col.Name))) -}}
+	{{- $colAlias := $alias.Column $col.Name}}
+
+	{{$alias.DownSingular}}Found, err = Find{{$alias.UpSingular}}By{{$colAlias}}({{if not $.NoContext}}ctx, {{end -}} tx, o.{{$colAlias}})
+	if err != nil {
+		t.Error(err)
+	}
+
+	if {{$alias.DownSingular}}Found == nil {
+		t.Error("want a record by {{$col.Name}}, got nil")
+	}
+	{{- end -}}
+	{{- end}}
 }
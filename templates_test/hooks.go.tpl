@@ -55,7 +55,7 @@ func test{{$alias.UpPlural}}Hooks(t *testing.T) {
 	o := &{{$alias.UpSingular}}{}
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, false); err != nil {
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, false); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} object: %s", err)
 	}
 
@@ -139,5 +139,56 @@ func test{{$alias.UpPlural}}Hooks(t *testing.T) {
 		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
 	}
 	{{$alias.DownSingular}}AfterUpsertHooks = []{{$alias.UpSingular}}Hook{}
+
+	// An error from a before hook aborts the operation before the executor is used
+	Add{{$alias.UpSingular}}Hook(boil.BeforeInsertHook, func({{if .NoContext}}e boil.Executor{{else}}ctx context.Context, e boil.ContextExecutor{{end}}, o *{{$alias.UpSingular}}) error {
+		return {{$alias.DownSingular}}HookError{}
+	})
+	if err = o.Insert({{if not .NoContext}}ctx, {{end -}} nil, boil.Infer()); err != ({{$alias.DownSingular}}HookError{}) {
+		t.Errorf("Expected BeforeInsertHook error to abort the insert, but got: %v", err)
+	}
+	{{$alias.DownSingular}}BeforeInsertHooks = []{{$alias.UpSingular}}Hook{}
+
+	{{if not .NoContext -}}
+	// The context given to the model method is the one passed to its hooks
+	type hookCtxKey struct{}
+	var hookCtxValue interface{}
+	Add{{$alias.UpSingular}}Hook(boil.BeforeInsertHook, func(ctx context.Context, e boil.ContextExecutor, o *{{$alias.UpSingular}}) error {
+		hookCtxValue = ctx.Value(hookCtxKey{})
+		return {{$alias.DownSingular}}HookError{}
+	})
+	_ = o.Insert(context.WithValue(ctx, hookCtxKey{}, "trace"), nil, boil.Infer())
+	if hookCtxValue != "trace" {
+		t.Errorf("Expected the context to reach BeforeInsertHook, but got value: %v", hookCtxValue)
+	}
+	{{$alias.DownSingular}}BeforeInsertHooks = []{{$alias.UpSingular}}Hook{}
+
+	{{end -}}
+	// After hooks run once the row has been inserted
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} object: %s", err)
+	}
+
+	var inserted bool
+	Add{{$alias.UpSingular}}Hook(boil.AfterInsertHook, func({{if .NoContext}}e boil.Executor{{else}}ctx context.Context, e boil.ContextExecutor{{end}}, o *{{$alias.UpSingular}}) error {
+		inserted = true
+		return nil
+	})
+	if err = o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if !inserted {
+		t.Error("Expected AfterInsertHook to run after the insert")
+	}
+	{{$alias.DownSingular}}AfterInsertHooks = []{{$alias.UpSingular}}Hook{}
+}
+
+type {{$alias.DownSingular}}HookError struct{}
+
+func ({{$alias.DownSingular}}HookError) Error() string {
+	return "{{$alias.DownSingular}} hook failed"
 }
 {{- end}}
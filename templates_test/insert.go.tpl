@@ -1,11 +1,18 @@
 {{- $alias := .Aliases.Table .Table.Name}}
+{{- $colNames := .Table.Columns | columnNames}}
 func test{{$alias.UpPlural}}Insert(t *testing.T) {
 	t.Parallel()
 
 	seed := randomize.NewSeed()
 	var err error
 	o := &{{$alias.UpSingular}}{}
-	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+	{{- if and (not .NoAutoTimestamps) (containsAny $colNames .AutoColumns.Created .AutoColumns.Updated)}}
+	// The timestamps are left zero for Insert to set
+	blacklist := append([]string{ {{- range $col := .Table.Columns}}{{if or (eq $col.Name $.AutoColumns.Created) (eq $col.Name $.AutoColumns.Updated)}}"{{$col.Name}}", {{end}}{{end -}} }, {{$alias.DownSingular}}ColumnsWithDefault...)
+	{{- else}}
+	blacklist := {{$alias.DownSingular}}ColumnsWithDefault
+	{{- end}}
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, blacklist...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
@@ -24,6 +31,25 @@ func test{{$alias.UpPlural}}Insert(t *testing.T) {
 	if count != 1 {
 		t.Error("want one record, got:", count)
 	}
+	{{- if not .NoAutoTimestamps}}
+	{{- range $col := .Table.Columns}}
+	{{- if or (eq $col.Name $.AutoColumns.Created) (eq $col.Name $.AutoColumns.Updated)}}
+	{{- $colAlias := $alias.Column $col.Name}}
+	{{- if eq $col.Type "time.Time"}}
+
+	if o.{{$colAlias}}.IsZero() {
+	{{- else if isPointer $col.Type}}
+
+	if o.{{$colAlias}} == nil || o.{{$colAlias}}.IsZero() {
+	{{- else}}
+
+	if queries.MustTime(o.{{$colAlias}}).IsZero() {
+	{{- end}}
+		t.Error("want {{$col.Name}} to be set automatically")
+	}
+	{{- end}}
+	{{- end}}
+	{{- end}}
 }
 
 func test{{$alias.UpPlural}}InsertWhitelist(t *testing.T) {
@@ -32,7 +58,7 @@ func test{{$alias.UpPlural}}InsertWhitelist(t *testing.T) {
 	seed := randomize.NewSeed()
 	var err error
 	o := &{{$alias.UpSingular}}{}
-	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true); err != nil {
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
@@ -52,3 +78,57 @@ func test{{$alias.UpPlural}}InsertWhitelist(t *testing.T) {
 		t.Error("want one record, got:", count)
 	}
 }
+
+func test{{$alias.UpPlural}}InsertBlacklist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &{{$alias.UpSingular}}{}
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	{{if not .NoContext}}ctx := context.Background(){{end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Blacklist({{$alias.DownSingular}}ColumnsWithDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := {{$alias.UpPlural}}().Count({{if not .NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func test{{$alias.UpPlural}}InsertGreylist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &{{$alias.UpSingular}}{}
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	{{if not .NoContext}}ctx := context.Background(){{end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Greylist({{$alias.DownSingular}}ColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := {{$alias.UpPlural}}().Count({{if not .NoContext}}ctx, {{end -}} tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -19,7 +19,7 @@ func test{{$ltable.UpSingular}}ToMany{{$relAlias.Local}}(t *testing.T) {
 	var b, c {{$ftable.UpSingular}}
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, {{$ltable.DownSingular}}DBTypes, true, {{$ltable.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, &a, {{$ltable.DownSingular}}DBTypes, true, {{$ltable.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$ltable.UpSingular}} struct: %s", err)
 	}
 
@@ -27,10 +27,10 @@ func test{{$ltable.UpSingular}}ToMany{{$relAlias.Local}}(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, {{$ftable.DownSingular}}DBTypes, false, {{$ftable.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, &b, {{$ftable.DownSingular}}DBTypes, false, {{$ftable.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, {{$ftable.DownSingular}}DBTypes, false, {{$ftable.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, &c, {{$ftable.DownSingular}}DBTypes, false, {{$ftable.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
@@ -108,6 +108,31 @@ func test{{$ltable.UpSingular}}ToMany{{$relAlias.Local}}(t *testing.T) {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
+	{{if not $.NoContext -}}
+	var d {{$ltable.UpSingular}}
+	if err = randomizeStruct(seed, &d, {{$ltable.DownSingular}}DBTypes, true, {{$ltable.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = d.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	// All parents must be loaded with a single query
+	a.R.{{$relAlias.Local}} = nil
+	debug := &bytes.Buffer{}
+	debugCtx := boil.WithDebugWriter(boil.WithDebug(ctx, true), debug)
+	parents := {{$ltable.UpSingular}}Slice{&a, &d}
+	if err = a.L.Load{{$relAlias.Local}}(debugCtx, tx, false, (*[]*{{$ltable.UpSingular}})(&parents), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := bytes.Count(debug.Bytes(), []byte("\n")); got != 2 {
+		t.Errorf("want a single eager load query for all parents, got:\n%s", debug.String())
+	}
+	if got := len(a.R.{{$relAlias.Local}}); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+	{{- end}}
+
 	if t.Failed() {
 		t.Logf("%#v", check)
 	}
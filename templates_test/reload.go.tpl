@@ -5,7 +5,7 @@ func test{{$alias.UpPlural}}Reload(t *testing.T) {
 	seed := randomize.NewSeed()
 	var err error
 	o := &{{$alias.UpSingular}}{}
-	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
@@ -19,6 +19,31 @@ func test{{$alias.UpPlural}}Reload(t *testing.T) {
 	if err = o.Reload({{if not .NoContext}}ctx, {{end -}} tx); err != nil {
 		t.Error(err)
 	}
+
+	// Stale values are overwritten with the ones in the database
+	want := *o
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}PrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+	if err = o.Reload({{if not .NoContext}}ctx, {{end -}} tx); err != nil {
+		t.Error(err)
+	}
+	if !reflect.DeepEqual(want, *o) {
+		t.Errorf("want reloaded object to be %#v, got %#v", want, *o)
+	}
+
+	{{if .NoRowsAffected -}}
+	if err = o.Delete({{if not .NoContext}}ctx, {{end -}} tx{{if and .AddSoftDeletes (.Table.CanSoftDeleteColumn .AutoColumns.Deleted)}}, false{{end}}); err != nil {
+		t.Error(err)
+	}
+	{{- else -}}
+	if _, err = o.Delete({{if not .NoContext}}ctx, {{end -}} tx{{if and .AddSoftDeletes (.Table.CanSoftDeleteColumn .AutoColumns.Deleted)}}, false{{end}}); err != nil {
+		t.Error(err)
+	}
+	{{- end}}
+	if err = o.Reload({{if not .NoContext}}ctx, {{end -}} tx); err == nil {
+		t.Error("expected an error reloading a deleted row")
+	}
 }
 
 func test{{$alias.UpPlural}}ReloadAll(t *testing.T) {
@@ -27,7 +52,7 @@ func test{{$alias.UpPlural}}ReloadAll(t *testing.T) {
 	seed := randomize.NewSeed()
 	var err error
 	o := &{{$alias.UpSingular}}{}
-	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
@@ -44,3 +44,66 @@ func (f *fKeyDestroyer) Read(b []byte) (int, error) {
 	return f.buf.Read(b)
 }
 
+
+// randomizeStruct is randomize.Struct for structs that have pointer fields,
+// like nullable columns generated as pointers, which randomize doesn't
+// support. The pointer fields of columns are randomized as the type they
+// point to and are left nil when that's the zero value.
+func randomizeStruct(s *randomize.Seed, str interface{}, colTypes map[string]string, canBeNull bool, blacklist ...string) error {
+	val := reflect.ValueOf(str)
+	if val.Kind() != reflect.Ptr || val.Elem().Kind() != reflect.Struct {
+		return randomize.Struct(s, str, colTypes, canBeNull, blacklist...)
+	}
+	val = val.Elem()
+	typ := val.Type()
+
+	// Randomize a copy of the struct's columns with the pointers replaced by
+	// the type they point to
+	var fields []reflect.StructField
+	var indexes []int
+	hasPointers := false
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if _, ok := colTypes[field.Name]; !ok {
+			continue
+		}
+		if field.Type.Kind() == reflect.Ptr {
+			hasPointers = true
+			field.Type = field.Type.Elem()
+		}
+		fields = append(fields, field)
+		indexes = append(indexes, i)
+	}
+	if !hasPointers {
+		return randomize.Struct(s, str, colTypes, canBeNull, blacklist...)
+	}
+
+	cpy := reflect.New(reflect.StructOf(fields)).Elem()
+	for j, i := range indexes {
+		if field := val.Field(i); field.Kind() != reflect.Ptr {
+			cpy.Field(j).Set(field)
+		} else if !field.IsNil() {
+			cpy.Field(j).Set(field.Elem())
+		}
+	}
+
+	if err := randomize.Struct(s, cpy.Addr().Interface(), colTypes, canBeNull, blacklist...); err != nil {
+		return err
+	}
+
+	for j, i := range indexes {
+		field := val.Field(i)
+		switch {
+		case field.Kind() != reflect.Ptr:
+			field.Set(cpy.Field(j))
+		case cpy.Field(j).IsZero():
+			field.Set(reflect.Zero(field.Type()))
+		default:
+			ptr := reflect.New(field.Type().Elem())
+			ptr.Elem().Set(cpy.Field(j))
+			field.Set(ptr)
+		}
+	}
+
+	return nil
+}
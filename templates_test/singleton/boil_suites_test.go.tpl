@@ -6,7 +6,7 @@
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsView -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}})
@@ -17,9 +17,9 @@ func TestParent(t *testing.T) {
 {{if .AddSoftDeletes -}}
 func TestSoftDelete(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsView -}}
   {{- else -}}
-  	{{- if .CanSoftDelete -}}
+  	{{- if .CanSoftDeleteColumn $.AutoColumns.Deleted -}}
       {{- $alias := $.Aliases.Table .Name -}}
       t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}SoftDelete)
   	{{end -}}
@@ -29,9 +29,9 @@ func TestSoftDelete(t *testing.T) {
 
 func TestQuerySoftDeleteAll(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsView -}}
   {{- else -}}
-  	{{- if .CanSoftDelete -}}
+  	{{- if .CanSoftDeleteColumn $.AutoColumns.Deleted -}}
       {{- $alias := $.Aliases.Table .Name -}}
       t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}QuerySoftDeleteAll)
   	{{end -}}
@@ -41,20 +41,32 @@ func TestQuerySoftDeleteAll(t *testing.T) {
 
 func TestSliceSoftDeleteAll(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsView -}}
   {{- else -}}
-  	{{- if .CanSoftDelete -}}
+  	{{- if .CanSoftDeleteColumn $.AutoColumns.Deleted -}}
       {{- $alias := $.Aliases.Table .Name -}}
       t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}SliceSoftDeleteAll)
   	{{end -}}
   {{end -}}
   {{- end -}}
 }
+
+func TestRestore(t *testing.T) {
+  {{- range .Tables}}
+  {{- if or .IsJoinTable .IsView -}}
+  {{- else -}}
+  	{{- if .CanSoftDeleteColumn $.AutoColumns.Deleted -}}
+      {{- $alias := $.Aliases.Table .Name -}}
+      t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Restore)
+  	{{end -}}
+  {{end -}}
+  {{- end -}}
+}
 {{- end}}
 
 func TestDelete(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsView -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Delete)
@@ -64,7 +76,7 @@ func TestDelete(t *testing.T) {
 
 func TestQueryDeleteAll(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsView -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}QueryDeleteAll)
@@ -74,7 +86,7 @@ func TestQueryDeleteAll(t *testing.T) {
 
 func TestSliceDeleteAll(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsView -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}SliceDeleteAll)
@@ -84,7 +96,7 @@ func TestSliceDeleteAll(t *testing.T) {
 
 func TestExists(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsView -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Exists)
@@ -92,9 +104,19 @@ func TestExists(t *testing.T) {
   {{- end -}}
 }
 
+func TestColumns(t *testing.T) {
+  {{- range .Tables}}
+  {{- if or .IsJoinTable .IsView -}}
+  {{- else -}}
+  {{- $alias := $.Aliases.Table .Name -}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Columns)
+  {{end -}}
+  {{- end -}}
+}
+
 func TestFind(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsView -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Find)
@@ -104,7 +126,7 @@ func TestFind(t *testing.T) {
 
 func TestBind(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsView -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Bind)
@@ -114,7 +136,7 @@ func TestBind(t *testing.T) {
 
 func TestOne(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsView -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}One)
@@ -124,7 +146,7 @@ func TestOne(t *testing.T) {
 
 func TestAll(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsView -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}All)
@@ -134,7 +156,7 @@ func TestAll(t *testing.T) {
 
 func TestCount(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsView -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Count)
@@ -145,7 +167,7 @@ func TestCount(t *testing.T) {
 {{if not .NoHooks -}}
 func TestHooks(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsView -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Hooks)
@@ -154,13 +176,27 @@ func TestHooks(t *testing.T) {
 }
 {{- end}}
 
+{{if .AddValidation}}
+func TestValidate(t *testing.T) {
+  {{- range .Tables}}
+  {{- if or .IsJoinTable .IsView -}}
+  {{- else -}}
+  {{- $alias := $.Aliases.Table .Name -}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Validate)
+  {{end -}}
+  {{- end -}}
+}
+
+{{end -}}
 func TestInsert(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsView -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Insert)
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}InsertWhitelist)
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}InsertBlacklist)
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}InsertGreylist)
   {{end -}}
   {{- end -}}
 }
@@ -169,7 +205,7 @@ func TestInsert(t *testing.T) {
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
 {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsView -}}
   {{- else -}}
     {{- range $fkey := .FKeys -}}
       {{- $ltable := $.Aliases.Table $fkey.Table -}}
@@ -185,7 +221,7 @@ func TestToOne(t *testing.T) {
 // or deadlocks can occur.
 func TestOneToOne(t *testing.T) {
   {{- range .Tables}}
-	{{- if .IsJoinTable -}}
+	{{- if or .IsJoinTable .IsView -}}
 	{{- else -}}
 	  {{- range $rel := .ToOneRelationships -}}
       {{- $ltable := $.Aliases.Table $rel.Table -}}
@@ -201,7 +237,7 @@ func TestOneToOne(t *testing.T) {
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
   {{- range .Tables}}
-    {{- if .IsJoinTable -}}
+    {{- if or .IsJoinTable .IsView -}}
     {{- else -}}
       {{- range $rel := .ToManyRelationships -}}
         {{- $ltable := $.Aliases.Table $rel.Table -}}
@@ -216,7 +252,7 @@ func TestToMany(t *testing.T) {
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
 {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsView -}}
   {{- else -}}
     {{- range $fkey := .FKeys -}}
       {{- $ltable := $.Aliases.Table $fkey.Table -}}
@@ -232,7 +268,7 @@ func TestToOneSet(t *testing.T) {
 // or deadlocks can occur.
 func TestToOneRemove(t *testing.T) {
 {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsView -}}
   {{- else -}}
     {{- range $fkey := .FKeys -}}
       {{- if $fkey.Nullable -}}
@@ -250,7 +286,7 @@ func TestToOneRemove(t *testing.T) {
 // or deadlocks can occur.
 func TestOneToOneSet(t *testing.T) {
   {{- range .Tables}}
-	{{- if .IsJoinTable -}}
+	{{- if or .IsJoinTable .IsView -}}
 	{{- else -}}
 	  {{- range $rel := .ToOneRelationships -}}
       {{- $ltable := $.Aliases.Table $rel.Table -}}
@@ -266,7 +302,7 @@ func TestOneToOneSet(t *testing.T) {
 // or deadlocks can occur.
 func TestOneToOneRemove(t *testing.T) {
   {{- range .Tables}}
-	{{- if .IsJoinTable -}}
+	{{- if or .IsJoinTable .IsView -}}
 	{{- else -}}
 	  {{- range $rel := .ToOneRelationships -}}
 		{{- if $rel.ForeignColumnNullable -}}
@@ -284,7 +320,7 @@ func TestOneToOneRemove(t *testing.T) {
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
   {{- range .Tables}}
-    {{- if .IsJoinTable -}}
+    {{- if or .IsJoinTable .IsView -}}
     {{- else -}}
       {{- range $rel := .ToManyRelationships -}}
         {{- $ltable := $.Aliases.Table $rel.Table -}}
@@ -299,7 +335,7 @@ func TestToManyAdd(t *testing.T) {
 // or deadlocks can occur.
 func TestToManySet(t *testing.T) {
   {{- range .Tables}}
-    {{- if .IsJoinTable -}}
+    {{- if or .IsJoinTable .IsView -}}
     {{- else -}}
       {{- range $rel := .ToManyRelationships -}}
         {{- if not (or $rel.ForeignColumnNullable $rel.ToJoinTable)}}
@@ -317,7 +353,7 @@ func TestToManySet(t *testing.T) {
 // or deadlocks can occur.
 func TestToManyRemove(t *testing.T) {
   {{- range .Tables}}
-    {{- if .IsJoinTable -}}
+    {{- if or .IsJoinTable .IsView -}}
     {{- else -}}
       {{- range $rel := .ToManyRelationships -}}
         {{- if not (or $rel.ForeignColumnNullable $rel.ToJoinTable)}}
@@ -333,7 +369,7 @@ func TestToManyRemove(t *testing.T) {
 
 func TestReload(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsView -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Reload)
@@ -343,7 +379,7 @@ func TestReload(t *testing.T) {
 
 func TestReloadAll(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsView -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}ReloadAll)
@@ -353,7 +389,7 @@ func TestReloadAll(t *testing.T) {
 
 func TestSelect(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsView -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Select)
@@ -363,7 +399,7 @@ func TestSelect(t *testing.T) {
 
 func TestUpdate(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsView -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}Update)
@@ -371,9 +407,20 @@ func TestUpdate(t *testing.T) {
   {{- end -}}
 }
 
+{{if .OptimisticLock}}
+func TestUpdateOptimisticLock(t *testing.T) {
+  {{- range .Tables}}
+  {{- if index $.OptimisticLock .Name -}}
+  {{- $alias := $.Aliases.Table .Name -}}
+  t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}UpdateOptimisticLock)
+  {{end -}}
+  {{- end -}}
+}
+
+{{end -}}
 func TestSliceUpdateAll(t *testing.T) {
   {{- range .Tables}}
-  {{- if .IsJoinTable -}}
+  {{- if or .IsJoinTable .IsView -}}
   {{- else -}}
   {{- $alias := $.Aliases.Table .Name -}}
   t.Run("{{$alias.UpPlural}}", test{{$alias.UpPlural}}SliceUpdateAll)
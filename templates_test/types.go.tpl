@@ -1,5 +1,17 @@
 {{- $alias := .Aliases.Table .Table.Name}}
+{{- /* Set columns are randomized like enums since a single member is a valid set value */}}
 var (
-	{{$alias.DownSingular}}DBTypes = map[string]string{{"{"}}{{range $i, $col := .Table.Columns -}}{{- if ne $i 0}},{{end}}`{{$alias.Column $col.Name}}`: `{{$col.DBType}}`{{end}}{{"}"}}
+	{{$alias.DownSingular}}DBTypes = map[string]string{{"{"}}{{range $i, $col := .Table.Columns -}}{{- if ne $i 0}},{{end}}`{{$alias.Column $col.Name}}`: `{{if $col.SetValues}}enum{{slice $col.DBType 3}}{{else}}{{$col.DBType}}{{end}}`{{end}}{{"}"}}
 	_ = bytes.MinRead
 )
+{{- range $column := .Table.Columns -}}
+{{- $jsonType := index $.JSONTypes (printf "%s.%s" $.Table.Name $column.Name) -}}
+{{- if $jsonType.Type}}
+
+// Randomize leaves the {{$column.Type}} as the zero value, there is no way to
+// know what makes a valid {{$jsonType.Type}}
+func (j *{{$column.Type}}) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	*j = {{$column.Type}}{}
+}
+{{- end -}}
+{{- end}}
@@ -1,4 +1,5 @@
-{{- $alias := .Aliases.Table .Table.Name}}
+{{- $alias := .Aliases.Table .Table.Name -}}
+{{- $lockCol := index .OptimisticLock .Table.Name}}
 func test{{$alias.UpPlural}}Update(t *testing.T) {
 	t.Parallel()
 
@@ -12,7 +13,7 @@ func test{{$alias.UpPlural}}Update(t *testing.T) {
 	seed := randomize.NewSeed()
 	var err error
 	o := &{{$alias.UpSingular}}{}
-	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
@@ -32,7 +33,7 @@ func test{{$alias.UpPlural}}Update(t *testing.T) {
 		t.Error("want one record, got:", count)
 	}
 
-	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}PrimaryKeyColumns...); err != nil {
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{if $lockCol}}append({{$alias.DownSingular}}PrimaryKeyColumns, "{{$lockCol}}"){{else}}{{$alias.DownSingular}}PrimaryKeyColumns{{end}}...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
@@ -49,6 +50,43 @@ func test{{$alias.UpPlural}}Update(t *testing.T) {
 	{{end -}}
 }
 
+{{if $lockCol -}}
+func test{{$alias.UpPlural}}UpdateOptimisticLock(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &{{$alias.UpSingular}}{}
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	{{if not .NoContext}}ctx := context.Background(){{end}}
+	tx := MustTx({{if .NoContext}}boil.Begin(){{else}}boil.BeginTx(ctx, nil){{end}})
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	stale := *o
+	version := o.{{$alias.Column $lockCol}}
+
+	if {{if not .NoRowsAffected}}_, {{end}}err = o.Update({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if o.{{$alias.Column $lockCol}} != version+1 {
+		t.Errorf("want {{$lockCol}} to be incremented to %v, got %v", version+1, o.{{$alias.Column $lockCol}})
+	}
+
+	if {{if not .NoRowsAffected}}_, {{end}}err = stale.Update({{if not .NoContext}}ctx, {{end -}} tx, boil.Infer()); err != ErrOptimisticLock {
+		t.Error("want ErrOptimisticLock for a stale update, got:", err)
+	}
+	if stale.{{$alias.Column $lockCol}} != version {
+		t.Errorf("want {{$lockCol}} to be left at %v after a failed update, got %v", version, stale.{{$alias.Column $lockCol}})
+	}
+}
+
+{{end -}}
 func test{{$alias.UpPlural}}SliceUpdateAll(t *testing.T) {
 	t.Parallel()
 
@@ -59,7 +97,7 @@ func test{{$alias.UpPlural}}SliceUpdateAll(t *testing.T) {
 	seed := randomize.NewSeed()
 	var err error
 	o := &{{$alias.UpSingular}}{}
-	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
@@ -79,7 +117,7 @@ func test{{$alias.UpPlural}}SliceUpdateAll(t *testing.T) {
 		t.Error("want one record, got:", count)
 	}
 
-	if err = randomize.Struct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}PrimaryKeyColumns...); err != nil {
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}PrimaryKeyColumns...); err != nil {
 		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
 	}
 
@@ -0,0 +1,61 @@
+{{- if .AddValidation -}}
+{{- $alias := .Aliases.Table .Table.Name}}
+func test{{$alias.UpPlural}}Validate(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &{{$alias.UpSingular}}{}
+	if err = randomizeStruct(seed, o, {{$alias.DownSingular}}DBTypes, true, {{$alias.DownSingular}}ColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize {{$alias.UpSingular}} struct: %s", err)
+	}
+
+	if err = o.Validate(); err != nil {
+		t.Error(err)
+	}
+	{{- $required := false}}
+	{{- range $column := .Table.Columns}}
+	{{- $colAlias := $alias.Column $column.Name}}
+	{{- $auto := and (not $.NoAutoTimestamps) (or (eq $column.Name $.AutoColumns.Created) (eq $column.Name $.AutoColumns.Updated))}}
+	{{- if and (not $column.Nullable) (not $column.Default) (not $auto) (or (eq $column.Type "string") (eq $column.Type "[]byte") (eq $column.Type "time.Time"))}}
+	{{- if not $required}}
+	{{- $required = true}}
+
+	var missing {{$alias.UpSingular}}
+	{{- end}}
+
+	missing = *o
+	missing.{{$colAlias}} = {{$alias.UpSingular}}{}.{{$colAlias}}
+	if err = missing.Validate(); err == nil {
+		t.Error("want an error when {{$column.Name}} is not set")
+	}
+	{{- end}}
+	{{- end}}
+	{{- range $column := .Table.Columns}}
+	{{- $colAlias := $alias.Column $column.Name}}
+	{{- with $column.MaxLength}}
+	{{- if eq $column.Type "string"}}
+
+	o.{{$colAlias}} = string(bytes.Repeat([]byte("a"), {{.}}+1))
+	if err = o.Validate(); err == nil {
+		t.Error("want an error when {{$column.Name}} is longer than {{.}} characters")
+	}
+	o.{{$colAlias}} = string(bytes.Repeat([]byte("a"), {{.}}))
+	if err = o.Validate(); err != nil {
+		t.Error(err)
+	}
+	{{- else if eq $column.Type "null.String"}}
+
+	o.{{$colAlias}}.String, o.{{$colAlias}}.Valid = string(bytes.Repeat([]byte("a"), {{.}}+1)), true
+	if err = o.Validate(); err == nil {
+		t.Error("want an error when {{$column.Name}} is longer than {{.}} characters")
+	}
+	o.{{$colAlias}}.String = string(bytes.Repeat([]byte("a"), {{.}}))
+	if err = o.Validate(); err != nil {
+		t.Error(err)
+	}
+	{{- end}}
+	{{- end}}
+	{{- end}}
+}
+{{- end}}
@@ -3,7 +3,8 @@ CREATE TABLE event_one (
   name   VARCHAR(255),
   face   enum('happy','sad','bitter'),
   mood   enum('happy','sad','bitter'),
-  day    enum('monday','tuesday','wednesday')
+  day    enum('monday','tuesday','wednesday'),
+  colors set('red','green','blue')
 );
 
 CREATE TABLE magic (